@@ -0,0 +1,139 @@
+package stemmer
+
+import "strings"
+
+// AffixKind is the position of an affix in the Indonesian word model
+// [[prefix-1 + [prefix-2 + [prefix-3 +]]] root [+ suffix] [+ possessive] [+ particle]].
+type AffixKind int
+
+const (
+	FirstPrefix AffixKind = iota
+	SecondPrefix
+	ThirdPrefix
+	DerivationSuffix
+	PossessivePronoun
+	Particle
+)
+
+var affixKindNames = [...]string{
+	FirstPrefix:       "first-prefix",
+	SecondPrefix:      "second-prefix",
+	ThirdPrefix:       "third-prefix",
+	DerivationSuffix:  "derivation-suffix",
+	PossessivePronoun: "possessive-pronoun",
+	Particle:          "particle",
+}
+
+// MarshalText encodes the kind as its name.
+func (k AffixKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (k AffixKind) String() string {
+	if k < 0 || int(k) >= len(affixKindNames) {
+		return "unknown"
+	}
+	return affixKindNames[k]
+}
+
+// IsPrefix reports whether k is one of the prefix positions.
+func (k AffixKind) IsPrefix() bool {
+	return k >= FirstPrefix && k <= ThirdPrefix
+}
+
+// Affix is an affix removed from a word. Recoding holds the letters
+// put back in its place, such as "s" for "meny-" in "menyapu".
+type Affix struct {
+	Kind     AffixKind `json:"kind"`
+	Value    string    `json:"value"`
+	Recoding string    `json:"recoding,omitempty"`
+}
+
+// String returns the affix with a hyphen on the side of the root,
+// such as "meny-" or "-kan".
+func (a Affix) String() string {
+	if a.Kind.IsPrefix() {
+		return a.Value + "-"
+	}
+	return "-" + a.Value
+}
+
+// affixes holds at most one affix of each kind, indexed by kind.
+type affixes [Particle + 1]Affix
+
+func (a *affixes) add(kind AffixKind, value, recoding string) {
+	a[kind] = Affix{Kind: kind, Value: value, Recoding: recoding}
+}
+
+// with returns a with the affixes of b added.
+func (a affixes) with(b affixes) affixes {
+	for i := range b {
+		if b[i].Value != "" {
+			a[i] = b[i]
+		}
+	}
+	return a
+}
+
+// list returns the affixes in the order they appear in the word.
+func (a *affixes) list() []Affix {
+	var list []Affix
+	for _, affix := range a {
+		if affix.Value != "" {
+			list = append(list, affix)
+		}
+	}
+	return list
+}
+
+// Analysis is the result of stemming one word.
+type Analysis struct {
+	// Word is the word as given.
+	Word string `json:"word"`
+	// Root is the lowercase stem of Word, or Word itself when it is
+	// protected or a strict Stemmer finds no root word for it.
+	Root string `json:"root"`
+	// Affixes are the affixes removed, in the order they appear in Word.
+	Affixes []Affix `json:"affixes,omitempty"`
+	// Overridden reports whether Root was taken from the overrides.
+	Overridden bool `json:"overridden,omitempty"`
+	// Protected reports whether Word is protected and was not stemmed.
+	Protected bool `json:"protected,omitempty"`
+	// Reduplicated reports whether Word was stemmed as a reduplication.
+	Reduplicated bool `json:"reduplicated,omitempty"`
+	// Found reports whether Root is in the dictionary. Otherwise Root
+	// is a best-effort fallback, or the word itself for a strict Stemmer.
+	Found bool `json:"found"`
+}
+
+// Analyze stems word like Stemm and reports how its root was found.
+func (s *Stemmer) Analyze(word string) Analysis {
+	st := s.stemming()
+	return st.analyze(word)
+}
+
+func (st *stemming) analyze(word string) Analysis {
+	lower := []byte(strings.ToLower(word))
+	if st.isProtected(string(lower)) {
+		return Analysis{Word: word, Root: word, Protected: true}
+	}
+	if stem, ok := st.overrides[string(lower)]; ok {
+		return Analysis{Word: word, Root: stem, Overridden: true, Found: st.isRoot([]byte(stem))}
+	}
+	if st.isRoot(lower) {
+		return Analysis{Word: word, Root: string(lower), Found: true}
+	}
+
+	root, affixes, reduplicated := st.stem(lower)
+	found := st.isRoot(root)
+	if st.strict && !found {
+		return Analysis{Word: word, Root: word}
+	}
+	return Analysis{
+		Word:         word,
+		Root:         string(root),
+		Affixes:      affixes.list(),
+		Reduplicated: reduplicated,
+		Found:        found,
+	}
+}
@@ -0,0 +1,73 @@
+package stemmer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	testCases := []struct {
+		mode Mode
+		in   string
+		out  Analysis
+	}{
+		{NaziefAdriani, "Cinta", Analysis{Word: "Cinta", Root: "cinta", Found: true}},
+		{NaziefAdriani, "menyapu", Analysis{Word: "menyapu", Root: "sapu", Found: true, Affixes: []Affix{
+			{Kind: FirstPrefix, Value: "meny", Recoding: "s"},
+		}}},
+		{NaziefAdriani, "mencintainya", Analysis{Word: "mencintainya", Root: "cinta", Found: true, Affixes: []Affix{
+			{Kind: FirstPrefix, Value: "men"},
+			{Kind: DerivationSuffix, Value: "i"},
+			{Kind: PossessivePronoun, Value: "nya"},
+		}}},
+		{NaziefAdriani, "bukumulah", Analysis{Word: "bukumulah", Root: "buku", Found: true, Affixes: []Affix{
+			{Kind: PossessivePronoun, Value: "mu"},
+			{Kind: Particle, Value: "lah"},
+		}}},
+		{NaziefAdriani, "memperbarui", Analysis{Word: "memperbarui", Root: "baru", Found: true, Affixes: []Affix{
+			{Kind: FirstPrefix, Value: "mem"},
+			{Kind: SecondPrefix, Value: "per"},
+			{Kind: DerivationSuffix, Value: "i"},
+		}}},
+		{NaziefAdriani, "kemerah-merahan", Analysis{Word: "kemerah-merahan", Root: "merah", Found: true, Reduplicated: true, Affixes: []Affix{
+			{Kind: FirstPrefix, Value: "ke"},
+			{Kind: DerivationSuffix, Value: "an"},
+		}}},
+		{NaziefAdriani, "qwxzvb", Analysis{Word: "qwxzvb", Root: "qwxzvb"}},
+
+		{EnhancedConfixStripping, "menyapu", Analysis{Word: "menyapu", Root: "sapu", Found: true, Affixes: []Affix{
+			{Kind: FirstPrefix, Value: "meny", Recoding: "s"},
+		}}},
+		{EnhancedConfixStripping, "memperbarui", Analysis{Word: "memperbarui", Root: "baru", Found: true, Affixes: []Affix{
+			{Kind: FirstPrefix, Value: "mem"},
+			{Kind: SecondPrefix, Value: "per"},
+			{Kind: DerivationSuffix, Value: "i"},
+		}}},
+		{EnhancedConfixStripping, "qwxzvb", Analysis{Word: "qwxzvb", Root: "qwxzvb"}},
+	}
+
+	for _, tc := range testCases {
+		out := New(WithMode(tc.mode)).Analyze(tc.in)
+		if !reflect.DeepEqual(out, tc.out) {
+			t.Error(tc.mode, tc.in, out)
+		}
+	}
+}
+
+func TestAffixString(t *testing.T) {
+	testCases := []struct {
+		in  Affix
+		out string
+	}{
+		{Affix{Kind: FirstPrefix, Value: "meny", Recoding: "s"}, "meny-"},
+		{Affix{Kind: ThirdPrefix, Value: "ke"}, "ke-"},
+		{Affix{Kind: DerivationSuffix, Value: "kan"}, "-kan"},
+		{Affix{Kind: Particle, Value: "lah"}, "-lah"},
+	}
+
+	for _, tc := range testCases {
+		if tc.in.String() != tc.out {
+			t.Error(tc.in.String())
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Package bleveid registers an Indonesian analyzer and stemming
+// token filter with Bleve. Import it for its side effects:
+//
+//	import _ "github.com/ariefrahmansyah/stemmer/bleveid"
+//
+// and set "id" as the analyzer of a field or index mapping.
+package bleveid
+
+import (
+	"fmt"
+
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/lang/id"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/registry"
+
+	"github.com/ariefrahmansyah/stemmer"
+)
+
+const (
+	// AnalyzerName is the name of the Indonesian analyzer: the unicode
+	// tokenizer, lowercase, Indonesian stopwords and StemmerName.
+	AnalyzerName = "id"
+
+	// StemmerName is the name of the stemming token filter.
+	StemmerName = "stemmer_id"
+)
+
+// StemmerFilter is a token filter replacing every term by its stem.
+// Keyword tokens are left as they are.
+type StemmerFilter struct {
+	s *stemmer.Stemmer
+}
+
+// NewStemmerFilter returns a StemmerFilter stemming with s.
+func NewStemmerFilter(s *stemmer.Stemmer) *StemmerFilter {
+	return &StemmerFilter{s: s}
+}
+
+func (f *StemmerFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	for _, token := range input {
+		if token.KeyWord {
+			continue
+		}
+		token.Term = []byte(f.s.Stem(string(token.Term)))
+	}
+	return input
+}
+
+// StemmerFilterConstructor builds a StemmerFilter. The optional "mode"
+// setting selects the algorithm, "nazief-adriani" or "ecs".
+func StemmerFilterConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	var opts []stemmer.Option
+	if name, ok := config["mode"]; ok {
+		mode, err := stemmer.ParseMode(fmt.Sprint(name))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, stemmer.WithMode(mode))
+	}
+	return NewStemmerFilter(stemmer.New(opts...)), nil
+}
+
+// AnalyzerConstructor builds the Indonesian analyzer.
+func AnalyzerConstructor(config map[string]interface{}, cache *registry.Cache) (analysis.Analyzer, error) {
+	tokenizer, err := cache.TokenizerNamed(unicode.Name)
+	if err != nil {
+		return nil, err
+	}
+	toLowerFilter, err := cache.TokenFilterNamed(lowercase.Name)
+	if err != nil {
+		return nil, err
+	}
+	stopIdFilter, err := cache.TokenFilterNamed(id.StopName)
+	if err != nil {
+		return nil, err
+	}
+	stemmerIdFilter, err := cache.TokenFilterNamed(StemmerName)
+	if err != nil {
+		return nil, err
+	}
+	rv := analysis.DefaultAnalyzer{
+		Tokenizer: tokenizer,
+		TokenFilters: []analysis.TokenFilter{
+			toLowerFilter,
+			stopIdFilter,
+			stemmerIdFilter,
+		},
+	}
+	return &rv, nil
+}
+
+func init() {
+	if err := registry.RegisterTokenFilter(StemmerName, StemmerFilterConstructor); err != nil {
+		panic(err)
+	}
+	if err := registry.RegisterAnalyzer(AnalyzerName, AnalyzerConstructor); err != nil {
+		panic(err)
+	}
+}
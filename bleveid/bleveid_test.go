@@ -0,0 +1,85 @@
+package bleveid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/registry"
+
+	"github.com/ariefrahmansyah/stemmer"
+)
+
+func TestStemmerFilter(t *testing.T) {
+	input := analysis.TokenStream{
+		{Term: []byte("mencintai")},
+		{Term: []byte("perekonomian")},
+		{Term: []byte("pertamina"), KeyWord: true},
+		{Term: []byte("buku")},
+	}
+
+	out := NewStemmerFilter(stemmer.New()).Filter(input)
+	var terms []string
+	for _, token := range out {
+		terms = append(terms, string(token.Term))
+	}
+	if !reflect.DeepEqual(terms, []string{"cinta", "ekonomi", "pertamina", "buku"}) {
+		t.Error(terms)
+	}
+}
+
+func TestStemmerFilterConstructor(t *testing.T) {
+	testCases := []struct {
+		config map[string]interface{}
+		err    bool
+	}{
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"mode": "ecs"}, false},
+		{map[string]interface{}{"mode": "porter"}, true},
+	}
+
+	for _, tc := range testCases {
+		_, err := StemmerFilterConstructor(tc.config, registry.NewCache())
+		if (err != nil) != tc.err {
+			t.Error(tc.config, err)
+		}
+	}
+}
+
+func TestAnalyzer(t *testing.T) {
+	a, err := registry.NewCache().AnalyzerNamed(AnalyzerName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var terms []string
+	for _, token := range a.Analyze([]byte("Perekonomian yang MENCINTAI buku-buku")) {
+		terms = append(terms, string(token.Term))
+	}
+	if !reflect.DeepEqual(terms, []string{"ekonomi", "cinta", "buku", "buku"}) {
+		t.Error(terms)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	mapping := bleve.NewIndexMapping()
+	mapping.DefaultAnalyzer = AnalyzerName
+	index, err := bleve.NewMemOnly(mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+
+	if err := index.Index("1", map[string]string{"body": "Mereka mencintai perekonomian negeri"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := index.Search(bleve.NewSearchRequest(bleve.NewMatchQuery("cinta ekonomi")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Total != 1 {
+		t.Error(res.Total)
+	}
+}
@@ -0,0 +1,113 @@
+package stemmer
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheStats are the counters of the cache of a Stemmer.
+type CacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	Len    int    `json:"len"`
+	Size   int    `json:"size"`
+}
+
+// cacheSource identifies the root words and overrides the stems
+// in a cache were computed with.
+type cacheSource struct {
+	words     *wordSet
+	overrides *stemTable
+}
+
+// cache is a least recently used cache of lowercase words to stems.
+// It empties itself when the root words or overrides change.
+type cache struct {
+	mu     sync.Mutex
+	size   int
+	source cacheSource
+	order  *list.List // of *cacheEntry, most recently used first
+	items  map[string]*list.Element
+	hits   uint64
+	misses uint64
+}
+
+type cacheEntry struct {
+	word string
+	stem string
+}
+
+func newCache(size int) *cache {
+	return &cache{size: size, order: list.New(), items: make(map[string]*list.Element, size)}
+}
+
+// get returns the stem of word computed with source.
+func (c *cache) get(source cacheSource, word string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if source != c.source {
+		c.purge()
+		c.source = source
+	}
+	e, ok := c.items[word]
+	if !ok {
+		c.misses++
+		return "", false
+	}
+	c.hits++
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).stem, true
+}
+
+// add caches the stem of word computed with source,
+// evicting the least recently used word when full.
+func (c *cache) add(source cacheSource, word, stem string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if source != c.source {
+		return
+	}
+	if e, ok := c.items[word]; ok {
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).word)
+	}
+	c.items[word] = c.order.PushFront(&cacheEntry{word: word, stem: stem})
+}
+
+// purge empties the cache; c.mu must be held.
+func (c *cache) purge() {
+	c.order.Init()
+	c.items = make(map[string]*list.Element, c.size)
+}
+
+// CacheStats returns the counters of the cache of s,
+// or zero stats when s has no cache.
+func (s *Stemmer) CacheStats() CacheStats {
+	if s.cache == nil {
+		return CacheStats{}
+	}
+
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	return CacheStats{Hits: s.cache.hits, Misses: s.cache.misses, Len: s.cache.order.Len(), Size: s.cache.size}
+}
+
+// PurgeCache empties the cache of s and resets its counters. The cache
+// already empties itself when the root words or overrides change.
+func (s *Stemmer) PurgeCache() {
+	if s.cache == nil {
+		return
+	}
+
+	s.cache.mu.Lock()
+	defer s.cache.mu.Unlock()
+	s.cache.purge()
+	s.cache.hits, s.cache.misses = 0, 0
+}
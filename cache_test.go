@@ -0,0 +1,93 @@
+package stemmer
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestStemmCache(t *testing.T) {
+	s := New(WithCache(2), WithCasing(RestoreCase))
+	if stats := s.CacheStats(); stats != (CacheStats{Size: 2}) {
+		t.Error(stats)
+	}
+
+	out := s.Stemm("mencintai", "Mencintai", "perekonomian", "kediri", "mencintai")
+	if !reflect.DeepEqual(out, []string{"cinta", "Cinta", "ekonomi", "diri", "cinta"}) {
+		t.Error(out)
+	}
+	// "mencintai" was evicted by "perekonomian" and "kediri"
+	if stats := s.CacheStats(); stats != (CacheStats{Hits: 1, Misses: 4, Len: 2, Size: 2}) {
+		t.Error(stats)
+	}
+
+	s.PurgeCache()
+	if stats := s.CacheStats(); stats != (CacheStats{Size: 2}) {
+		t.Error(stats)
+	}
+}
+
+func TestStemmCacheInvalidation(t *testing.T) {
+	s := NewWithDictionary(DefaultDictionary(), WithCache(10))
+	if out := s.Stemm("kediri"); out[0] != "diri" {
+		t.Error(out)
+	}
+
+	s.AddRootWords("kediri")
+	if out := s.Stemm("kediri"); out[0] != "kediri" {
+		t.Error(out)
+	}
+
+	s.SetOverrides(NewOverrides(map[string]string{"kediri": "kota"}))
+	if out := s.Stemm("kediri"); out[0] != "kota" {
+		t.Error(out)
+	}
+
+	s.SetDictionary(NewDictionary("cinta"))
+	s.SetOverrides(nil)
+	if out := s.Stemm("kediri"); out[0] != "kediri" {
+		t.Error(out)
+	}
+	if stats := s.CacheStats(); stats.Hits != 0 || stats.Len != 1 {
+		t.Error(stats)
+	}
+}
+
+func TestStemmCacheConcurrentUse(t *testing.T) {
+	s := New(WithCache(8))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				w := benchmarkWords[j%len(benchmarkWords)]
+				if out, want := s.Stemm(w)[0], New().Stemm(w)[0]; out != want {
+					t.Error(w, out)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := s.CacheStats(); stats.Hits+stats.Misses != 800 || stats.Len > 8 {
+		t.Error(stats)
+	}
+}
+
+func TestNoCache(t *testing.T) {
+	s := New(WithCache(0))
+	s.Stemm("mencintai")
+	s.PurgeCache()
+	if stats := s.CacheStats(); stats != (CacheStats{}) {
+		t.Error(stats)
+	}
+}
+
+func BenchmarkStemmCache(b *testing.B) {
+	s := New(WithCache(1024))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Stemm(benchmarkWords[i%len(benchmarkWords)])
+	}
+}
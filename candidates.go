@@ -0,0 +1,133 @@
+package stemmer
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// candidatePrefixRules are all the prefix rules of both modes.
+// Candidates tries every matching rule instead of the first one.
+var candidatePrefixRules = append([][]prefixRule{ecsPrefixRules, plainPrefixRules, pePrefixRules}, complexPrefixRules...)
+
+// Candidates returns every root word that word can be decomposed into,
+// trying all the prefix rules with each of their recodings, such as
+// "beri" and "ikan" for "berikan".
+//
+// The root returned by Stemm comes first when it is a root word. The
+// others follow ordered by the number of affixes removed, fewest first,
+// then by length, longest first, then alphabetically.
+func (s *Stemmer) Candidates(word string) []string {
+	st := s.stemming()
+	lower := []byte(strings.ToLower(word))
+
+	found := map[string]int{}
+	for _, w := range suffixCandidates(lower) {
+		search := candidateSearch{stemming: &st, derivation: w.derivation, found: found}
+		search.collect(w.word, "", 0, w.removed)
+	}
+
+	preferred := lower
+	if !st.isRoot(lower) {
+		preferred, _, _ = st.stem(lower)
+	}
+	first, ok := string(preferred), st.isRoot(preferred)
+
+	roots := make([]string, 0, len(found))
+	for root := range found {
+		if !ok || root != first {
+			roots = append(roots, root)
+		}
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		a, b := roots[i], roots[j]
+		switch {
+		case found[a] != found[b]:
+			return found[a] < found[b]
+		case len(a) != len(b):
+			return len(a) > len(b)
+		}
+		return a < b
+	})
+
+	if ok {
+		roots = append([]string{first}, roots...)
+	}
+	return roots
+}
+
+// suffixed is a word with some of its suffixes removed.
+type suffixed struct {
+	word       []byte
+	derivation string
+	removed    int
+}
+
+// suffixCandidates returns word with every combination of a particle,
+// a possessive pronoun and a derivation suffix removed.
+func suffixCandidates(word []byte) []suffixed {
+	words := []suffixed{{word: word}}
+	for _, suffixes := range [][]string{particles, possessivePronouns} {
+		for _, w := range words {
+			if base, _, ok := trimSuffix(w.word, suffixes); ok && len(base) > 0 {
+				words = append(words, suffixed{word: base, removed: w.removed + 1})
+			}
+		}
+	}
+
+	for _, w := range words {
+		for _, suffix := range derivationSuffixes {
+			if bytes.HasSuffix(w.word, []byte(suffix)) && len(w.word) > len(suffix) {
+				base := w.word[:len(w.word)-len(suffix)]
+				words = append(words, suffixed{word: base, derivation: suffix, removed: w.removed + 1})
+			}
+		}
+		for _, suffix := range derivationPeople {
+			if bytes.HasSuffix(w.word, []byte(suffix)) && len(w.word) > len(suffix) {
+				base := w.word[:len(w.word)-len(suffix)]
+				words = append(words, suffixed{word: base, removed: w.removed + 1})
+			}
+		}
+	}
+	return words
+}
+
+// candidateSearch collects the root words of a word
+// that has lost the derivation suffix derivation.
+type candidateSearch struct {
+	*stemming
+	derivation string
+	found      map[string]int
+}
+
+// collect records the root words reachable from word by removing
+// up to three prefixes, with the fewest affixes removed for each.
+// A first prefix forming a disallowed confix with the derivation
+// suffix and a prefix repeating previous are not removed.
+func (c *candidateSearch) collect(word []byte, previous string, depth, removed int) {
+	if c.isRoot(word) {
+		if n, ok := c.found[string(word)]; !ok || removed < n {
+			c.found[string(word)] = removed
+		}
+	}
+	if depth == 3 {
+		return
+	}
+
+	for _, rules := range candidatePrefixRules {
+		for i := range rules {
+			if !rules[i].match.Match(word) {
+				continue
+			}
+			for _, removal := range rules[i].removals {
+				prefix := removal.prefix[:2]
+				if prefix == previous || depth == 0 && isDisallowedConfix(removal.prefix, c.derivation) {
+					continue
+				}
+				if stem, ok := removal.apply(word); ok && len(stem) > 0 {
+					c.collect(stem, prefix, depth+1, removed+1)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package stemmer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCandidates(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out []string
+	}{
+		{"berikan", []string{"ikan", "berik", "beri"}},
+		{"mengukur", []string{"ukur", "kukur"}},
+		{"peranan", []string{"peran"}},
+		{"menyanyi", []string{"nyanyi"}},
+		{"Cinta", []string{"cinta"}},
+		{"qwxzvb", []string{}},
+	}
+
+	s := New()
+	for _, tc := range testCases {
+		out := s.Candidates(tc.in)
+		if !reflect.DeepEqual(out, tc.out) {
+			t.Error(tc.in, out)
+		}
+	}
+}
+
+func TestCandidatesFirstIsStemm(t *testing.T) {
+	for _, mode := range []Mode{NaziefAdriani, EnhancedConfixStripping} {
+		s := New(WithMode(mode))
+		for _, w := range benchmarkWords {
+			out := s.Candidates(w)
+			if stem := s.Stemm(w)[0]; s.IsRootWord([]byte(stem)) && (len(out) == 0 || out[0] != stem) {
+				t.Error(mode, w, stem, out)
+			}
+		}
+	}
+}
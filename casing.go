@@ -0,0 +1,46 @@
+package stemmer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Casing selects how Stemm capitalises the stems it returns.
+type Casing int
+
+const (
+	// LowerCase returns every stem in lowercase.
+	LowerCase Casing = iota
+
+	// RestoreCase gives a stem the capitalisation of its word when the
+	// word is in UPPER or Title case, so "PEREKONOMIAN" stems to "EKONOMI"
+	// and "Perekonomian" to "Ekonomi". Other words give a lowercase stem.
+	RestoreCase
+)
+
+// String returns the name of the casing.
+func (c Casing) String() string {
+	switch c {
+	case LowerCase:
+		return "lower"
+	case RestoreCase:
+		return "restore"
+	}
+	return "unknown"
+}
+
+// restoreCase returns the lowercase stem with the capitalisation of word.
+func restoreCase(word, stem string) string {
+	first, n := utf8.DecodeRuneInString(word)
+	switch {
+	case !unicode.IsUpper(first):
+		return stem
+	case strings.ToUpper(word) == word:
+		return strings.ToUpper(stem)
+	case strings.ToLower(word[n:]) == word[n:]:
+		r, size := utf8.DecodeRuneInString(stem)
+		return string(unicode.ToUpper(r)) + stem[size:]
+	}
+	return stem
+}
@@ -0,0 +1,70 @@
+package stemmer
+
+import "testing"
+
+func TestStemmCasing(t *testing.T) {
+	testCases := []struct {
+		in      string
+		lower   string
+		restore string
+	}{
+		{"cinta", "cinta", "cinta"},
+		{"Cinta", "cinta", "Cinta"},
+		{"CINTA", "cinta", "CINTA"},
+		{"perekonomian", "ekonomi", "ekonomi"},
+		{"Perekonomian", "ekonomi", "Ekonomi"},
+		{"PEREKONOMIAN", "ekonomi", "EKONOMI"},
+		{"PeReKonomian", "ekonomi", "ekonomi"},
+		{"Buku-buku", "buku", "Buku"},
+	}
+
+	lower, restore := New(), New(WithCasing(RestoreCase))
+	if lower.Casing() != LowerCase || restore.Casing() != RestoreCase {
+		t.Error(lower.Casing(), restore.Casing())
+	}
+	for _, tc := range testCases {
+		if out := lower.Stemm(tc.in)[0]; out != tc.lower {
+			t.Error(tc.in, out)
+		}
+		if out := restore.Stemm(tc.in)[0]; out != tc.restore {
+			t.Error(tc.in, out)
+		}
+	}
+}
+
+func TestRestoreCase(t *testing.T) {
+	testCases := []struct {
+		word string
+		stem string
+		out  string
+	}{
+		{"A", "a", "A"},
+		{"Ábaca", "ábaca", "Ábaca"},
+		{"ÁBACA", "ábaca", "ÁBACA"},
+		{"iPhone", "iphone", "iphone"},
+		{"", "", ""},
+	}
+
+	for _, tc := range testCases {
+		if out := restoreCase(tc.word, tc.stem); out != tc.out {
+			t.Error(tc.word, out)
+		}
+	}
+}
+
+func TestCasingString(t *testing.T) {
+	testCases := []struct {
+		in  Casing
+		out string
+	}{
+		{LowerCase, "lower"},
+		{RestoreCase, "restore"},
+		{Casing(-1), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if tc.in.String() != tc.out {
+			t.Error(tc.in.String())
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Command stemmer prints the stems of Indonesian words.
+//
+// Usage:
+//
+//	stemmer [flags] [word ...]
+//
+// Words are taken from the arguments, or else from the files given
+// with -f, or else from standard input, one or more words per line.
+// Each stem is printed on its own line, or with its word as
+// "word<TAB>stem" with -o tsv.
+//
+// The exit code is 0 on success, 1 when a file cannot be read or
+// written, 2 on bad usage and 3 when -check is given and a stem is
+// not a root word.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ariefrahmansyah/stemmer"
+)
+
+const (
+	exitOK = iota
+	exitError
+	exitUsage
+	exitUnknown
+)
+
+// files is a flag that can be repeated.
+type files []string
+
+func (f *files) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *files) Set(name string) error {
+	*f = append(*f, name)
+	return nil
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fl := flag.NewFlagSet("stemmer", flag.ContinueOnError)
+	fl.SetOutput(stderr)
+	fl.Usage = func() {
+		fmt.Fprintln(stderr, "usage: stemmer [flags] [word ...]")
+		fl.PrintDefaults()
+	}
+
+	var inputs files
+	fl.Var(&inputs, "f", "read words from `file` (repeatable)")
+	output := fl.String("o", "plain", "output `format`: plain or tsv")
+	dict := fl.String("dict", "", "load root words from `file` instead of the embedded ones")
+	var mode stemmer.Mode
+	fl.TextVar(&mode, "mode", stemmer.NaziefAdriani, "stemming `mode`: nazief-adriani or ecs")
+	check := fl.Bool("check", false, "exit with code 3 if a stem is not a root word")
+	if err := fl.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if *output != "plain" && *output != "tsv" {
+		fmt.Fprintf(stderr, "stemmer: unknown output format %q\n", *output)
+		return exitUsage
+	}
+	if len(inputs) > 0 && fl.NArg() > 0 {
+		fmt.Fprintln(stderr, "stemmer: words given both as arguments and with -f")
+		return exitUsage
+	}
+
+	opts := []stemmer.Option{stemmer.WithMode(mode)}
+	if *dict != "" {
+		d, err := stemmer.LoadDictionaryFile(*dict)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+		opts = append(opts, stemmer.WithDictionary(d))
+	}
+
+	p := &printer{
+		s:   stemmer.New(opts...),
+		w:   bufio.NewWriter(stdout),
+		tsv: *output == "tsv",
+	}
+	switch {
+	case fl.NArg() > 0:
+		for _, word := range fl.Args() {
+			p.print(word)
+		}
+	case len(inputs) > 0:
+		for _, name := range inputs {
+			if err := p.printFile(name); err != nil {
+				fmt.Fprintln(stderr, err)
+				return exitError
+			}
+		}
+	default:
+		if err := p.printWords(stdin); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+	}
+
+	if err := p.w.Flush(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	if *check && p.unknown > 0 {
+		fmt.Fprintf(stderr, "stemmer: %d stems are not root words\n", p.unknown)
+		return exitUnknown
+	}
+	return exitOK
+}
+
+// printer prints the stems of words and counts those that are not root words.
+type printer struct {
+	s       *stemmer.Stemmer
+	w       *bufio.Writer
+	tsv     bool
+	unknown int
+}
+
+func (p *printer) print(word string) {
+	stem := p.s.Stem(word)
+	if !p.s.IsRootWord([]byte(stem)) {
+		p.unknown++
+	}
+	if p.tsv {
+		fmt.Fprintf(p.w, "%s\t%s\n", word, stem)
+	} else {
+		fmt.Fprintln(p.w, stem)
+	}
+}
+
+// printWords prints the stems of the words in r, scanned one word
+// at a time so that lines of any length can be read.
+func (p *printer) printWords(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	sc.Split(bufio.ScanWords)
+	for sc.Scan() {
+		p.print(sc.Text())
+	}
+	return sc.Err()
+}
+
+func (p *printer) printFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return p.printWords(f)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	words := filepath.Join(dir, "words.txt")
+	if err := os.WriteFile(words, []byte("mencintai\n\nperekonomian  kediri\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dict := filepath.Join(dir, "dict.txt")
+	if err := os.WriteFile(dict, []byte("cinta\nkediri\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		args   []string
+		stdin  string
+		stdout string
+		code   int
+	}{
+		{[]string{"mencintai", "Perekonomian"}, "", "cinta\nekonomi\n", exitOK},
+		{[]string{"-o", "tsv", "mencintai"}, "", "mencintai\tcinta\n", exitOK},
+		{nil, "mencintai perekonomian\n\nmakan\n", "cinta\nekonomi\nmakan\n", exitOK},
+		{nil, strings.Repeat("mencintai ", 10000), strings.Repeat("cinta\n", 10000), exitOK},
+		{[]string{"-f", words, "-f", words}, "", strings.Repeat("cinta\nekonomi\ndiri\n", 2), exitOK},
+		{[]string{"-dict", dict, "kediri", "mencintai"}, "", "kediri\ncinta\n", exitOK},
+		{[]string{"-mode", "ecs", "memperbarui"}, "", "baru\n", exitOK},
+		{[]string{"-check", "mencintai"}, "", "cinta\n", exitOK},
+		{[]string{"-check", "qwxzvb"}, "", "qwxzvb\n", exitUnknown},
+
+		{[]string{"-f", filepath.Join(dir, "missing.txt")}, "", "", exitError},
+		{[]string{"-dict", filepath.Join(dir, "missing.txt"), "cinta"}, "", "", exitError},
+		{[]string{"-o", "json", "cinta"}, "", "", exitUsage},
+		{[]string{"-mode", "porter", "cinta"}, "", "", exitUsage},
+		{[]string{"-f", words, "cinta"}, "", "", exitUsage},
+		{[]string{"-unknown"}, "", "", exitUsage},
+	}
+
+	for _, tc := range testCases {
+		var stdout, stderr strings.Builder
+		code := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+		if code != tc.code || stdout.String() != tc.stdout {
+			t.Error(tc.args, code, stdout.String(), stderr.String())
+		}
+		if (code == exitOK) != (stderr.Len() == 0) {
+			t.Error(tc.args, stderr.String())
+		}
+	}
+}
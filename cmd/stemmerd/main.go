@@ -0,0 +1,104 @@
+// Command stemmerd serves the stemmer over HTTP with JSON requests
+// and responses.
+//
+// Usage:
+//
+//	stemmerd [-addr :8080] [-dict file] [-mode nazief-adriani|ecs]
+//
+// Endpoints:
+//
+//	POST /stem      {"words": ["mencintai"]}    -> {"stems": ["cinta"]}
+//	POST /text      {"text": "Buku-buku itu"}  -> {"tokens": [{"kind": "word", ...}]}
+//	POST /analyze   {"word": "menyapu"}        -> {"word": "menyapu", "root": "sapu", ...}
+//	POST /isroot    {"word": "cinta"}          -> {"word": "cinta", "isRoot": true}
+//	GET  /healthz                              -> {"status": "ok"}
+//
+// Request bodies larger than -max-body bytes are rejected with 413.
+// On SIGINT or SIGTERM the server stops accepting connections and
+// waits up to -shutdown-timeout for the running requests.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ariefrahmansyah/stemmer"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stderr io.Writer) error {
+	fl := flag.NewFlagSet("stemmerd", flag.ContinueOnError)
+	fl.SetOutput(stderr)
+	addr := fl.String("addr", ":8080", "listen `address`")
+	dict := fl.String("dict", "", "load root words from `file` instead of the embedded ones")
+	var mode stemmer.Mode
+	fl.TextVar(&mode, "mode", stemmer.NaziefAdriani, "stemming `mode`: nazief-adriani or ecs")
+	cache := fl.Int("cache", 10000, "number of stems to cache, 0 to disable")
+	maxBody := fl.Int64("max-body", 1<<20, "largest request body in `bytes`")
+	timeout := fl.Duration("shutdown-timeout", 10*time.Second, "how long to wait for running requests on shutdown")
+	if err := fl.Parse(args); err != nil {
+		return err
+	}
+
+	opts := []stemmer.Option{stemmer.WithMode(mode), stemmer.WithCache(*cache)}
+	if *dict != "" {
+		d, err := stemmer.LoadDictionaryFile(*dict)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, stemmer.WithDictionary(d))
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newHandler(stemmer.New(opts...), *maxBody),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return serve(ctx, srv, *timeout)
+}
+
+// serve runs srv until ctx is done, then shuts it down gracefully.
+func serve(ctx context.Context, srv *http.Server, timeout time.Duration) error {
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf("stemmerd listening on %s", srv.Addr)
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Print("stemmerd shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ariefrahmansyah/stemmer"
+)
+
+type stemRequest struct {
+	Words []string `json:"words"`
+}
+
+type stemResponse struct {
+	Stems []string `json:"stems"`
+}
+
+type textRequest struct {
+	Text string `json:"text"`
+}
+
+type textResponse struct {
+	Tokens []stemmer.Token `json:"tokens"`
+}
+
+type wordRequest struct {
+	Word string `json:"word"`
+}
+
+type isRootResponse struct {
+	Word   string `json:"word"`
+	IsRoot bool   `json:"isRoot"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// server answers the stemming requests with s.
+type server struct {
+	s       *stemmer.Stemmer
+	maxBody int64
+}
+
+func newHandler(s *stemmer.Stemmer, maxBody int64) http.Handler {
+	srv := &server{s: s, maxBody: maxBody}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /stem", srv.stem)
+	mux.HandleFunc("POST /text", srv.text)
+	mux.HandleFunc("POST /analyze", srv.analyze)
+	mux.HandleFunc("POST /isroot", srv.isRoot)
+	mux.HandleFunc("GET /healthz", srv.health)
+	return mux
+}
+
+func (srv *server) stem(w http.ResponseWriter, r *http.Request) {
+	var req stemRequest
+	if !srv.decode(w, r, &req) {
+		return
+	}
+	writeJSON(w, http.StatusOK, stemResponse{Stems: srv.s.Stemm(req.Words...)})
+}
+
+func (srv *server) text(w http.ResponseWriter, r *http.Request) {
+	var req textRequest
+	if !srv.decode(w, r, &req) {
+		return
+	}
+	writeJSON(w, http.StatusOK, textResponse{Tokens: srv.s.StemText(req.Text)})
+}
+
+func (srv *server) analyze(w http.ResponseWriter, r *http.Request) {
+	var req wordRequest
+	if !srv.decode(w, r, &req) || !requireWord(w, req.Word) {
+		return
+	}
+	writeJSON(w, http.StatusOK, srv.s.Analyze(req.Word))
+}
+
+func (srv *server) isRoot(w http.ResponseWriter, r *http.Request) {
+	var req wordRequest
+	if !srv.decode(w, r, &req) || !requireWord(w, req.Word) {
+		return
+	}
+	writeJSON(w, http.StatusOK, isRootResponse{Word: req.Word, IsRoot: srv.s.IsRootWord([]byte(strings.ToLower(req.Word)))})
+}
+
+func (srv *server) health(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// decode reads the JSON body of r into v, answering
+// the request with an error when it cannot.
+func (srv *server) decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, srv.maxBody))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err == nil && dec.More() {
+		err = errors.New("body must hold a single JSON object")
+	}
+	if err == nil {
+		return true
+	}
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeError(w, http.StatusRequestEntityTooLarge, "request body too large")
+	} else {
+		writeError(w, http.StatusBadRequest, "invalid request: "+err.Error())
+	}
+	return false
+}
+
+func requireWord(w http.ResponseWriter, word string) bool {
+	if word == "" {
+		writeError(w, http.StatusBadRequest, `invalid request: missing "word"`)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, errorResponse{Error: msg})
+}
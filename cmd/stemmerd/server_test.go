@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ariefrahmansyah/stemmer"
+)
+
+func TestHandler(t *testing.T) {
+	testCases := []struct {
+		method string
+		path   string
+		body   string
+		status int
+		out    string
+	}{
+		{"POST", "/stem", `{"words": ["mencintai", "Perekonomian"]}`, 200, `{"stems":["cinta","ekonomi"]}`},
+		{"POST", "/stem", `{"words": []}`, 200, `{"stems":[]}`},
+		{"POST", "/text", `{"text": "Buku-buku #baru"}`, 200,
+			`{"tokens":[{"kind":"word","text":"Buku-buku","start":0,"end":9,"stem":"buku"},{"kind":"hashtag","text":"#baru","start":10,"end":15}]}`},
+		{"POST", "/analyze", `{"word": "menyapu"}`, 200,
+			`{"word":"menyapu","root":"sapu","affixes":[{"kind":"first-prefix","value":"meny","recoding":"s"}],"found":true}`},
+		{"POST", "/isroot", `{"word": "cinta"}`, 200, `{"word":"cinta","isRoot":true}`},
+		{"POST", "/isroot", `{"word": "Cinta"}`, 200, `{"word":"Cinta","isRoot":true}`},
+		{"POST", "/isroot", `{"word": "mencintai"}`, 200, `{"word":"mencintai","isRoot":false}`},
+		{"GET", "/healthz", "", 200, `{"status":"ok"}`},
+
+		{"POST", "/stem", `{"words": "cinta"}`, 400, ""},
+		{"POST", "/stem", `{"word": "cinta"}`, 400, ""},
+		{"POST", "/stem", `{} {}`, 400, ""},
+		{"POST", "/analyze", `{}`, 400, `{"error":"invalid request: missing \"word\""}`},
+		{"POST", "/text", `{"text": "` + strings.Repeat("a", 200) + `"}`, 413, `{"error":"request body too large"}`},
+		{"GET", "/stem", "", 405, ""},
+		{"POST", "/missing", "{}", 404, ""},
+	}
+
+	h := newHandler(stemmer.New(), 128)
+	for _, tc := range testCases {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body)))
+		out := strings.TrimSpace(rec.Body.String())
+		if rec.Code != tc.status || tc.out != "" && out != tc.out {
+			t.Error(tc.method, tc.path, tc.body, rec.Code, out)
+		}
+	}
+}
+
+func TestServeShutdown(t *testing.T) {
+	srv := &http.Server{Addr: "127.0.0.1:0", Handler: newHandler(stemmer.New(), 1<<20)}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() { done <- serve(ctx, srv, time.Second) }()
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Error(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("serve did not return after cancel")
+	}
+}
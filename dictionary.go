@@ -0,0 +1,195 @@
+package stemmer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Dictionary is a set of root words used by a Stemmer to decide
+// whether a (partially stripped) word is a valid root.
+//
+// It is safe for concurrent use. Lookups read an immutable word set
+// that is swapped atomically on every change, so they never block;
+// Add, Remove and Replace copy the set, so batch words in one call.
+type Dictionary struct {
+	mu    sync.Mutex // serializes writers
+	words atomic.Pointer[wordSet]
+}
+
+type wordSet map[string]struct{}
+
+// NewDictionary returns a Dictionary containing the given root words.
+// Empty words are ignored.
+func NewDictionary(words ...string) *Dictionary {
+	d := &Dictionary{}
+	d.Replace(words...)
+	return d
+}
+
+// DefaultDictionary returns a new Dictionary built from
+// the embedded Indonesian root words.
+func DefaultDictionary() *Dictionary {
+	return NewDictionary(strings.Split(data, " ")...)
+}
+
+// load returns the current word set, which must not be modified.
+func (d *Dictionary) load() wordSet {
+	if ws := d.words.Load(); ws != nil {
+		return *ws
+	}
+	return nil
+}
+
+// Contains reports whether word is a root word in the dictionary.
+func (d *Dictionary) Contains(word string) bool {
+	_, ok := d.load()[word]
+	return ok
+}
+
+// Len returns the number of root words in the dictionary.
+func (d *Dictionary) Len() int {
+	return len(d.load())
+}
+
+// Add adds root words to the dictionary. Empty words are ignored.
+func (d *Dictionary) Add(words ...string) {
+	d.update(func(ws wordSet) {
+		for _, w := range words {
+			if w != "" {
+				ws[w] = struct{}{}
+			}
+		}
+	})
+}
+
+// Remove removes root words from the dictionary.
+func (d *Dictionary) Remove(words ...string) {
+	d.update(func(ws wordSet) {
+		for _, w := range words {
+			delete(ws, w)
+		}
+	})
+}
+
+// Replace atomically replaces every root word in the dictionary.
+// Empty words are ignored.
+func (d *Dictionary) Replace(words ...string) {
+	ws := make(wordSet, len(words))
+	for _, w := range words {
+		if w != "" {
+			ws[w] = struct{}{}
+		}
+	}
+
+	d.mu.Lock()
+	d.words.Store(&ws)
+	d.mu.Unlock()
+}
+
+// clone returns a new Dictionary with the root words of d.
+// Word sets are never modified once published, so it shares
+// the current set until either dictionary changes.
+func (d *Dictionary) clone() *Dictionary {
+	c := &Dictionary{}
+	c.words.Store(d.words.Load())
+	return c
+}
+
+// update applies fn to a copy of the word set and publishes the copy.
+func (d *Dictionary) update(fn func(ws wordSet)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	old := d.load()
+	ws := make(wordSet, len(old))
+	for w := range old {
+		ws[w] = struct{}{}
+	}
+	fn(ws)
+	d.words.Store(&ws)
+}
+
+// ErrEmptyDictionary is returned when a dictionary source contains no root words.
+var ErrEmptyDictionary = errors.New("stemmer: empty dictionary")
+
+// maxDictionaryLine is the longest line LoadDictionary accepts. It is
+// large enough for a whole dictionary on one space-separated line,
+// as in the embedded root words.
+const maxDictionaryLine = 16 << 20
+
+// LoadDictionary reads root words from r. Words may be separated by
+// spaces or newlines; each one is lowercased and must consist of
+// letters a-z with optional inner hyphens, as in "abal-abal".
+// Lines may be up to 16 MiB long.
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	var words []string
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxDictionaryLine)
+	for line := 1; sc.Scan(); line++ {
+		for _, w := range strings.Fields(sc.Text()) {
+			w = strings.ToLower(w)
+			if !isValidRootWord(w) {
+				return nil, fmt.Errorf("stemmer: malformed root word %q on line %d", w, line)
+			}
+			words = append(words, w)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(words) == 0 {
+		return nil, ErrEmptyDictionary
+	}
+	return NewDictionary(words...), nil
+}
+
+// LoadDictionaryFile reads root words from the named file.
+// See LoadDictionary for the accepted format.
+func LoadDictionaryFile(name string) (*Dictionary, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadDictionary(f)
+}
+
+// LoadDictionaryFS reads root words from the named file in fsys.
+// See LoadDictionary for the accepted format.
+func LoadDictionaryFS(fsys fs.FS, name string) (*Dictionary, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadDictionary(f)
+}
+
+// isValidRootWord reports whether w only has letters a-z
+// and hyphens that join two non-empty parts.
+func isValidRootWord(w string) bool {
+	if w == "" || w[0] == '-' || w[len(w)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(w); i++ {
+		c := w[i]
+		if (c < 'a' || c > 'z') && c != '-' {
+			return false
+		}
+		if c == '-' && w[i-1] == '-' {
+			return false
+		}
+	}
+	return true
+}
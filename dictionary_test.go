@@ -0,0 +1,309 @@
+package stemmer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+func TestNewDictionary(t *testing.T) {
+	d := NewDictionary("cinta", "", "makan")
+	if d.Len() != 2 {
+		t.Error(d.Len())
+	}
+	if !d.Contains("cinta") || d.Contains("") {
+		t.Error("cinta")
+	}
+}
+
+func TestDefaultDictionary(t *testing.T) {
+	d := DefaultDictionary()
+	if !d.Contains("cinta") || d.Contains("mencintai") {
+		t.Error("cinta")
+	}
+}
+
+func TestNewWithDictionary(t *testing.T) {
+	testCases := []struct {
+		word     string
+		baseWord string
+	}{
+		{"mencintai", "cinta"},
+		{"memakan", "makan"},
+		{"berlari", "berlari"},
+	}
+
+	s := NewWithDictionary(NewDictionary("cinta", "makan"))
+	for _, tc := range testCases {
+		out := s.Stemm(tc.word)
+		if out[0] != tc.baseWord {
+			t.Error(tc.word, tc.baseWord, out[0])
+		}
+	}
+
+	if New().dictionary() != rootWords.Load() {
+		t.Error("New should use the shared dictionary")
+	}
+}
+
+func TestLoadDictionary(t *testing.T) {
+	testCases := []struct {
+		in  string
+		len int
+		err bool
+	}{
+		{"makan\ncinta\n", 2, false},
+		{"makan cinta  minum\r\nabal-abal", 4, false},
+		{"Makan", 1, false},
+
+		{"", 0, true},
+		{" \n\n", 0, true},
+		{"makan c1nta", 0, true},
+		{"makan\n-cinta", 0, true},
+		{"abal--abal", 0, true},
+	}
+
+	for _, tc := range testCases {
+		d, err := LoadDictionary(strings.NewReader(tc.in))
+		if (err != nil) != tc.err {
+			t.Error(tc.in, err)
+			continue
+		}
+		if err == nil && d.Len() != tc.len {
+			t.Error(tc.in, d.Len())
+		}
+	}
+
+	if _, err := LoadDictionary(strings.NewReader("")); err != ErrEmptyDictionary {
+		t.Error(err)
+	}
+}
+
+func TestLoadDictionaryLongLine(t *testing.T) {
+	d, err := LoadDictionary(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != DefaultDictionary().Len() {
+		t.Error(d.Len(), DefaultDictionary().Len())
+	}
+}
+
+func TestLoadDictionaryFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "rootwords.txt")
+	if err := os.WriteFile(name, []byte("makan\ncinta\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := LoadDictionaryFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Contains("makan") || !d.Contains("cinta") {
+		t.Error(name)
+	}
+
+	if _, err := LoadDictionaryFile(name + ".missing"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestLoadDictionaryFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"id/rootwords.txt": {Data: []byte("makan cinta")},
+	}
+
+	d, err := LoadDictionaryFS(fsys, "id/rootwords.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != 2 {
+		t.Error(d.Len())
+	}
+
+	if _, err := LoadDictionaryFS(fsys, "en/rootwords.txt"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestDictionaryAddRemove(t *testing.T) {
+	d := NewDictionary("cinta")
+	d.Add("makan", "")
+	if !d.Contains("makan") || d.Len() != 2 {
+		t.Error("makan")
+	}
+
+	d.Remove("cinta", "minum")
+	if d.Contains("cinta") || d.Len() != 1 {
+		t.Error("cinta")
+	}
+}
+
+func TestStemmerAddRemoveRootWords(t *testing.T) {
+	s := NewWithDictionary(DefaultDictionary())
+
+	// "kediri" is over-stripped to "diri" until it is known as a root.
+	if out := s.Stemm("kediri"); out[0] == "kediri" {
+		t.Fatal(out[0])
+	}
+
+	s.AddRootWords("kediri")
+	if !s.IsRootWord([]byte("kediri")) {
+		t.Error("kediri")
+	}
+	if out := s.Stemm("kediri"); out[0] != "kediri" {
+		t.Error(out[0])
+	}
+
+	s.RemoveRootWords("kediri")
+	if s.IsRootWord([]byte("kediri")) {
+		t.Error("kediri")
+	}
+	if New().IsRootWord([]byte("kediri")) {
+		t.Error("shared dictionary must not change")
+	}
+}
+
+func TestStemmerAddRootWordsShared(t *testing.T) {
+	s := New()
+	s.AddRootWords("kediri")
+	if !s.IsRootWord([]byte("kediri")) {
+		t.Error("kediri")
+	}
+	if New().IsRootWord([]byte("kediri")) || rootWords.Load().Contains("kediri") {
+		t.Error("shared dictionary must not change")
+	}
+
+	InitRootWords()
+	if !s.IsRootWord([]byte("kediri")) {
+		t.Error("InitRootWords must not discard the added words")
+	}
+
+	s = New()
+	s.RemoveRootWords("cinta")
+	if s.IsRootWord([]byte("cinta")) || !New().IsRootWord([]byte("cinta")) {
+		t.Error("cinta")
+	}
+
+	s = New()
+	s.Dictionary().Add("kediri")
+	if !s.IsRootWord([]byte("kediri")) || New().IsRootWord([]byte("kediri")) {
+		t.Error("Dictionary must not return the shared dictionary")
+	}
+}
+
+func TestDictionaryConcurrentUse(t *testing.T) {
+	s := NewWithDictionary(DefaultDictionary())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Stemm("mencintai", "kediri")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				s.AddRootWords("kediri")
+				s.RemoveRootWords("kediri")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDictionaryReplace(t *testing.T) {
+	d := NewDictionary("cinta")
+	d.Replace("makan", "minum", "")
+	if d.Contains("cinta") || !d.Contains("makan") || d.Len() != 2 {
+		t.Error(d.Len())
+	}
+
+	var zero Dictionary
+	if zero.Contains("cinta") || zero.Len() != 0 {
+		t.Error("zero Dictionary should be empty")
+	}
+	zero.Add("cinta")
+	if !zero.Contains("cinta") {
+		t.Error("cinta")
+	}
+}
+
+func TestStemmerSetDictionary(t *testing.T) {
+	s := NewWithDictionary(NewDictionary("cinta"))
+	if out := s.Stemm("memakan"); out[0] == "makan" {
+		t.Error(out[0])
+	}
+
+	s.SetDictionary(NewDictionary("makan"))
+	if out := s.Stemm("memakan"); out[0] != "makan" {
+		t.Error(out[0])
+	}
+
+	s.SetDictionary(nil)
+	if s.dictionary() != rootWords.Load() {
+		t.Error("nil dictionary should fall back to the shared one")
+	}
+}
+
+// The tests below are meant to be run with the race detector:
+//
+//	go test -race -run Reload
+
+func TestStemmReloadInitRootWords(t *testing.T) {
+	s := New()
+	hammer(t, s, func() { InitRootWords() })
+}
+
+func TestStemmReloadSetDictionary(t *testing.T) {
+	s := NewWithDictionary(DefaultDictionary())
+	hammer(t, s, func() { s.SetDictionary(DefaultDictionary()) })
+}
+
+func TestStemmReloadReplace(t *testing.T) {
+	d := DefaultDictionary()
+	words := strings.Split(data, " ")
+	s := NewWithDictionary(d)
+	hammer(t, s, func() { d.Replace(words...) })
+}
+
+// hammer stems from many goroutines while reload runs repeatedly,
+// checking that every result matches one of the loaded dictionaries.
+func hammer(t *testing.T, s *Stemmer, reload func()) {
+	t.Helper()
+
+	const stemmers = 16
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < stemmers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				out := s.Stemm("mencintai", "pembangunan", "keberuntunganmu")
+				if out[0] != "cinta" || out[1] != "bangun" || out[2] != "untung" {
+					t.Error(out)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 5; i++ {
+		reload()
+	}
+	close(done)
+	wg.Wait()
+}
@@ -0,0 +1,267 @@
+package stemmer
+
+// ecsPrefixRules are the prefix rules of Enhanced Confix Stripping,
+// numbered as in Arifin et al. The first matching rule is applied.
+// A rule matching "P" only matches when the next letters are not "er".
+var ecsPrefixRules = []prefixRule{
+	rule(`^di\S{1,}`, strip("di")),
+	rule(`^ke\S{1,}`, strip("ke")),
+	rule(`^se\S{1,}`, strip("se")),
+
+	// 1. berV -> ber-V | be-rV
+	rule(`^ber[aiueo]`, strip("ber"), recode("ber", "r")),
+	// 2. berCAP -> ber-CAP
+	rule(`^ber[^aiueor][a-z]([^e]|e([^r]|$)|$)`, strip("ber")),
+	// 3. berCAerV -> ber-CAerV
+	rule(`^ber[^aiueor][a-z]er[aiueo]`, strip("ber")),
+	// 4. belajar -> bel-ajar
+	rule(`^belajar`, strip("bel")),
+	// 5. beC1erC2 -> be-C1erC2
+	rule(`^be[^aiueolr]er[^aiueo]`, strip("be")),
+
+	// 6. terV -> ter-V | te-rV
+	rule(`^ter[aiueo]`, strip("ter"), recode("ter", "r")),
+	// 7. terCerV -> ter-CerV
+	rule(`^ter[^aiueor]er[aiueo]`, strip("ter")),
+	// 8. terCP -> ter-CP
+	rule(`^ter[^aiueor]([^e]|e([^r]|$)|$)`, strip("ter")),
+	// 9. teC1erC2 -> te-C1erC2
+	rule(`^te[^aiueor]er[^aiueo]`, strip("te")),
+	// 35. terC1erC2 -> ter-C1erC2
+	rule(`^ter[^aiueor]er[^aiueo]`, strip("ter")),
+
+	// 10. me{l|r|w|y}V -> me-{l|r|w|y}V
+	rule(`^me[lrwy][aiueo]`, strip("me")),
+	// 11. mem{b|f|v} -> mem-{b|f|v}
+	rule(`^mem[bfv]`, strip("mem")),
+	// 12. mempe -> mem-pe
+	rule(`^mempe`, strip("mem")),
+	// 13. mem{rV|V} -> me-m{rV|V} | me-p{rV|V}
+	rule(`^mem(r[aiueo]|[aiueo])`, recode("mem", "m"), recode("mem", "p")),
+	// 14. men{c|d|j|s|t|z} -> men-{c|d|j|s|t|z}
+	rule(`^men[cdjstz]`, strip("men")),
+	// 15. menV -> me-nV | me-tV
+	rule(`^men[aiueo]`, recode("men", "n"), recode("men", "t")),
+	// 16. meng{g|h|q|k} -> meng-{g|h|q|k}
+	rule(`^meng[ghqk]`, strip("meng")),
+	// 17. mengV -> meng-V | meng-kV | menge-C | me-ngV
+	rule(`^meng[aiueo]`, strip("meng"), recode("meng", "k"), strip("menge"), recode("meng", "ng")),
+	// 18. menyV -> meny-sV | me-nyV
+	rule(`^meny[aiueo]`, recode("meny", "s"), strip("me")),
+	// 19. mempA -> mem-pA where A != e
+	rule(`^memp[^e]`, strip("mem")),
+
+	// 20. pe{w|y}V -> pe-{w|y}V
+	rule(`^pe[wy][aiueo]`, strip("pe")),
+	// 21. perV -> per-V | pe-rV
+	rule(`^per[aiueo]`, strip("per"), recode("per", "r")),
+	// 23. perCAP -> per-CAP
+	rule(`^per[^aiueor][a-z]([^e]|e([^r]|$)|$)`, strip("per")),
+	// 24. perCAerV -> per-CAerV
+	rule(`^per[^aiueor][a-z]er[aiueo]`, strip("per")),
+	// 25. pem{b|f|v} -> pem-{b|f|v}
+	rule(`^pem[bfv]`, strip("pem")),
+	// 26. pem{rV|V} -> pe-m{rV|V} | pe-p{rV|V}
+	rule(`^pem(r[aiueo]|[aiueo])`, recode("pem", "m"), recode("pem", "p")),
+	// 27. pen{c|d|j|s|t|z} -> pen-{c|d|j|s|t|z}
+	rule(`^pen[cdjstz]`, strip("pen")),
+	// 28. penV -> pe-nV | pe-tV
+	rule(`^pen[aiueo]`, recode("pen", "n"), recode("pen", "t")),
+	// 29. peng{g|h|q|k} -> peng-{g|h|q|k}
+	rule(`^peng[ghqk]`, strip("peng")),
+	// 30. pengV -> peng-V | peng-kV | penge-C
+	rule(`^peng[aiueo]`, strip("peng"), recode("peng", "k"), strip("penge")),
+	// 31. penyV -> peny-sV | pe-nyV
+	rule(`^peny[aiueo]`, recode("peny", "s"), strip("pe")),
+	// 32. pelV -> pe-lV, except pelajar -> ajar
+	rule(`^pel[aiueo]`, guarded("pelajar", "pel"), strip("pe")),
+	// 33. peCerV -> pe-CerV
+	rule(`^pe[^aiueorwylmn]er[aiueo]`, strip("pe")),
+	// 34. peCP -> pe-CP
+	rule(`^pe[^aiueorwylmn]([^e]|e([^r]|$)|$)`, strip("pe")),
+	// 36. peC1erC2 -> pe-C1erC2
+	rule(`^pe[^aiueorwylmn]er[^aiueo]`, strip("pe")),
+}
+
+// ecsRemoval records one affix removed from a word.
+type ecsRemoval struct {
+	subject []byte
+	result  []byte
+	affix   Affix
+}
+
+// confixStripping is a word being stemmed by Enhanced Confix Stripping.
+type confixStripping struct {
+	st       *stemming
+	word     []byte
+	removals []ecsRemoval
+}
+
+// confixStrippingProcess stems word with Enhanced Confix Stripping
+// and returns word itself when no root word is found.
+func (st *stemming) confixStrippingProcess(word []byte) candidate {
+	c := &confixStripping{st: st, word: word}
+	if !c.stem() {
+		return candidate{word: word}
+	}
+
+	root := candidate{word: c.word}
+	for _, r := range c.removals {
+		root.affixes[r.affix.Kind] = r.affix
+	}
+	return root
+}
+
+func (c *confixStripping) stem() bool {
+	original := c.word
+
+	// confixes with rule precedence lose their prefixes first
+	if c.st.isRulePrecedence(c.word) {
+		if c.removePrefixes() || c.removeSuffixes() {
+			return true
+		}
+		c.st.step("skip", "", original, c.word, "rule precedence: removing the prefixes first gave no root word")
+		c.word, c.removals = original, nil
+	}
+
+	if c.removeSuffixes() || c.removePrefixes() {
+		return true
+	}
+	return c.loopPengembalianAkhiran()
+}
+
+func (c *confixStripping) isRoot() bool {
+	return c.st.isRoot(c.word)
+}
+
+// removeSuffixes removes the particle, the possessive pronoun
+// and the derivation suffix in turn, stopping at a root word.
+func (c *confixStripping) removeSuffixes() bool {
+	return c.removeSuffix(Particle, particles) && c.isRoot() ||
+		c.removeSuffix(PossessivePronoun, possessivePronouns) && c.isRoot() ||
+		c.removeSuffix(DerivationSuffix, derivationSuffixes) && c.isRoot()
+}
+
+func (c *confixStripping) removeSuffix(kind AffixKind, suffixes []string) bool {
+	base, suffix, ok := trimSuffix(c.word, suffixes)
+	if !ok || len(base) == 0 {
+		return false
+	}
+
+	r := ecsRemoval{
+		subject: c.word,
+		result:  base,
+		affix:   Affix{Kind: kind, Value: suffix},
+	}
+	c.st.removed(r.affix, r.subject, r.result)
+	c.removals = append(c.removals, r)
+	c.word = base
+	return true
+}
+
+// removePrefixes removes up to three prefixes, stopping at a root word,
+// at a prefix repeating the previous one, or at a first prefix that
+// forms a disallowed confix with the removed derivation suffix.
+func (c *confixStripping) removePrefixes() bool {
+	previous := ""
+	for i := 0; i < 3; i++ {
+		rule := c.st.findPrefixRule(ecsPrefixRules, c.word)
+		if rule == nil {
+			return false
+		}
+
+		prefix := rule.removals[0].prefix[:2]
+		if prefix == previous {
+			c.st.step("skip", prefix+"-", c.word, nil, "repeated prefix")
+			return false
+		}
+		if suffix := c.derivationSuffix(); i == 0 && suffix != "" && isDisallowedConfix(prefix, suffix) {
+			c.st.step("skip", prefix+"-", c.word, nil, "disallowed confix "+prefix+"-"+suffix)
+			return false
+		}
+		previous = prefix
+
+		kind := FirstPrefix + AffixKind(i)
+		var first *ecsRemoval
+		for _, removal := range rule.removals {
+			stem, ok := removal.apply(c.word)
+			if !ok {
+				continue
+			}
+
+			r := ecsRemoval{
+				subject: c.word,
+				result:  stem,
+				affix:   Affix{Kind: kind, Value: removal.prefix, Recoding: removal.recode},
+			}
+			c.st.removed(r.affix, r.subject, r.result)
+			if c.st.isRoot(stem) {
+				c.removals = append(c.removals, r)
+				c.word = stem
+				return true
+			}
+			if first == nil {
+				first = &r
+			}
+		}
+		if first == nil {
+			return false
+		}
+
+		// no recoding gave a root word, carry on with the first one
+		c.removals = append(c.removals, *first)
+		c.word = first.result
+	}
+	return false
+}
+
+// derivationSuffix returns the derivation suffix removed so far, or "".
+func (c *confixStripping) derivationSuffix() string {
+	for _, r := range c.removals {
+		if r.affix.Kind == DerivationSuffix {
+			return r.affix.Value
+		}
+	}
+	return ""
+}
+
+// loopPengembalianAkhiran puts the removed suffixes back one at a time,
+// last removed first, and removes the prefixes again from each word.
+// A removed "-kan" is first put back as "-k" only.
+func (c *confixStripping) loopPengembalianAkhiran() bool {
+	var suffixes []ecsRemoval
+	for _, r := range c.removals {
+		if !r.affix.Kind.IsPrefix() {
+			suffixes = append(suffixes, r)
+		}
+	}
+
+	for i := len(suffixes) - 1; i >= 0; i-- {
+		r := suffixes[i]
+		if r.affix.Value == "kan" {
+			k := append(r.result[:len(r.result):len(r.result)], 'k')
+			c.st.step("restore", "-k", r.result, k, "")
+			if c.retry(k, suffixes[:i]) {
+				// only the "-an" of "-kan" was a suffix
+				c.removals = append(c.removals, ecsRemoval{
+					subject: r.subject,
+					result:  k,
+					affix:   Affix{Kind: DerivationSuffix, Value: "an"},
+				})
+				return true
+			}
+		}
+		c.st.step("restore", r.affix.String(), r.result, r.subject, "")
+		if c.retry(r.subject, suffixes[:i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// retry restarts prefix removal from word, keeping only the given removals.
+func (c *confixStripping) retry(word []byte, removals []ecsRemoval) bool {
+	c.word = word
+	c.removals = append([]ecsRemoval(nil), removals...)
+	return c.isRoot() || c.removePrefixes()
+}
@@ -0,0 +1,61 @@
+package stemmer
+
+import "testing"
+
+func TestStemmEnhancedConfixStripping(t *testing.T) {
+	testCases := []struct {
+		word     string
+		baseWord string
+	}{
+		{"mencintai", "cinta"},
+		{"memperbaiki", "baik"},
+		{"perekonomian", "ekonomi"},
+		{"kebaikannya", "baik"},
+		{"bertemanlah", "teman"},
+		{"belajar", "ajar"},
+		{"terpercaya", "percaya"},
+		{"mempromosikan", "promosi"},
+		{"penstabilan", "stabil"},
+
+		// mempe-, menge- and penge- recoding
+		{"mempelajari", "ajar"},
+		{"mempekerjakan", "kerja"},
+		{"mengebom", "bom"},
+		{"pengebom", "bom"},
+		{"menyanyi", "nyanyi"},
+
+		// up to three prefixes
+		{"diperbaiki", "baik"},
+		{"dipermainkan", "main"},
+		{"kepemimpinan", "pimpin"},
+		{"seperjuangan", "juang"},
+		{"sepengetahuan", "tahu"},
+
+		// loop pengembalian akhiran
+		{"pelanggan", "langgan"},
+		{"penolakan", "tolak"},
+
+		// no root word is found
+		{"disebarluaskan", "disebarluaskan"},
+	}
+
+	s := New(WithMode(EnhancedConfixStripping))
+	for _, tc := range testCases {
+		out := s.Stemm(tc.word)
+		if out[0] != tc.baseWord {
+			t.Error(tc.word, tc.baseWord, out[0])
+		}
+	}
+}
+
+func TestEnhancedConfixStrippingDisallowedConfix(t *testing.T) {
+	s := New(WithMode(EnhancedConfixStripping))
+
+	// "me-an" may not be decomposed
+	if out := s.Stemm("menulisan"); out[0] != "menulisan" {
+		t.Error(out[0])
+	}
+	if out := s.Stemm("menuliskan"); out[0] != "tulis" {
+		t.Error(out[0])
+	}
+}
@@ -0,0 +1,75 @@
+package stemmer
+
+import "strings"
+
+// StopwordFilter selects when a Stemmer drops stopwords.
+type StopwordFilter int
+
+const (
+	// KeepStopwords stems stopwords like any other word.
+	KeepStopwords StopwordFilter = iota
+
+	// FilterBeforeStemming drops the words that are stopwords,
+	// so "sebagai" is dropped rather than stemmed to "bagai".
+	FilterBeforeStemming
+
+	// FilterAfterStemming drops the words whose stem is a stopword.
+	FilterAfterStemming
+)
+
+// String returns the name of the filter.
+func (f StopwordFilter) String() string {
+	switch f {
+	case KeepStopwords:
+		return "keep"
+	case FilterBeforeStemming:
+		return "before"
+	case FilterAfterStemming:
+		return "after"
+	}
+	return "unknown"
+}
+
+// DefaultStopwords returns a new Dictionary of
+// the embedded Indonesian stopwords.
+func DefaultStopwords() *Dictionary {
+	return NewDictionary(strings.Split(stopwordData, " ")...)
+}
+
+// Stopwords returns the stopwords dropped by s, or nil.
+func (s *Stemmer) Stopwords() *Dictionary {
+	return s.stopwords
+}
+
+// StopwordFilter returns when s drops stopwords.
+func (s *Stemmer) StopwordFilter() StopwordFilter {
+	return s.filter
+}
+
+// IsStopword reports whether word, in any case, is a stopword of s.
+func (s *Stemmer) IsStopword(word string) bool {
+	return s.stopwords != nil && s.stopwords.Contains(strings.ToLower(word))
+}
+
+// Filter returns the words that s keeps, in order. With FilterBeforeStemming
+// the stopwords are dropped, with FilterAfterStemming the words whose stem is
+// a stopword; protected words are always kept. Stemm and Stem never drop a
+// word, so stemming without stopwords takes both calls:
+//
+//	stems := s.Stemm(s.Filter(words...)...)
+func (s *Stemmer) Filter(words ...string) []string {
+	st := s.stemming()
+	kept := []string{}
+	for _, w := range words {
+		lower := strings.ToLower(w)
+		switch {
+		case st.filter == KeepStopwords || st.isProtected(lower):
+		case st.filter == FilterBeforeStemming && st.IsStopword(lower):
+			continue
+		case st.filter == FilterAfterStemming && st.IsStopword(st.root(lower)):
+			continue
+		}
+		kept = append(kept, w)
+	}
+	return kept
+}
@@ -0,0 +1,97 @@
+package stemmer
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefaultStopwords(t *testing.T) {
+	d := DefaultStopwords()
+	for _, w := range []string{"yang", "dan", "di", "ke", "dari", "ini", "sebagai"} {
+		if !d.Contains(w) {
+			t.Error(w)
+		}
+	}
+	if d.Contains("cinta") {
+		t.Error("cinta")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	words := []string{"Buku", "yang", "sebagai", "mencintai", "ITU", "bagaikan"}
+	stems := []string{"buku", "yang", "bagai", "cinta", "itu", "bagai"}
+	testCases := []struct {
+		opts []Option
+		out  []string
+	}{
+		{nil, stems},
+		{[]Option{WithStopwords(nil, KeepStopwords)}, stems},
+		{[]Option{WithStopwords(nil, FilterBeforeStemming)}, []string{"buku", "cinta"}},
+		{[]Option{WithStopwords(NewDictionary("bagai", "itu"), FilterAfterStemming)}, []string{"buku", "yang", "cinta"}},
+		{[]Option{WithStopwords(nil, FilterBeforeStemming), WithProtectedWords(NewDictionary("yang"))}, []string{"buku", "yang", "cinta"}},
+	}
+
+	for _, tc := range testCases {
+		s := New(tc.opts...)
+		if out := s.Stemm(s.Filter(words...)...); !reflect.DeepEqual(out, tc.out) {
+			t.Error(out)
+		}
+		// Stemm never drops a word
+		if out := s.Stemm(words...); !reflect.DeepEqual(out, stems) {
+			t.Error(out)
+		}
+	}
+}
+
+func TestStopwordsRuntime(t *testing.T) {
+	stopwords := NewDictionary("yang")
+	s := New(WithStopwords(stopwords, FilterBeforeStemming))
+	if s.Stopwords() != stopwords || s.StopwordFilter() != FilterBeforeStemming {
+		t.Error(s.StopwordFilter())
+	}
+
+	stopwords.Add("buku")
+	if !s.IsStopword("Buku") || len(s.Filter("buku")) != 0 {
+		t.Error("buku")
+	}
+	if New().IsStopword("yang") {
+		t.Error("yang")
+	}
+}
+
+func TestStemTextStopwords(t *testing.T) {
+	s := New(WithStopwords(nil, FilterBeforeStemming))
+	out := s.StemText("buku yang #yang")
+	want := []Token{
+		{Kind: Word, Text: "buku", Start: 0, End: 4, Stem: "buku"},
+		{Kind: Hashtag, Text: "#yang", Start: 10, End: 15},
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Error(out)
+	}
+
+	var b strings.Builder
+	if err := s.StemStream(context.Background(), strings.NewReader("buku yang dibaca"), &b); err != nil || b.String() != "buku  baca" {
+		t.Error(b.String(), err)
+	}
+}
+
+func TestStopwordFilterString(t *testing.T) {
+	testCases := []struct {
+		in  StopwordFilter
+		out string
+	}{
+		{KeepStopwords, "keep"},
+		{FilterBeforeStemming, "before"},
+		{FilterAfterStemming, "after"},
+		{StopwordFilter(-1), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if tc.in.String() != tc.out {
+			t.Error(tc.in.String())
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/ariefrahmansyah/stemmer
+
+go 1.22
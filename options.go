@@ -0,0 +1,127 @@
+package stemmer
+
+import "fmt"
+
+// Mode selects the algorithm a Stemmer uses to strip affixes.
+type Mode int
+
+const (
+	// NaziefAdriani is the default rule cascade of this package,
+	// based on the algorithm of Nazief and Adriani.
+	NaziefAdriani Mode = iota
+
+	// EnhancedConfixStripping is the Enhanced Confix Stripping algorithm
+	// of Arifin et al. It adds recoding rules for prefixes such as
+	// "mempe-", "menge-" and "penge-", strips up to three prefixes and
+	// puts removed suffixes back one at a time when no root is found.
+	// Words without a root are returned unchanged.
+	EnhancedConfixStripping
+)
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case NaziefAdriani:
+		return "nazief-adriani"
+	case EnhancedConfixStripping:
+		return "ecs"
+	}
+	return "unknown"
+}
+
+// MarshalText encodes the mode as its name.
+func (m Mode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText decodes a mode from its name. See ParseMode.
+func (m *Mode) UnmarshalText(text []byte) error {
+	mode, err := ParseMode(string(text))
+	if err != nil {
+		return err
+	}
+	*m = mode
+	return nil
+}
+
+// ParseMode returns the mode with the given name,
+// "nazief-adriani" or "ecs".
+func ParseMode(name string) (Mode, error) {
+	for _, m := range []Mode{NaziefAdriani, EnhancedConfixStripping} {
+		if name == m.String() {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("stemmer: unknown mode %q", name)
+}
+
+// Option configures a Stemmer created by New.
+type Option func(*Stemmer)
+
+// WithMode makes the Stemmer use the given algorithm.
+func WithMode(mode Mode) Option {
+	return func(s *Stemmer) {
+		s.mode = mode
+	}
+}
+
+// WithCasing makes the Stemmer capitalise its stems as set by casing.
+func WithCasing(casing Casing) Option {
+	return func(s *Stemmer) {
+		s.casing = casing
+	}
+}
+
+// WithStopwords makes the Stemmer drop the given stopwords as set
+// by filter. A nil stopwords uses the embedded stopwords.
+func WithStopwords(stopwords *Dictionary, filter StopwordFilter) Option {
+	return func(s *Stemmer) {
+		if stopwords == nil {
+			stopwords = DefaultStopwords()
+		}
+		s.stopwords, s.filter = stopwords, filter
+	}
+}
+
+// WithProtectedWords makes the Stemmer return the given words, in any
+// case, verbatim. See Stemmer.ProtectedWords.
+func WithProtectedWords(words *Dictionary) Option {
+	return func(s *Stemmer) {
+		s.protected.Store(words)
+	}
+}
+
+// WithOverrides makes the Stemmer take the stems of
+// the words in o from o instead of its rules.
+func WithOverrides(o *Overrides) Option {
+	return func(s *Stemmer) {
+		s.overrides.Store(o)
+	}
+}
+
+// WithCache gives the Stemmer a least recently used cache of
+// the stems of up to size words. A size below 1 disables it.
+func WithCache(size int) Option {
+	return func(s *Stemmer) {
+		s.cache = nil
+		if size > 0 {
+			s.cache = newCache(size)
+		}
+	}
+}
+
+// WithStrict makes the Stemmer return a word unchanged, in its
+// original case, unless its stem is confirmed by the dictionary.
+func WithStrict() Option {
+	return func(s *Stemmer) {
+		s.strict = true
+	}
+}
+
+// WithDictionary makes the Stemmer own dict instead of
+// the shared dictionary. A nil dict keeps the shared one.
+func WithDictionary(dict *Dictionary) Option {
+	return func(s *Stemmer) {
+		s.dict.Store(dict)
+	}
+}
@@ -0,0 +1,100 @@
+package stemmer
+
+import "testing"
+
+func TestNewOptions(t *testing.T) {
+	if m := New().Mode(); m != NaziefAdriani {
+		t.Error(m)
+	}
+
+	dict := NewDictionary("cinta")
+	s := New(WithMode(EnhancedConfixStripping), WithDictionary(dict))
+	if s.Mode() != EnhancedConfixStripping || s.Dictionary() != dict {
+		t.Error(s.Mode())
+	}
+
+	s = NewWithDictionary(dict, WithMode(EnhancedConfixStripping))
+	if s.Mode() != EnhancedConfixStripping || s.Dictionary() != dict {
+		t.Error(s.Mode())
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out Mode
+		err bool
+	}{
+		{"nazief-adriani", NaziefAdriani, false},
+		{"ecs", EnhancedConfixStripping, false},
+
+		{"", NaziefAdriani, true},
+		{"ECS", NaziefAdriani, true},
+		{"unknown", NaziefAdriani, true},
+	}
+
+	for _, tc := range testCases {
+		out, err := ParseMode(tc.in)
+		if out != tc.out || (err != nil) != tc.err {
+			t.Error(tc.in, out, err)
+		}
+
+		var m Mode
+		if err := m.UnmarshalText([]byte(tc.in)); m != tc.out || (err != nil) != tc.err {
+			t.Error(tc.in, m, err)
+		}
+	}
+}
+
+func TestModeString(t *testing.T) {
+	testCases := []struct {
+		in  Mode
+		out string
+	}{
+		{NaziefAdriani, "nazief-adriani"},
+		{EnhancedConfixStripping, "ecs"},
+		{Mode(-1), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if tc.in.String() != tc.out {
+			t.Error(tc.in.String())
+		}
+	}
+}
+
+func TestStemmStrict(t *testing.T) {
+	testCases := []struct {
+		in     string
+		out    string
+		strict string
+	}{
+		{"mencintai", "cinta", "cinta"},
+		{"Cinta", "cinta", "cinta"},
+		{"qwxzvblah", "qwxzvb", "qwxzvblah"},
+		{"xyz-xyz", "xyz", "xyz-xyz"},
+		{"QWXZ", "qwxz", "QWXZ"},
+		{"Mencintai", "cinta", "cinta"},
+	}
+
+	s, strict := New(), New(WithStrict())
+	if s.Strict() || !strict.Strict() {
+		t.Error(s.Strict(), strict.Strict())
+	}
+	for _, tc := range testCases {
+		if out := s.Stemm(tc.in)[0]; out != tc.out {
+			t.Error(tc.in, out)
+		}
+		if out := strict.Stemm(tc.in)[0]; out != tc.strict {
+			t.Error(tc.in, out)
+		}
+	}
+
+	a := strict.Analyze("qwxzvblah")
+	if a.Root != "qwxzvblah" || a.Found || a.Affixes != nil {
+		t.Error(a)
+	}
+	if a := strict.Analyze("Qwerty"); a.Root != "Qwerty" || a.Root != strict.Stemm("Qwerty")[0] {
+		t.Error(a)
+	}
+}
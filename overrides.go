@@ -0,0 +1,156 @@
+package stemmer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Overrides maps words to the stems a Stemmer returns for them instead
+// of applying its rules, to fix words the rules get wrong.
+//
+// Like Dictionary, it is safe for concurrent use: lookups never block
+// and every change copies the table.
+type Overrides struct {
+	mu    sync.Mutex // serializes writers
+	stems atomic.Pointer[stemTable]
+}
+
+type stemTable map[string]string
+
+// NewOverrides returns Overrides with the given word to stem pairs.
+// Words are lowercased; pairs with an empty word or stem are ignored.
+func NewOverrides(stems map[string]string) *Overrides {
+	o := &Overrides{}
+	o.update(func(t stemTable) {
+		for word, stem := range stems {
+			t.set(word, stem)
+		}
+	})
+	return o
+}
+
+// load returns the current table, which must not be modified.
+func (o *Overrides) load() stemTable {
+	if t := o.stems.Load(); t != nil {
+		return *t
+	}
+	return nil
+}
+
+// Stem returns the stem of word, in any case, if it is overridden.
+func (o *Overrides) Stem(word string) (string, bool) {
+	stem, ok := o.load()[strings.ToLower(word)]
+	return stem, ok
+}
+
+// Len returns the number of overridden words.
+func (o *Overrides) Len() int {
+	return len(o.load())
+}
+
+// Set overrides the stem of word.
+func (o *Overrides) Set(word, stem string) {
+	o.update(func(t stemTable) {
+		t.set(word, stem)
+	})
+}
+
+// Delete removes the overrides of words.
+func (o *Overrides) Delete(words ...string) {
+	o.update(func(t stemTable) {
+		for _, w := range words {
+			delete(t, strings.ToLower(w))
+		}
+	})
+}
+
+func (t stemTable) set(word, stem string) {
+	if word != "" && stem != "" {
+		t[strings.ToLower(word)] = strings.ToLower(stem)
+	}
+}
+
+// update applies fn to a copy of the table and publishes the copy.
+func (o *Overrides) update(fn func(t stemTable)) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	old := o.load()
+	t := make(stemTable, len(old))
+	for word, stem := range old {
+		t[word] = stem
+	}
+	fn(t)
+	o.stems.Store(&t)
+}
+
+// LoadOverrides reads overrides from r, one "word<TAB>stem" pair per line.
+// Blank lines and lines starting with "#" are ignored. A word listed
+// twice takes its last stem.
+func LoadOverrides(r io.Reader) (*Overrides, error) {
+	stems := map[string]string{}
+
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, "\t")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("stemmer: malformed override %q on line %d", text, line)
+		}
+		word, stem := strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1])
+		if word == "" || stem == "" {
+			return nil, fmt.Errorf("stemmer: malformed override %q on line %d", text, line)
+		}
+		stems[strings.ToLower(word)] = stem
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewOverrides(stems), nil
+}
+
+// LoadOverridesFile reads overrides from the named TSV file.
+// See LoadOverrides for the accepted format.
+func LoadOverridesFile(name string) (*Overrides, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadOverrides(f)
+}
+
+// LoadOverridesFS reads overrides from the named TSV file in fsys.
+// See LoadOverrides for the accepted format.
+func LoadOverridesFS(fsys fs.FS, name string) (*Overrides, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadOverrides(f)
+}
+
+// Overrides returns the overrides consulted by s, or nil.
+func (s *Stemmer) Overrides() *Overrides {
+	return s.overrides.Load()
+}
+
+// SetOverrides atomically replaces the overrides consulted by s.
+// A nil o removes them.
+func (s *Stemmer) SetOverrides(o *Overrides) {
+	s.overrides.Store(o)
+}
@@ -0,0 +1,91 @@
+package stemmer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadOverrides(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out map[string]string
+		err bool
+	}{
+		{"", map[string]string{}, false},
+		{"# comment\n\nBukunya\tBuku\r\nberikan\tikan\nberikan\tberi\n", map[string]string{"bukunya": "buku", "berikan": "beri"}, false},
+		{"bukunya buku\n", nil, true},
+		{"bukunya\tbuku\tbuk\n", nil, true},
+		{"bukunya\t\n", nil, true},
+	}
+
+	for _, tc := range testCases {
+		o, err := LoadOverrides(strings.NewReader(tc.in))
+		if (err != nil) != tc.err {
+			t.Error(tc.in, err)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(map[string]string(o.load()), tc.out) {
+			t.Error(tc.in, o.load())
+		}
+	}
+}
+
+// TestOverridesFile checks that every fix listed in testdata/overrides.tsv is applied.
+func TestOverridesFile(t *testing.T) {
+	o, err := LoadOverridesFile("testdata/overrides.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mode := range []Mode{NaziefAdriani, EnhancedConfixStripping} {
+		s := New(WithMode(mode), WithOverrides(o))
+		for word, stem := range o.load() {
+			if out := s.Stemm(word)[0]; out != stem {
+				t.Error(mode, word, out)
+			}
+		}
+	}
+}
+
+func TestStemmOverrides(t *testing.T) {
+	s := New(WithCasing(RestoreCase))
+	if out := s.Stemm("Kediri"); out[0] != "Diri" {
+		t.Error(out)
+	}
+
+	s.SetOverrides(NewOverrides(map[string]string{"Kediri": "Kediri", "": "x"}))
+	if out := s.Stemm("Kediri", "KEDIRI"); !reflect.DeepEqual(out, []string{"Kediri", "KEDIRI"}) {
+		t.Error(out)
+	}
+
+	o := s.Overrides()
+	o.Set("bukunya", "buku")
+	o.Delete("KEDIRI")
+	if out := s.Stemm("kediri", "bukunya"); !reflect.DeepEqual(out, []string{"diri", "buku"}) {
+		t.Error(out)
+	}
+	if o.Len() != 1 {
+		t.Error(o.Len())
+	}
+
+	a := s.Analyze("Bukunya")
+	if !reflect.DeepEqual(a, Analysis{Word: "Bukunya", Root: "buku", Overridden: true, Found: true}) {
+		t.Error(a)
+	}
+}
+
+func TestLoadOverridesFS(t *testing.T) {
+	fsys := fstest.MapFS{"fix.tsv": {Data: []byte("bukunya\tbuku\n")}}
+	o, err := LoadOverridesFS(fsys, "fix.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stem, ok := o.Stem("BUKUNYA"); !ok || stem != "buku" {
+		t.Error(stem, ok)
+	}
+	if _, err := LoadOverridesFS(fsys, "missing.tsv"); err == nil {
+		t.Error("missing.tsv")
+	}
+}
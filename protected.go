@@ -0,0 +1,136 @@
+package stemmer
+
+import (
+	"bufio"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// ProtectedWords returns the words s returns verbatim instead of
+// stemming them, such as names and acronyms. The list belongs to s;
+// editing it takes effect immediately. Its words match in any case.
+func (s *Stemmer) ProtectedWords() *Dictionary {
+	if d := s.protected.Load(); d != nil {
+		return d
+	}
+	s.protected.CompareAndSwap(nil, NewDictionary())
+	return s.protected.Load()
+}
+
+// SetProtectedWords atomically replaces the protected words of s,
+// for instance with a list read by LoadProtectedWordsFile.
+func (s *Stemmer) SetProtectedWords(words *Dictionary) {
+	s.protected.Store(words)
+}
+
+// Protect adds words, in any case, to the protected words of s.
+func (s *Stemmer) Protect(words ...string) {
+	s.ProtectedWords().Add(lowerWords(words)...)
+}
+
+// Unprotect removes words, in any case, from the protected words of s.
+func (s *Stemmer) Unprotect(words ...string) {
+	remove := make(wordSet, len(words))
+	for _, w := range lowerWords(words) {
+		remove[w] = struct{}{}
+	}
+	s.ProtectedWords().update(func(ws wordSet) {
+		for w := range ws {
+			if _, ok := remove[strings.ToLower(w)]; ok {
+				delete(ws, w)
+			}
+		}
+	})
+}
+
+// IsProtected reports whether word, in any case, is protected in s.
+func (s *Stemmer) IsProtected(word string) bool {
+	_, ok := s.protectedWords()[strings.ToLower(word)]
+	return ok
+}
+
+// foldedWords is a lowercase copy of one set of protected words.
+type foldedWords struct {
+	src   *wordSet
+	words wordSet
+}
+
+// protectedWords returns the protected words of s in lowercase.
+// Published word sets never change, so the copy is made once and
+// reused until the protected words change.
+func (s *Stemmer) protectedWords() wordSet {
+	d := s.protected.Load()
+	if d == nil {
+		return nil
+	}
+	src := d.words.Load()
+	if src == nil {
+		return nil
+	}
+	if f := s.folded.Load(); f != nil && f.src == src {
+		return f.words
+	}
+
+	words := make(wordSet, len(*src))
+	for w := range *src {
+		words[strings.ToLower(w)] = struct{}{}
+	}
+	s.folded.Store(&foldedWords{src: src, words: words})
+	return words
+}
+
+// LoadProtectedWords reads protected words from r, separated by spaces
+// or newlines. Unlike root words they may have any characters, as in
+// "G20" or "McDonald's". Lines starting with "#" are ignored.
+func LoadProtectedWords(r io.Reader) (*Dictionary, error) {
+	var words []string
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxDictionaryLine)
+	for sc.Scan() {
+		text := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(text, "#") {
+			continue
+		}
+		words = append(words, strings.Fields(text)...)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewDictionary(words...), nil
+}
+
+// LoadProtectedWordsFile reads protected words from the named file.
+// See LoadProtectedWords for the accepted format.
+func LoadProtectedWordsFile(name string) (*Dictionary, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadProtectedWords(f)
+}
+
+// LoadProtectedWordsFS reads protected words from the named file in fsys.
+// See LoadProtectedWords for the accepted format.
+func LoadProtectedWordsFS(fsys fs.FS, name string) (*Dictionary, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return LoadProtectedWords(f)
+}
+
+func lowerWords(words []string) []string {
+	lower := make([]string, len(words))
+	for i, w := range words {
+		lower[i] = strings.ToLower(w)
+	}
+	return lower
+}
@@ -0,0 +1,90 @@
+package stemmer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestStemmProtected(t *testing.T) {
+	s := New(WithCasing(RestoreCase))
+	if out := s.Stemm("Kediri"); out[0] != "Diri" {
+		t.Error(out)
+	}
+
+	s.Protect("PERTAMINA", "Kediri")
+	if !s.IsProtected("pertamina") || !s.IsProtected("KEDIRI") {
+		t.Error(s.ProtectedWords().Len())
+	}
+	if out := s.Stemm("Pertamina", "kediri", "mencintai"); !reflect.DeepEqual(out, []string{"Pertamina", "kediri", "cinta"}) {
+		t.Error(out)
+	}
+	if New().IsProtected("pertamina") {
+		t.Error("protected words are shared")
+	}
+
+	s.Unprotect("kediri")
+	if out := s.Stemm("kediri"); out[0] != "diri" {
+		t.Error(out)
+	}
+}
+
+func TestProtectedWordsCase(t *testing.T) {
+	s := New(WithProtectedWords(NewDictionary("Pertamina", "Kediri")))
+	if out := s.Stemm("kediri", "PERTAMINA"); !reflect.DeepEqual(out, []string{"kediri", "PERTAMINA"}) {
+		t.Error(out)
+	}
+	if !s.IsProtected("Kediri") || !s.IsProtected("kediri") {
+		t.Error("Kediri")
+	}
+
+	s.ProtectedWords().Add("Bekasi")
+	if !s.IsProtected("bekasi") {
+		t.Error("Bekasi")
+	}
+
+	s.Unprotect("KEDIRI")
+	if s.IsProtected("Kediri") || s.ProtectedWords().Contains("Kediri") {
+		t.Error("Unprotect")
+	}
+}
+
+func TestAnalyzeProtected(t *testing.T) {
+	s := New(WithProtectedWords(NewDictionary("bekasi")))
+	out := s.Analyze("Bekasi")
+	if !reflect.DeepEqual(out, Analysis{Word: "Bekasi", Root: "Bekasi", Protected: true}) {
+		t.Error(out)
+	}
+}
+
+func TestProtectedWordsFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "protected.txt")
+	content := "# names\nMedan\nTelkomsel Pertamina\n\nG20 McDonald's\n"
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	words, err := LoadProtectedWordsFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := New()
+	s.SetProtectedWords(words)
+	if s.ProtectedWords() != words {
+		t.Error("SetProtectedWords")
+	}
+	if words.Len() != 5 || words.Contains("#") {
+		t.Error(words.Len())
+	}
+	if out := s.Stemm("Telkomsel", "pertamina", "medan"); !reflect.DeepEqual(out, []string{"Telkomsel", "pertamina", "medan"}) {
+		t.Error(out)
+	}
+	if !s.IsProtected("g20") || !s.IsProtected("McDonald's") {
+		t.Error("G20")
+	}
+
+	if _, err := LoadProtectedWordsFile(name + ".missing"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
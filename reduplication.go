@@ -0,0 +1,102 @@
+package stemmer
+
+import "bytes"
+
+// removeReduplication stems a hyphenated reduplication: full ("buku-buku"),
+// affixed ("berlari-lari", "kemerah-merahan") or rhythmic ("sayur-mayur",
+// "gerak-gerik"). Both halves are stemmed; when they agree their stem is
+// returned, when the stem of one half is the other half that stem, and
+// when the halves rhyme the stem of the first half that is a root word.
+// It reports false for a compound of unrelated words, as in "anti-korupsi".
+func (st *stemming) removeReduplication(word []byte) (candidate, bool) {
+	if bytes.Count(word, []byte("-")) != 1 {
+		return candidate{}, false
+	}
+
+	i := bytes.IndexByte(word, '-')
+	left, right := word[:i], word[i+1:]
+	if len(left) == 0 || len(right) == 0 {
+		return candidate{}, false
+	}
+
+	st.step("rule", "reduplication", word, nil, "")
+	l, lok := st.stemHalf(left)
+	r, rok := st.stemHalf(right)
+	switch {
+	case bytes.Equal(l.word, r.word):
+		// the halves share a confix, as in "ke-" and "-an" of "kemerah-merahan"
+		l.affixes = l.affixes.with(r.affixes)
+		return l, true
+	case bytes.Equal(l.word, right):
+		return l, true
+	case bytes.Equal(r.word, left):
+		return r, true
+	case !isRhythmic(left, right):
+		st.step("skip", "reduplication", word, nil, "the halves are unrelated words")
+		return candidate{}, false
+	case lok:
+		return l, true
+	case rok:
+		return r, true
+	}
+	st.step("skip", "reduplication", word, nil, "neither half has a root word")
+	return candidate{}, false
+}
+
+// isRhythmic reports whether two halves rhyme after their first
+// consonant, as in "sayur-mayur" and "lauk-pauk", or only differ
+// in their vowels, as in "gerak-gerik" and "mondar-mandir".
+func isRhythmic(left, right []byte) bool {
+	if len(left) > 1 && len(right) > 1 && !isVowel(left[0]) && !isVowel(right[0]) &&
+		bytes.Equal(left[1:], right[1:]) {
+		return true
+	}
+
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if left[i] != right[i] && !(isVowel(left[i]) && isVowel(right[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// stemHalf stems one half of a reduplication and
+// reports whether the result is a root word.
+func (st *stemming) stemHalf(half []byte) (candidate, bool) {
+	if st.isRoot(half) {
+		return candidate{word: half}, true
+	}
+
+	// look the half up after each inflection suffix, so the root of
+	// "temannya" is found before "-an" is stripped from "teman"
+	c := candidate{word: half}
+	if base, particle, ok := trimSuffix(c.word, particles); ok && len(base) > 0 {
+		c.word = base
+		c.affixes.add(Particle, particle, "")
+		if st.isRoot(c.word) {
+			return c, true
+		}
+	}
+	if base, pronoun, ok := trimSuffix(c.word, possessivePronouns); ok && len(base) > 0 {
+		c.word = base
+		c.affixes.add(PossessivePronoun, pronoun, "")
+		if st.isRoot(c.word) {
+			return c, true
+		}
+	}
+
+	stem := st.stemWord(half)
+	return stem, st.isRoot(stem.word)
+}
+
+// isVowel reports whether c is one of the vowels a, i, u, e and o.
+func isVowel(c byte) bool {
+	switch c {
+	case 'a', 'i', 'u', 'e', 'o':
+		return true
+	}
+	return false
+}
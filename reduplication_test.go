@@ -0,0 +1,75 @@
+package stemmer
+
+import "testing"
+
+func TestStemmReduplication(t *testing.T) {
+	testCases := []struct {
+		word     string
+		baseWord string
+	}{
+		// full
+		{"buku-buku", "buku"},
+		{"orang-orangan", "orang"},
+		// affixed
+		{"berlari-lari", "lari"},
+		{"kemerah-merahan", "merah"},
+		{"anak-anaknya", "anak"},
+		{"teman-temannya", "teman"},
+		{"buku-bukulah", "buku"},
+		{"sebaik-baiknya", "baik"},
+		{"tolong-menolong", "tolong"},
+		// rhythmic
+		{"sayur-mayur", "sayur"},
+		{"gerak-gerik", "gerak"},
+		{"lauk-pauk", "lauk"},
+		{"tindak-tanduk", "tindak"},
+		// reduplicated root words
+		{"abal-abal", "abal-abal"},
+		{"bolak-balik", "bolak-balik"},
+		// compounds of unrelated words
+		{"anti-korupsi", "anti-korupsi"},
+		{"kerja-sama", "kerja-sama"},
+		{"Jakarta-Bandung", "jakarta-bandung"},
+	}
+
+	for _, mode := range []Mode{NaziefAdriani, EnhancedConfixStripping} {
+		s := New(WithMode(mode))
+		for _, tc := range testCases {
+			out := s.Stemm(tc.word)
+			if out[0] != tc.baseWord {
+				t.Error(mode, tc.word, tc.baseWord, out[0])
+			}
+		}
+	}
+}
+
+func TestRemoveReduplication(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out string
+		ok  bool
+	}{
+		{"buku-buku", "buku", true},
+		{"xyz-xyz", "xyz", true},
+		{"sayur-mayur", "sayur", true},
+		{"gerak-gerik", "gerak", true},
+
+		{"buku", "", false},
+		{"-buku", "", false},
+		{"buku-", "", false},
+		{"buku-buku-buku", "", false},
+		{"qwx-zvb", "", false},
+		{"qwx-pwx", "", false},
+		{"anti-korupsi", "", false},
+		{"kerja-sama", "", false},
+		{"jakarta-bandung", "", false},
+	}
+
+	s := New().stemming()
+	for _, tc := range testCases {
+		out, ok := s.removeReduplication([]byte(tc.in))
+		if ok != tc.ok || string(out.word) != tc.out {
+			t.Error(tc.in, string(out.word), ok)
+		}
+	}
+}
@@ -1,3 +1,3 @@
 package stemmer
 
-var data = `aba abad abadi abadiah abah abai abaimana abaka abaktinal abakus abal-abal aban abang abangan abangga abar abatoar abau abdas abdi abdikasi abdomen abdominal abdu abduksi abduktor abece aben aberasi abet abian abid abidin abilah abing abiogenesis abiosfer abiotik abis abisal abiseka abiturien abjad abjadiah ablasi ablaut ablepsia abnormal abnormalitas abnus aboi abolisi abon abonemen abong-abong aborsi abortif abortiva abortus abrak abrakadabra abrar abras abrasi abreaksi abrek abreviasi abrikos abrit-abrit abrosfer absah absen absensi absensia absente absenteisme abses absis absolusi absolut absolutisme absonan absorb absorben absorbir absorpsi absorpsiometer absorptif abstain abstinensi abstrak abstraksi absurd absurdisme abtar abu abuan abuh abuk abulhayat abulia abun-abun abur abus abyad acah acak acala acan acang acap acar acara acaram acat acau acawi acerang aci acik aco acu acuh acum acung ada adab adad adagio adagium adakala adakalanya adakan adaks adaktil adalah adalat adam adan adang adap adaptabel adaptabilitas adaptasi adaptif adaptometer adaptor adapun adar adas adat adati adegan adeh adekuat adem adempauze adendum adenoid adenoma adenosis adhesi adhesif adi adiabatik adiabatis adiaktinik adib adibangkit adibintang adiboga adibusana adicita adidaya adigang adiguna adigung adik adika adikara adikarya adikodrati adikong adiksi adiktif adikuasa adil adiluhung adimarga adinda ading adipati adipenghantar adiposa adipositas adipura adiraja adiratna adisi adisional adisiswa aditif aditokoh adiwangsa adiwarna adiwidia adjektif adjektiva adjektival adjuvan administrasi administratif administrator admiral admisi admitans adnan adolesens adon adopsi adoptif adpertensi adrenal adrenalin adrenergik adres adsorben adsorpi adsorpsi adstringen adu aduh aduhai aduk aduksi aduktor adun adveksi adven adventisia adventisius adverbia adverbial advertensi advis advokad advokasi advokat aerasi aerator aerob aerobatik aerobik aerobika aerodinamik aerodinamika aerofisika aerofisiologi aerofon aerogram aerolit aerologi aerometer aeromovel aeronautika aeroplangton aeroplankton aeroskop aerosol aerostat aerostatika aestetika afair afal afasia afdal afdeling afdruk afek afeksi afektif aferesis afiat afidavit afiks afiksasi afiliasi afinitas afirmasi afirmatif afonia aforisme afotik afrasia afrikat afrit afrodisiak afsun afwah aga agah agak agak-agih agal agalaksia agam agama agamais agamawi agamen agamet agami agamogenesis agan agape agar agas agen agenda agens agentif agih agil agio agiria agitasi agitatif agitator aglikon aglomerasi aglomerat aglutinasi aglutinat aglutinatif aglutinin agnosia agnostik agnostisisme agogo agometer agon agonia agonis agonistik agorafobia agradasi agrafia agraria agraris agregasi agregat agregatif agresi agresif agresivitas agresor agriologi agripnia agrisilvikultur agrobis agrobisnis agroekonomi agroekosistem agrogeologi agroikos agroindustri agrokimia agronom agronomi agrostologi agrowisata aguk agul agun agung agus agustus agut ahad ahadiat ahimsa ahistoris ahkam ahlan ahli ahlulbait ahlulkitab ahlulkubur ahlunujum ahlusuluk ahlusunah ahmak ahmar aho ahsan ahwal aib ain ainulbanat ainulyakin ainunjariah air aja ajab ajaib ajaibkhanah ajak ajal ajang ajar ajek ajektifa ajektiva ajengan aji ajigineng ajimumpung ajir ajisaka ajnabi ajnas ajojing ajre aju ajudan ajufan ajujah ajuk ajun ajung ajur ajuster akad akademi akademik akademikus akademis akademisi akaid akak akal akan akang akapela akar akas akasia akatalepsia akbar akene akeo akh akhbar akhir akhirat akhirulkalam akhlak akhlaki akhwan aki akibat akidah akik akikah akil aklamasi aklasia akli akliah aklimasi aklimatisasi akmal akolade akomodasi akomodatif akor akordeon akrab akrawati akreditasi akriflavina akrilat akrilik akrobat akrobatik akrofobia akromegali akrometer akromion akronim aksa aksara akseleran akselerasi akselerator akselerometer aksen aksentologi aksentuasi aksep akseptabel akseptabilitas akseptasi akseptor akses aksesibilitas aksesori aksi aksidental aksila aksiologi aksioma aksiomatis aksis akson aksostil akta aktentas aktif akting aktinida aktinik aktinisme aktinium aktinokimia aktinolit aktinometer aktiva aktivis aktivisme aktivitas aktor aktris aktual aktualisasi aktuaria aktuaris aku akua akuades akuaduk akuakultur akualung akuamarin akuan akuarel akuaris akuarium akuarius akuatik akuifer akuisisi akuk akulturasi akumulasi akumulatif akumulator akun akuntabel akuntabilitas akuntan akuntansi akupungtur akupungturis akupunktur akur akurasi akurat akusatif akustik akustika akut akwal ala alabangka alabio alaf alai alai-belai alaihisalam alaika alaikum alak alalia alam alamah alamak alamanda alamas alamat alamatulhayat alami alamiah alamin alan-alan alang alangkah alantois alap alarm alas alat alau alawar alazon albanat albas albatros albedo albedometer albinisme albino albinoid albit albuginea album albumen albumin albuminoit albuminometer albuminuria aldehida alegori alegoris aleksandrit aleksia aleksin alel alem alergen alergi alergis alf alfa alfabet alfabetis alfaktorius alfanumerik alferes alga algilik algoid algojo algologi algometer algoritme algrafi alhamdulillah alhasil ali aliansi alias aliase alibi alienasi alif alifatik alifbata alih alihragam alik alikuot alim alimbubu alimentasi alimenter alimiah alimiat alimun alin alinea aling alip alir alis alisiklik alit aliterasi alizarin aljabar aljalil alkabir alkadim alkadir alkah alkali alkalinitas alkalis alkaloid alkalometri alkamar alkana alkari alkasyaf alkausar alkena alkil alkimia alkisah alkitab alkohol alkoholis alkoholisasi alkoholisme alkoholometer alku alkuna alkus allah allahu allahuma almaktub almalik almalun almamater almanak almandin almandina almandit almarhum almarhumah almasih almuazam almukhlis alobar alofon alograf aloi alokasi alokron aloleks alomerisme alomorf alon alonim alopati alopesia alot alotropi alpa alpaka alperes alpukah alquran altar alter alteratif alternasi alternatif alternator altimeter alto altokumulus altostratus altruis altruisme altruistis alu alufiru alum alumina aluminium alumni alumnus alun alung alup alur alusi aluvial aluvium alveolar alveolum alveolus alwah alwasi alwasia ama amabakdu amah amai-amai amal amalgam amalgamasi amalgamator amaliah aman amanah amanat amandel amandemen amang amanitin amar amarilis amat amatir amatirisme amatol ambah ambah-ambah ambai ambai-ambai ambak ambal ambalang ambalela ambang ambar ambarau ambasade ambasador ambau ambeien ambek amben amberal ambergris amberit ambet ambi ambigu ambiguitas ambil ambilingual ambin ambing ambisi ambisius ambivalen ambivalensi amblas amblek ambles ambliobia amboi ambreng-ambrengan ambring ambrol ambruk ambu-ambu ambuh ambul ambulakral ambulans ambulatori ambung ambur amburadul ambyar ameba amebiasis ameboid amebosit ameliorasi amelioratif amen amendemen amenorea amensalisme amenta amerisium amerospora amerta ametabola ametis ametobola amfetamin amfiartrosis amfibi amfibol amfibolisme amfiston amfiteater amfoterik ami amigdalin amikal amil amilase amilopektin amin amina aminisasi amino amir amirulbahar amirulhaj amirulmukminin amis amit amitosir amko ammeter amnesia amnesti amnion amniotik amoi amonia amonifikasi amonit amonium amor amoral amorf amortisasi ampai ampang ampas ampat ampe ampean ampek ampel ampela ampelam ampelas ampere amperemeter amperometri ampisilin amplas amplifikasi amplitudo amplop ampo amprok amprung ampu ampuh ampuk ampul ampula ampun amput amputasi amra amril amsal amtenar amuba amuh amuk amulet amung amunisi ana anabasis anabiosis anabolisme anadrom anaerob anaerobik anafilaksis anafora anaforis anafrodisiak anaglif anai-anai anak anakoluton anakronisme anakronistis anakrus anal analekta analeptik analgesik analis analisis analitis analog analogi analseks analsit anamel anamnesis anamorfosis ananda anang anani anapes anaptiksis anarki anarkis anarkisme anarkistis anasional anasir anastomosis anatase anatomi anatomis anbia anca ancai ancak ancala ancam ancang ancar-ancar ancoa ancol ancuk anda andai andak andaka andal andalas andalusit andam andan andang andapita andar andarah andeng-andeng anderak andesit andewi andika andiko andil andilau andok andong andragogi andrawina androgen androgini androgogi androlog andrologi anduh andur aneh aneka anekdot aneksasi anemer anemia anemofili anemograf anemogram anemometer aneroid anestesi anestesia anestesiolog anestetis aneuploid aneurisme anfas angah angan angel angga anggai anggak anggal anggan anggap anggar anggara anggau anggerka anggit angglap anggorokasih anggota anggrek angguh angguk anggul anggun anggung anggung-anggip anggur anggut anghun angin angina angiogenesis angiologi angiosperma angit angka angkak angkal-angkal angkara angkasa angkat angkel angker angket angkin angkit angklung angkong angkring angku angkuh angkuk angkul-angkul angkup angkur angkus angkusa angkut angler anglo anglong anglung angon angop angot angpau angsa angsana angsoka angsu angsur angus angut anhidrosis ani aniaya anil anilina animasi animis animisme animo aning-aning anion aniridia anis anisogamet anisogami anisokoria anisotropis anja anjak anjal anjang anjangkarya anjangsana anjar anjiman anjing anjir anjlok anju anjung anjur ankilosis anoa anode anodin anofeles anoksemia anoksik anom anomali anomi anonim anonimitas anoreksia anorganik anorgastik anortopia anosmia anotasi ansa ansambel ansar ansari anta antagonis antagonisme antagonistis antah antah-berantah antakesuma antalas antalkali antamir antan antap antar antara antarbangsa antarbenua antardaerah antari antariksa antarkelompok antarlingkungan antarmaster antarmolekul antarmuka antarnegara antarplanet antarpribadi antarpulau antarras antarruang antarsel antarsuku antartika antarwilayah antasid antasida antawacana ante antedilivium antediluvium antefiks antek anteken antelas anteliks antelmintik antelop antem antemeridiem antena antenatal anteng antenul antep anter antera anteridium anterior antero anteseden anti antianemia antiartritik antibarion antibeku antibiosis antibiotik antibodi antidepresan antidioksida antidiuretik antidot antienzim antiflogistik antigen antigravitasi antih antihistamin antijasad antik antikarat antikatalis antiklimaks antiklin antiklinal antiklor antikonsepsi antikristus antikuari antikuariat antimateri antimetari antimonium antimuntah anting antinomi antinovel antipartikel antipati antipenawar antipiretik antipode antiproton antipruritik antisemitisme antisepsis antiseptik antisiklogenesis antisiklon antisiklonal antisiklonis antisimpul antisipasi antisipatif antitank antitesis antitoksin antitragus antiwirawan antizarah antoi antologi antonim antonimi antop antosian antraknosa antraks antrasena antrasian antrasit antre antri antromometer antromorfis antropobiologi antropoda antropofagi antropogeografi antropoid antropolog antropologi antropometer antropomorfisme antroponimi antroposentris antroposentrisme antroposofi antuk antul antun antung-antung antup anturium antusias antusiasme anu anual anugerah anuitas anulir anulus anumerta anunasika anuresis anuria anus anuswara anut anyak anyam anyang anyar anyel anyelir anyep anyes anyik anyir aorta apa apabila apak apakah apakala apalagi apam apanase apar aparat aparatur aparatus apartemen apartemenisasi apartheid apartotel apas apati apatis apatride ape apek apel apelativa apendektomi apendiks apendisitis apepsi apersepsi apes aphelion apheliotropisme api apik apikal apikultur apilan aping apion apit apium apkir aplasi aplaus aplikasi aplikatif aplus apnea apo apodal apoenzim apogami apoge apograf apok apokalips apokaliptik apokope apokrifa apokrin apokromatik apolitis apologetika apologetis apologi apologia apomiksis apomorfin apopleksi aposematik aposiopesis aposisi aposisional apositif apostasi apostel aposteriori apostolik apostrof apotek apoteker apotik apraksi apraksia apresiasi apresiatif apresorium april apriori aprit apron apsara apsari aptasi aptiklus apu apuh apung apuran ara arab arababu arabahu arabes arabesk arabika aragonit arah arai arak araknitis araknoid aral aram aramba arang aransemen ararut aras arasy arau arbaa arbab arbei arbiter arbitrase arbitrer arboreal arboretum arborikultur arca arcas ardi are area areal arek arem-arem aren arena areografi areola areometer arerut ares arestasi areta argari argentit argentum argirodit argol argometer argon argot argumen argumentasi argumentatif ari aria aries arif arifin arih arik aril aring ariningsun arip aris arisan aristokrasi aristokrat aristokratis aristotipe arit aritenoid aritmetika arja arkade arkais arkaisme arkan arkati arkegonium arkeolog arkeologi arkeologis arkeozoikum arketipe arkian arkifonem arkileksem arkitraf arktika arku arloji armada arnal arogan arogansi aroma aromatik arombai aron arpus arsenal arsenik arsenikum arsip arsipelago arsir arsis arsitek arsitektur arta artefak arteri arteriografi arteriola arteriosklerosis artesis artetis arti articok artifak artifisial artik artikel artikulasi artikulator artileri artiodaktil artis artisan artistik artisyok artona artotek artrobrankium artropoda aru aruan aruda aruk arumba arun arung arus arwah arwana arya aryaduta arzak asa asabat asabiah asabiyah asad asah asai asak asal asali asam asan asana asap asar asas asasi asbak asbes asbut aseksual asemble asembling asepsis aseptik aseran asese aset asetabulum asetat asetilena asetimeter aseton asfal asfar asfiksia asi asibilan asibilasi asid asidimeter asidosis asih asiklik asil asilabis asimetris asimilasi asimilatif asimtot asimus asin asindeton asing asinyor asiri asisten asistensi askar askariasis askarid askese asket asketisisme askon askriptif asli asma asmara asmaradanta asmaragama asmaraloka asmarandana asnad aso asoi asonansi asong asor asortimen asosial asosiasi asosiatif aspal asparaga asparagus aspartame aspek asperses aspiran aspirasi aspirasional aspirat aspiratif aspirator aspirin asprak asrama asrar asri assalamualaikum asta astadikpala astaga astagfirullah astaka astakona astana astasia astatik astatin astenia astenik astenopia asteositoma aster asteroid astigmatis astra astral astringen astrofisika astrolab astrolog astrologi astronaut astronautika astronom astronomi astronomis astrosit asu asuh asumsi asumtif asung asurador asuransi asusila aswa aswad aswasada asyera asyik asytoret asyura atak ataksia atap atar atas atase atau atavisme atebrin ateis ateisme ateistis atelir atensi atenuasi ateret atfal ati atlas atlet atletik atma atman atmolisis atmologi atmometer atmosfer atmosferis ato atok atol atom atomisasi atomistis atop atos atowa atraksi atraktan atraktif atresia atret atribut atributif atrisi atrium atrofi atropin atung atur atus aubade audiensi audio audiofil audiofon audiograf audiogram audiolingual audiologi audiometer audiovisual audit auditor auditorium augmentatif auk auksanometer auksi auksin aula aulia aum aung aur aura aural aurat aurikularia aurora aurum aus auskultasi autad autarki autarkis autentik autentikasi autentisitas autisme autistik auto autobiograf autobiografi autodidak autodidaktik autodin autofon autogami autogen autograf autografi autogram autoklaf autoklastik autokrasi autokrat autokton autoktonos autolisis autolitograf autologi automaton automobil automotif autopsi autoskop autosom autotoksin autotrof autotrofik autotrop autotropik auzubillah avalans aven aversi avertebrata avesta avgas aviari aviasi aviator avifauna avikultur avirulen avitaminosis avokad avontur avonturir avtur avunkulokal awa awaair awaarang awabau awabeku awabulu awabusa awadara awah awahama awai awak awal awalengas awam awamineral awan awanama awang awar awaracun awas awasenjata awat awawarna awet awewe awi awik-awik awin awur awut ayah ayahan ayahanda ayak ayal ayam ayan ayanda ayang-ayang ayap ayar ayat ayatullah ayem ayeng ayid ayit ayo ayom ayu ayuk ayum ayun ayunda ayut azab azal azali azam azan azeotrop azimat azimut aziz azmat azoikum azospermi azurit bab baba babad babah babak babakan babal baban babang babar babaring babas babat babatan babe babesiasis babet babi babil babit bablas babon babu babun babur babut baca bacah bacak bacang bacar bacek bacem bacik bacin baco bacok bacot bacul bacut bad bada badai badak badal badam badan badang badani badar badari badasi badau bade badi badik badminton badong badui baduk badung badur badut baduyut bafta bagai bagaimana bagak bagal bagan bagang bagar bagas bagasi bagat bagau bagea bagi baginda bagong bagor baguk bagul bagur bagus bah bahadur bahaduri bahagia bahak bahala bahalan baham bahan bahana bahang bahar bahara bahari baharu bahas bahasa bahaya bahenol baheula bahimiah bahkan bahri bahrulhayat bahtera bahu bahuku bahwa bahwasanya baiat baid baiduri baik bain bainah bais bait baitulharam baitullah baitulmakdis baitulmakmur baitulmal baitulmukadas baitulmukadis baja bajaj bajak bajan bajang bajar bajau bajetah baji bajigur bajik bajing baju bajul bak baka bakak bakal bakalaureat bakam bakap bakar bakarat bakas bakat bakau bakda bakdahu bakdu bakdul bakelit bakero bakh bakhil baki bakiak bakik bakir bakmi bako bakpao bakpia baksi baksis bakso baktau bakteremia bakteri bakterin bakteriofag bakteriolisis bakteriolog bakteriologi bakteriostatik bakterisida bakti baku bakul bakung bakup bakut bakwan bal bala balabad balad balada balah balai balairung balak balalaika balam balan balang balangkep balans balap balar balas balau balela balen balerina balerong balet balgam balian balig baliho balik baling balistik balistika baliu balkas balkon balneologi balneoterapi balok balon balong balot balsam balseros balu baluarti baluh balui baluk balun balung balur balut bam bambam bambang bambu bambung ban bana banal banang banar banat bancak bancang bancar bancet banci bancik bancuh bancut banda bandan bandang bandar bandara bandarsah bandasrayan bandea bandel bandela bandeng bandering banderol banding bandit banditisme bando bandongan bandos bandot bandrek bandu bandul bandung bandusa bandut bang bangai bangan bangang bangar bangas bangat bangau bangbung banget bangga banggan bangir bangka bangkah bangkai bangkal bangkang bangkar bangkas bangkasan bangkeh bangket bangking bangkir bangkis bangkit bangkong bangkot bangkrut bangku bangkut banglas bangle banglo bango bangor bangpak bangsa bangsai bangsal bangsat bangsi bangun bangus bani banian baning banir banjang banjar banjaran banji banjir banjur bank bankir bantah bantai bantal bantam bantar bantaran bantat bantau banteng banter banting bantu bantun bantut banua banyak banyo banyol banyu banyun banzai bao bap bapa bapak bapakisme bapanda bapang bapet baplang baptis bar bara baraat baragajul barah barai barak barakat barakatuh baran barang barangan barangkali barap baras barat barau-barau barbar barbarisme barbel barber barbital barbiton barbiturat barbur bardi bare-bare barel bareng barep baret barga bari barid barier barik barikade baring baringan barion baris barisfer barit barita bariton barium barjad barkas barkometer barli barograf barogram barok barologi barometer barometri baron barong barongan barongsai baroskop barotermograf barso barter baru barua baruh baruje baruna barung-barung barusan barut barzakh barzanji bas basa basa-basi basah basal basalioma basanit basat basau basi basil basilari basilika basilus basin basir basirah basis basit baskara baskat basket baskom basmi bastar basuh basung basut bata batagor batai batak batako batal batalion batalyon batang batara batari batas batekeng batel batela baterai bati batih batik batil batila batimetri batin batiplankton batir-batir batis batisfer batok batolit baton batu batuk batun batung bau baud bauk bauksit baun baung baur baureksa bausastra bausuku baut bawa bawab bawah bawak bawal bawang bawasir bawat bawel bawon baya bayak bayam bayan bayang bayangkara bayangkari bayar bayas bayat bayata bayati bayem bayi bayonet bayong bayu bayuh bayun bayung bayur bazar bazoka bea beasiswa beatifikasi bebal beban bebandos bebang bebar bebaru bebas bebat bebe bebek bebekel bebekisme bebel bebenah beber beberapa beberas bebesaran bebi bebodoran bebotoh bebrek bebuyutan becak becek beceng becokok becuk becus beda bedah bedak bedal bedama bedan bedar bedaru bedawi bedaya bedebah bedegap bedegong bedel bedeng bedil bedinde bedo bedol bedudak beduk bedukang bedung beeng bega begadang begah begal begana begana-begini begandering begandring begap begar begawan begini begitu bego begonia begroting begu beguk begundal beha behandel behena bejana bejat bek beka bekah bekakak bekakas bekal bekam bekantan bekap bekas bekat bekatul bekel beken beker bekerma bekicot bekil beking bekisar bekleding bekles beklit beksan beku bekuk bekuku bekukung bel bela belabas belacak belacan belacu belada beladau beladu belah belahak belahong belai belak belaka belakang belako belalah belalai belalak belalang belam belambang belan belanak belanda belandang belandar belandong belandung belang belanga belangah belangir belangkas belangkin belangkon belanja belantai belantan belantara belantik belantika belantuk belar belarak belas belasah belasting belasungkawa belasut belat belat-belit belata belater belati belatik belatuk belatung belau belawan beldu belebas belebat belecak beleda beledang beledi beledu beleid belek belekek belekok belel belelang belembang belencong belendong belendung beleng belenggu belengket belengkok belengkong belengset belenting belentung belepas belera belerang belerong beleter beli belia beliak belian beliau belibas belibat belibis belida beligat beligo belik belikat beliku belimbing belincong beling belingkang belingsat belingut belinjo belintang belis belit belitung beliung beliut beloan belobor belodok beloh belok belokok belolang belolok belolong belon belonggok belongkang belongkeng belongkot belongkotan belongsong belontang beloon belot belotong belu belu-belai beluam beluas belubu belubur beludak beludar beludru beluhan beluk belukap belukar beluku belukut belulang beluluk belulung belum belumpai belungkang belungkur belungsing belunjur beluntas beluru belus belusuk belut beluwek bembam bemban bembar bembarap bembet bemo bemper bena benah benak benalu benam benang benar benara benatu bencah bencana bencat benci bencol bencong benda bendahara bendahari bendala bendalu bendang bendar bendara bendari bendawat bendawi bende bendel bendela bendera benderang benderung bendesa bendi bendir bendo bendoro bendu benduan bendul bendung benefaktif bengah bengal bengang bengang-bengut bengap bengawan bengek bengep benggal benggala benggang benggil benggol bengis bengkah bengkak bengkal bengkal-bengkil bengkalai bengkang-bengkok bengkang-bengkong bengkang-bengkung bengkar bengkarak bengkarap bengkaras bengkarung bengkatak bengkawan bengkawang bengkayang bengkel bengkelai bengkeng bengker bengkerap bengkil bengkok bengkol bengkong bengkos bengku bengkuang bengkudu bengkunang bengkung bengoh bengok bengong bengot bengu benguk bengul bengung beni benian benih bening benitan benjol benjut benkap bensin benta bentak bentala bentan bentang bentangkan bentangur bentar bentara bentaus benteh benteng bentes bentet bentik bentoh bentok bentol bentong bentonit bentos bentrok bentuk bentul bentulu bentur benturung bentus benua benuang benuaron benulung benum benur benyai benyek benyoh benyot benzedrin benzena benzil benzoat benzoil benzoin benzol beo beol bera berabe beragan berahi berai beraja berak berakah beraksa beram berambang beramin beranang beranda berandal berandang berang berangai berangan berangas berangasan berangga berangkal berangkat berangsang berangta berangus berani beranta berantak berantas berapa beras berat bercak berdikari berdus berebes beredel berek-berek beremban berembang berendeng bereng-bereng berengau berenggil berengos berengsek berengut berentang bereo bererot beres beresok beret berewok bergajul bergas berguk berhala beri beriang beriani berida berik beril berilium berinda bering-bering beringas beringin beringsang berisik berita berkah berkas berkat berkelium berkik berkil berko berkung berlau berlian berma bermat bermi bernas bernga beroci beroga berok berokat beron beronang berondok berondong berong berongkos berongsang berongsong beronjong beronok beronsang berontak berosot beroti beroya bersat bersih bersil bersin bersit bersut bertam bertih beru beruang beruas berubuh berudu berui beruju berujul beruk berumbun berumbung berunai berunang berungut beruntus beruri berus berwari berzanji besalen besan besar besek besel besengek beser besero beset besi besikal besing besit beskal beskap beskat beslah beslit besok besot bestari bestek bestel bestialitas bestik bestir bestral besuk besusu besut besuta bet beta betah betahak betak-betak betang betapa betara betari betas betatas betatron betau betet beti betik betina beting betinga betis betok beton betonisasi betot betul betung betutu bewok bhayangkara biadab biadat biadi biah biak biang bianglala biaperi biar biara biarpet biarpun bias biasa biat biau biawak biawan biawas biaya bibel bibi bibinda bibir bibit biblio bibliografi bibliomania bibliotek bicana bicara bicokok bicu bida bidadari bidah bidai bidak bidal bidan bidang bidar bidara bidari bidas bidet bidik bido biduan biduanda biduanita biduk bidur biduri bienial biennale bifasial bifida bigair bigami bigamis bihi bihun bijak bijaksana bijan bijana biji bijih bik bikameral bikang bikarbonat bikin bikini bikir bikonkaf bikonveks bikromat biksah biksu biksuni biku bila bilabial bilah bilai bilakmata bilal bilamana bilamasa bilang bilas bilateral bilateralisme bilga bilhak biliar bilik bilineal bilingual bilingualisme bilis biliun billahi bilokal bilur bilyet bimasakti bimbang bimbing bimbit bimetal bin bina binal binar binara binaraga binasa binatak binatang binatu binawah binayah bincacak bincang bincang-bincut bincu bincul bincut bindam bindeng binder bindu bineka binen biner bingas bingit bingka bingkah bingkai bingkas bingkatak bingkis bingung bini binjai binjat binokular binomial bintak bintal bintalak bintan bintang bintara binti bintik bintil bintit bintul bintulbahar bintur binturung biodata biodin biofera biofilm biofilter biofisik biofisika biogas biogenesis biogenik biogeografi biografi biokimia bioklimatologi biola biolinguistik biolit biolog biologi biologis bioluminesensi biomassa biomekani biometeorologi biometri biometrika bionomika biopendar bioplasma biopsi bioritme bioritmik biosekuen biosfer biosida bioskop biostatika biota biotek bioteknologi biotik biotin biotoksin biotron bipatride bipolar bipolaritas bipolisentrisme biprisma bir birah birai biram birama birang biras birat biri-biri biring birit biro birofaks birokrasi birokrat birokratis birokratisasi birokratisme birsam biru bis bisa bisai bisan bisawab bisbol biseksual bisektris biseps bisik bising bisinosis biskuit bismillah bismut bisnis bison bissu bistik bisu bisul bit biti biting bitisik bitumen biuku biumbai bius biut bivak biverbal biyuh-biyuh bizurai blabar blabitisme blakblakan blangko blantik blantika blaster blastostil blazer blek blekek blekok blenda blender blepot blewah blirik blog bloger blok blokade blokir bloknot blong blower bludrek blues blus blustru bobato bobok bobol bobos bobot bobotok bobrok bocah bocok bocong bocor bodhi bodhisatwa bodi bodoh bodok bodong bodor boga bogam bogel bogem bogi bogol bogor bogot bohemian bohlam bohong bohorok bohsia boi boikot bois bokar bokca bokek boko bokoh bokong bokop bokor boks boksen bokser bokset boksu boku bol bola bolak bolak-balik bolang-baling boleh bolero bolide boling bolometer bolong bolos bolot bolotu bolpoin bolsak bolu bom bombai bombardemen bombardir bombas bombastis bomber bomoh bomseks bon bonafide bonafiditas bonang bonanza bonar bonbon boncel bonceng boncol boncong bondol bondong bondot boneka bonet bong bongak bonggol bongkah bongkak bongkar bongkar-bangkir bongkin bongko bongkol bongkong bongkor bongkot bongkrek bonglai bongmeh bongo bongok bongsang bongsor bonjol bonjor bonsai bontak bonto bontok bontot bonus bonyok bopeng boplang bopok bopong bor bora borak boraks borang borat borci border bordes bordil bordir bordu boreal boreh borek borgol borhan borjuasi borjuis borkol boro-boro borok boron borong boros bortel bos bosan boseta bosman boson bosor bosun bot bota botak botang botani botanikus botanis botelir botlir boto botoh botok botol botor botridium botulisme bowo boya boyak boyas boyo-boyo boyong boyongan bozah bradikardi brahma brahmana brahmani brahmi brahmin braille brakiasi brakilogi brakisefalik brakistokron brakiurus braktea bramacorah brambang brana brander brankar brankas branwir braseri brata bratawali bredel breksi breksia brem bren brendi brengsek bretel brevet brifing brigade brigadir brigidig briket brilian briofita briologi briozoa brisan broiler brokade brokat broker brokoli brom bromat bromida bromin bromisme brompit brongkos bronkioli bronkitis bronkodilator bronkotomi bronkus brontosaurus bros brosur browser bruder bruk brunai brutal brutalisme bruto bua buah buai buak bual buana buang buani buar buari buas buat buaya bubar bubo bubu bubuh bubuhan bubuk bubul bubun bubung bubur bubus bubut bucu budak budanco budaya buddha buddhis buddhisme bude budek budi budian budiman budu buduk budur bueng bufer bufet bugar bugenfil bugenvil bugi-bugi bugil buhuk buhul bui buih buil bujal bujam bujang bujangga bujet bujeter bujuk bujur bujut buk buka bukan bukankah bukantah bukat bukau buket bukit buklet bukti buku bukung bukur bukut bulai bulak bulan bulang bulang-baling bulangan bular bulat bulbul buldan buldog buldoser bule buleng buletin bulevar bulgur buli-buli bulian bulimia bulir bulsak bulu bulug buluh buluk bulur bulus bum bumantara bumban bumbu bumbun bumbung bumel bumerang bumi bumiah bumiputra bumper bumpet bumping bun buna bunbunan buncah buncak buncang buncis buncit bunda bundak bundar bundas bundel bunduk bundung bung bunga bungalo bungar bungkal bungkam bungkang bungkas bungker bungkil bungking bungkuk bungkul bungkus bunglai bunglon bungsil bungsu bungur buni bunian bunjai buntak buntal buntang buntar buntat buntek buntel buntet buntil bunting buntu buntung buntut bunuh bunut bunyi bupala bupati bupet bur bura burai burak burakah buraksa buram buras burat burayak burdah bureng buret burgundi burhan burik burit burjusumbulat burkak burnout buron bursa buru buruh buruj buruk burun burung burut bus busa busai busana busar buset bushido busi busik bustan buster busuk busung busur busut buta butadiena butala butana butang butarepan butbut butek butik butir butirat butongpai butuh butul butun butut buwuh buya buyar buyung buyur buyut byarpet caba cabai cabak cabang cabar cabau cabe cabik cabir cabo cabuh cabuk cabul cabur cabut caca cacah cacak cacap cacar cacat cacau caci cacibar cacil cacing cadai cadang cadar cadas cadel cadik cadok caduk cadung caem cagak cagar cagil cagu caguh cagun cagut cah cahang cahar cahari cahaya cahi cailah caima caing cair cais caisim cak cakah cakak cakalang cakalele cakap cakar cakawari cakela cakep caki cakiak cakil cakmar cako cakra cakrabirawa cakrabuana cakram cakrawala cakruk cakup cakur cakus cal calabikang caladi calak calang calar calecer calempong caling calir calit calo calon calui caluk calung calus cam camar camat camau cambahan cambang cambuk cambul cambung camca camil campa campah campak campang campin camping camplungan campuh campung campur camuk camur canai canak canang cancan cancang cancut canda candai candak candala candang candat candi candik candit candra candradimuka candramawa candrasa candrasengkala candu candung cang cangah cangak cangam cangap cangar cangcang cangga canggaan canggah canggai canggal cangget canggih canggu canggung cangkang cangkat cangkel cangkih cangking cangkir cangklong cangkok cangkol cangkrang cangkrim cangkriman cangkring cangkuk cangkul cangkulan cangkum cangkung cangkup canguk cangut cantas cante cantel canteng cantik canting cantol cantrik cantum caos cap capa capah capai capak capal capang capar capcai cape capek capelin capgome capik capil caping capit capjiki caplak caplok capuk capung cara carah carak caraka caram caran carang caren cari carik caring carter caruk carut cas casciscus cat catat catek catu catuk catur caturjalma caturlarik caturtunggal caturwangsa caturwarga caturwarna caturwulan catut caul caung cawai cawak cawan cawangan cawat cawe-cawe cawi cawis cebak ceban cebik cebikas cebil cebir cebis cebok cebol cebong cebur cecah cecak cecap cecar cecawi cece ceceh ceceng cecengklok cecer cecere cecok cecongor cecunguk cedal cedayam cedera ceding cedok cedong ceduk cegah cegak cegar cegat ceguk cek cekah cekak cekakak cekakan cekal cekam cekang cekarau cekat cekau cekcekcek cekcok cekdam cekdel cekek cekel ceker cekeram ceki cekibar cekih cekik cekikik ceking cekit ceklek cekluk cekok cekrem cekres ceku cekuh cekuk cekung cekup cekur cekut cela celaga celah celak celaka celampak celana celang celangak celangak-celinguk celangap celapak celar celari celas celas-celus celat celatuk cele celebuk celedang-celedok celek celekeh celemek celemotan celempong celempung celeng celengan celengkak-celengkok celengkang celentang celep celepik celepuk celetuk celi celih celik celinguk celingus celis celok celomes celomok celonok celopar celorot celoteh celsius celuk celum celung celup celupak celur celuring celurit celurut celus celutak celutuk cema cemani cemar cemara cemas cemat cembeng cemberut cembul cembung cemburu cemeeh cemeh cemek cemekian cemengkian cemer cemerlang cemeti cemetuk cemomot cemong cemooh cempa cempaka cempal cempala cempana cempe cempedak cempek cempelik cempelung cempeng cempera cemperai cemperling cempiang cempil cempin cemping cemplang cemplung cempor cempreng cempres cempuling cempung cempurit cemuas cemuk cena cenak cenal-cenil cenangau cenangga cenangkas cenayang cencala cencaluk cencang cencaru cencawan cencawi cendala cendana cendang cendawan cendekia cendera cenderai cenderasa cenderawasih cenderung cendok cendol cenduai cenela ceng cengal cengam cengang cengap cengar-cengir cengbeng cengeh cengek cengeng cengengesan cenggek cengger cenggeret cengi cengir-cengir cengis cengkal cengkam cengkar cengkaruk cengkau cengkedi cengkeh cengkek cengkelong cengkeram cengkerama cengkerawak cengkerik cengkering cengkerung cengki cengkiak cengkih cengking cengkir cengkiwing cengkok cengkol cengkong cengkung cengkurai cengli cengung cengut centang centangan centeng centet centil centong centung cenung cepak cepal cepat cepek cepeng cepengan ceper cepiau ceplas-ceplos ceples ceplok ceplos cepo cepol cepu cepuk cerabah cerabih cerabut ceracak ceracam ceracap ceracau cerah cerai cerai-berai cerak ceraka cerakin ceramah cerana cerancang cerang ceranggah cerangka cerap cerat ceratai ceratuk cerau cerawat cerbak cerca cercah cercak cercap cerdas cerdik cere cerecek cerek ceremai cerempung cerepu ceret cerewet cergas ceri ceria cericap cericau cericip ceriga cerih cerita ceriwis cerkam cerkau cerlang cerlih cerling cermai cermat cermin cerna ceroboh cerobong cerocok cerocos cerompong ceronggah ceropong ceroteh cerowok cerpelai cerpen cerpenis cerpu cerucuh cerucup ceruh ceruk cerun cerup cerut cerutu cespleng cetai cetak cetar cetek ceteng ceter cetera ceteri ceteria ceti cetok cetus ceuki cewang cewek ciak cialat ciap ciar cibir cibit ciblon cibuk cicik cicil cicinda cicip cicit cidomo ciduk cigak cih cihui cik cika cikadas cikal cikar cikok cikrak ciku cikun cikut cilap cilawagi cili cilik ciling cilok ciluk cilukba cimplong cina cincang cincau cincin cincong cincu cinda cindai cindaku cinde cindil cindur cing cingak cingam cingangah cingge cingkat cingkeh cingkrang cingur cinta cintamani cinteng cintrong cip cipai cipan ciplak cipok ciprat cipta cir circir ciri cirit ciriwangi cis cit cita citra citraleka ciu cium ciut coak coang coba coban cobar-cabir cobek coblos cocok cocol cocor codak codet codot cogah cogan cogok cok cokar cokek cokelat coket coklat cokmar coko cokok cokol cokor cokorda cola-cala colak colak-caling colang-caling colek coleng colet coli colok colong colot comat-comot comberan comblang combong comek comel comor comot compang-camping compeng compes compoh compreng comro concong condong conet congak congeh congek conggah-canggih congget conggok congkah-cangkih congkah-mangkih congkak congkel congki congklak congklang congkok congkong congo congok congol congor congsam contek conteng contoh contong cop copak-capik copet coplok copot cor corak corat-coret corek coreng coret coro corob corong corot cotet cotok cowok cowokan crat-crit criping cua cuaca cuai cuak cual cuang cuar cuat cubit cublik cubung cuca cucakrawa cuci cucu cucuh cucuk cucun cucunda cucung cucup cucur cucurut cucut cudang cuek cugat cuh cuik cuil cuit cuk cuka cukai cuki cukil cukimai cukin cukit cukong cuku cukup cukur cula culak culan culas culi culiah culik culika culim culun cuma cuman cumbu cumengkling cumepak cumi-cumi cumil cuming cun cunam cundang cundrik cunduk cung cungap cungkil cungkup cungo cungul cungur cunia cup cupai cupak cupang cupar cupet cuping cuplik cupu cupul cur cura curah curai curam curang curat cureng curi curiah curiga curik curna curu cus cut cutak cutbrai cutel cuti cuwil daayah dab daba dabak dabal dabat dabih dabik dabing dabir dabit dablek dabol dabung dabus dacin dad dada dadah dadaisme dadak dadal dadali dadap dadar dadek dadi dadih dading dadu daduh daduk dadung daeng daerah daerahisme dafnah daftar daga dagang dage dagel dagi daging dagu dah dahaga dahagi dahak daham dahan dahanam dahar dahi dahiat dahina dahlia dahriah dahsyat dahulu dai daidan daidanco daif daim daiman daing daitia dajal daka dakah dakaik dakar dakhil daki dakik dakocan dakon dakron daksa daksina daktil daktilitis daktilologi daktiloskopi daku dakwa dakwah dal dalal dalalah dalalat dalam dalang daldaru dalem dalfin dali-dali dalih dalil daltonisme dalu daluang dalung dam damah damai damak damal daman damar damaru damas damat damba dambin dambir dame damen dami damik damotin dampak dampal dampan dampar dampeng dampil damping dampit damprat dampung dan dana danau danawa danda dandan dandang dandanggula dandapati dandi dang dangai dangar dangau dangdut dange danghyang dangir dangka dangkal dangkap dangkar dangkung danguk dansa dansanak danta danuh danur danyang dap dapa dapar dapat dapra dapur dar dara darab darah daras darat darau dargah dari daripada darji darma darmabakti darmakelana darmasiswa darmatirta darmawisata daro darpana daru-daru darulaitam darulakhirat darulbaka darulfana daruljalal darun darunu darurat darusalam darwis das dasa dasalomba dasar dasarian dasasila dasatitah dasawarsa dasbor dasi dasin daster dasun data datang datar datatamak dati datif datu datuk datum datung dauk daulat daun daur dawai dawan dawat dawet daya dayah dayang dayu dayuh dayuk dayung dayus dealat deaneksasi debah debak debam debap debar debarkasi debas debat debet debik debil debing debirokratisasi debit debitase debitor debitur debris debu debug debuk debum debun debung debup debur debus debut decak decap deceh decing decit decup decur decut dedah dedai dedak dedal dedalu dedap dedar dedara dedare dedas dedau dedek dedel dedemit dedengkot deder dederuk dedes dedikasi dedikatif deduksi deduktif dedulang deeskalasi defaitisme defekasi defender defensi defensif deferens defile definisi definit definitif defisien defisit deflagrasi deflagrator deflasi defleksi deflorasi defoliasi defolisasi defonologisasi deforestasi deformasi deformatif deg degam degan degap degar degen degenerasi degeneratif degil deging degradasi degresi deguk degum degung degup deh deham dehem dehidrasi dehidrat dehidrogenasi dehumanisasi deideologisasi deifikasi deiksis deiktis deislamisasi deisme dek dekade dekaden dekadensi dekagram dekah dekak dekaliter dekam dekameter dekan dekanal dekantasi dekap dekapoda dekar dekare dekat dekik dekil deking deklamasi deklamator deklarasi deklaratif deklasifikasi deklerer deklinasi deklinometer dekode dekoder dekolonisasi dekomposer dekomposisi dekompresi dekongestan dekonsentrasi dekontekstualisasi dekor dekorasi dekoratif dekorator dekosistem dekremeter dekreolisasi dekret dekriminalisasi deksa dekstrin dekstrosa deksura deku dekunci dekung dekus dekut delabialisasi delah delamak delan delap delapan delas delat delegasi delegat delegitimasi delepak deler delik delikan delikat delikates delima delineasi delinkuen delinkuensi delirium delman delong delongop delta deltoid delu delusi delusif demabrasi demagog demagogi demagogis demah demam demang demap demarkasi dembai dembam dembun demek demen demes demi demik demikian demiliterisasi demineralisasi demisioner demo demobilisan demobilisasi demograf demografi demografis demokrasi demokrat demokratis demokratisasi demon demoniak demonopolisasi demonstran demonstrasi demonstratif demonstrativa demoralisasi demosi dempak dempam dempang demper dempet dempir demplon dempok dempuk dempul dempung demung den dena denah denai denak denasalisasi denasionalisasi denawa dencang dencing denda dendam dendang dendeng dendi dendrokronologi dendrologi denervasi dengak dengan dengap dengar dengih denging dengkang dengkel dengki dengkik dengking dengkol dengkul dengkung dengkur dengkus dengu dengue denguk dengung dengus dengut denim denok denominal denominasi denotasi denotatif densimeter densitas densitometer densitometri densometer dental dentam dentang dentat dentin denting dentum dentung dentur denudasi denuklirisasi denyar denyit denyut deodoran deoknumisasi deontologi depa depak depalatalisasi depan depang depap deparpolisasi departemen departemental departementalisasi dependen dependensi depersonalisasi depersonifikasi depigmentasi depilasi deplesi depo depolarisasi depolitisasi deponir depopulasi deportasi deposan deposit deposito depot depresi depresiasi depresor deprok deprotonasi depun depus deputasi deputi dera deragem derai derajah derajang derajat derak deram deran derana derang derap deras derau derawa derebar deregulasi derek derel derep deres deresi deret dergama derik dering deringo deris derit derita deritaan derivasi derivat derivatif derji derma dermaga derman dermatitis dermatofitosis dermatolog dermatologi dermatom dermis dermoid dersana dersik deru deruji deruk derum derun derung derup derus derut desa desah desain desainer desak desakralisasi desalinasi desaneksasi desar desas-desus desau desegregasi deselerasi desember desensitisasi desentralisasi deserebrasi desersi desertir desibel desidua desigram desih desik desikan desikator desil desiliter desiliun desimal desimeter desinens desinfeksi desinfektan desing desintegrasi desir desis desit deskripsi deskriptif deskuamasi desmonem desmoplasia desmosom desorientasi desorpsi despot despotik despotisme destabilisasi destar destinasi destroyer destruksi destruktif destruktor desuk desulfurisasi desup desur desus desut detail detak detap detar detas detasemen detasering detasir deteksi detektif detektofon detektor detenidos detensi detente detergen deteriorasi determinan determinasi determinatif determinator determinis determinisme detik deting detoksifikasi detonasi detonator detritus detrusor detup detus deuterium deuterokanonika deuteron deutranomalopia deutranopia devaluasi developer deverbal deviasi devisa devosi dewa dewadaru dewala dewan dewana dewanagari dewangga dewasa dewata dewe dewi dia diabetes diad diadem diafon diaforetik diafragma diagenesis diagnosis diagnostik diagometer diagonal diagram diaken diakon diakones diakonia diakritik diakronis dialek dialektal dialektik dialektika dialektis dialektologi dialinguistik dialisis dialog dialogis diam diamagnetisme diameter diametral diamorf dian diang diaper diapositif diar diare dias diasistem diaspora diastase diastole diat diaterman diatermi diatermik diatesis diatipe diatom diatomit diatonik diatopik diayah dibasa didaktik didaktikus didaktis didih didik didis didong dielektrik diensefalon dies diesel diet dietetika difabel diferensial diferensiasi difluens difluensi difraksi difteri diftong difusi digdaya digenesis digestif digit digital digitalin digitalis digitalisasi diglosia digraf digresi digul dihedral dihidroksil dik dikara dikau dikit diklorida dikotil dikotomi dikroisme dikromat dikromatik diksa diksi diktat diktator diktatorial diktatoris dikte diktum dil dila dilak dilam dilasi dilatasi dilatometer dilema dilematik diler diletan diluvium dim dimensi dimer diminutif dimorfik dimorfisme din dina dinamik dinamika dinamis dinamisator dinamisme dinamit dinamo dinamometer dinar dinas dinasti dinding dingin dingkis dingkit dingklang dingklik dingo dini diniah diniyah dinosaurus dinul-islam diode dioesis dioksida dioksin diopsida dioptase dioptri diorama diorit dioses dipan diplo diploid diploma diplomasi diplomat diplomatik diplomatis dipsomania diptera diptotos dirah diraja direk direksi direktorat direktorium direktris direktur dirgahayu dirgantara dirham diri dirigen diris dirus disagio disain disainer disakarida disastria disbursemen disdrometer disekuilibrium disel disensus disentri disertasi disfonia disfungsi disharmoni disiden disilabik disimilasi disinfektan disinformasi disinsentif disintegrasi disiplin disjoki disjungsi disjungtif diska disket diskiasis disklimaks disko diskoid diskon diskontinu diskontinuitas diskonto diskordans diskorobik diskotek diskredit diskrepansi diskresi diskriminasi diskriminatif diskriminator diskualifikasi diskulpasi diskursif diskus diskusi dislalia disleksia dislokasi dismembrasio dismenorea dismutasi disolventia disonansi disoperasi disorder disorganisasi disorientasi disosiasi dispareunia disparitas dispensasi dispenser dispepsia dispersal dispersi disposisi disposotio disprosium disrupsi distabilitas distal distansi distikiasis distikon distilasi distilator distingsi distingtif distoma distorsi distosia distribusi distributor distrik disuasi disuria dito ditransitif diuresis diuretik diurnal divergen divergensi diversifikasi diversitas divestasi dividen divisi diwala doa doang dobel dobi doblangan doble dobol dobolo dobrak dodekagon dodekahedron dodet dodok dodol dodong dodor dodos dodot doeloe dog dogel dogeng doger dogma dogmatik dogmatis dogmatisme dogol dohok dohyo doi dok dokar doko dokoh dokok-dokok doksologi dokter doktor doktoranda doktorandus doktrin doku dokumen dokumentasi dokumenter dol dolak-dalik dolan dolar doldrum dolfin dolikosepalik dolmen dolok dolomit dom domain domba domblong domein domestik domestikasi dominan dominansi dominasi domine dominggo dominion domino domisili domot dompak dompet domplang dompleng dompol don donasi donat donatur doncang dondang donder dondon dong dongak dongan dongbret dongeng dongkak dongkel dongkok dongkol dongkrak dongkrok dongok dongpan dongsok doni donor donto dop doping dopis dor dorang dorbi dorbia dorman dormansi dorna dorong dorsal dorslah dorsopalatal dorsovelar dorsum dortrap dos dosa dosen dosin dosir dosis dot dowel dower doyak doyan doyang doyo doyong draf dragon drai drainase drakula dram drama dramatik dramatikus dramatis dramatisasi dramaturg dramaturgi draperi drastis drat drel dresoar dresur dribel drif dril drip drop droping dropsi drum drumben drumer druwe dua duafa duai duaja dualis dualisme dualistis duane duang duangsom dub dubalang dubelir dubes dubila dubing dubius duble dublir dubuk dubur duda duduk dudur dudus duel duet duga dugal dugang dugas dugdeng dugder duh duha duhai duhe duhu duilah duit duk duka dukacarita dukacita dukan dukana dukat dukaten duktulus duktus duku dukuh dukun dukung dula dulag dulang dulang-dulang duli dulur dum dumdum dumi dumping dumung dunah dunak dung dungas dungkelan dungkul dungu dungun dunia duniawi duodenum duodesimal duodrama duopoli dup dupa dupak dupleks duplik duplikasi duplikat duplikator duplisitas duplo dur dura duralumin duramater durasi durat duratif duren dureng durhaka duri durian durias duriat durja durjana durjasa durkarsa durma durna durno durnois durnoisme durometer dursila duru duruwiksa dus dusin dusta dustur dusun duta duwegan duwet duyun duyung dwiarti dwibahasa dwidarma dwidasawarsa dwifungsi dwiganda dwiguna dwilingga dwimatra dwiminggu dwimuka dwiperan dwipurwa dwisegi dwitarung dwitunggal dwiwarna dzal ebam eban ebek ebi eboni ebonit ebro eburina ecek eceng ecer eco edafik edafit edafologi edafon edan edar edema edentat edisi edit editor editorial edukasi edukatif efedrina efek efektif efektivitas efektor efelis efendi efisien efisiensi efloresensi eforus efusi egah egalisasi egalitarian egalitarianisme egalitarisme egaliter egat ego egois egoisme egoistis egol egomania egos egosentris egosentrisitas egosentrisme egrang egresif ehe eidetik eigendom eikosan einsteinium eja ejakulasi ejan ejawantah ejek ejektif ejektor ekabahasa ekad ekajati ekakarsa ekamatra ekang ekaristi ekatantri ekbalium ekdemik ekderon ekdisis ekeh ekimosis ekiofit eklektik eklektikus eklektis eklektisisme eklektisme eklips ekliptika ekliptis eklosi ekofisiologi ekofraksia ekofrasia ekografi ekogrup ekoklimat ekoklimatologi ekokronologi ekolabel ekolalia ekologi ekologis ekon ekonom ekonometri ekonomi ekonomis ekopolitik ekopraksia ekor ekornia ekosfer ekosistem ekosistematika ekospesies ekostratigrafi ekotipe ekotipifikasi ekoturisme ekozona ekrin ekrinologi eks eksak eksakta eksaltasi eksamen eksaminasi eksaminator eksantem eksantropus eksarasi eksegesis ekseget eksekusi eksekutif eksekutor eksem eksemplar eksenterasi eksentrik eksepsi ekseptor ekses eksesif eksfoliasi ekshalasi ekshibisi ekshibisionis ekshibisionisme ekshibitum eksikator eksin eksipien eksisi eksistensi eksistensialis eksistensialisme eksit eksitasi eksitus ekskavasi ekskavator eksklave eksklusif eksklusivisme ekskomunikasi ekskresi ekskreta ekskursi ekskursif eksobiologi eksobiotik eksodermis eksodos eksodus eksoenzim eksoergik eksofasia eksofora eksoftalmia eksoftalmos eksoftalmus eksogam eksogami eksogen eksogin eksoisogami eksordium eksorsis eksosfer eksospora eksostosis eksoterik eksotermik eksotik eksotis eksotisme ekspansi ekspansif ekspansionis ekspansionisme ekspansionistis ekspatriasi ekspatriat ekspedisi ekspeditor ekspektoran eksper eksperimen eksperimental ekspirasi eksplan eksplikasi eksplisit eksploit eksploitasi eksploitir eksplorasi eksploratif eksplorator eksplosi eksplosif ekspo eksponen eksponensial ekspor eksportir ekspos ekspose eksposisi ekspres ekspresi ekspresif ekspresionisme ekspresionistik ekspresivitas ekstase ekstasi ekstensi ekstensif ekstensifikasi ekstensor eksterior eksteriorisasi eksteritorialitas ekstern eksternal ekstin ekstra ekstradisi ekstrak ekstrakardial ekstraksi ekstraktif ekstrakurikuler ekstralinguistis ekstramarital ekstranei ekstraparlementer ekstrapolasi ekstraseluler ekstraterestrial ekstrateritorialitas ekstrauterin ekstraversi ekstrem ekstremis ekstremitas ekstrinsik ekstrospeksi ekstrover ekstrusi eksudasi eksudat ektoblas ektoderm ektohormon ektoparasit ektoplasma ektoterm ektotermik ekualitas ekuatif ekuator ekuilibrium ekuinoks ekuitas ekuiti ekuivalen ekuivalensi ekuivokasi ekumene ekumenis ekumenisme ela elaborasi elak elan elang elastik elastin elastis elastisitas elastomer elatif elefantiasis elegan elegansi elegi elektif elektorat elektret elektrifikasi elektrik elektris elektro elektrode elektrodinamika elektroensefalogram elektroforesis elektrokardiogram elektrokimia elektrokoagulasi elektrokusi elektrolisi elektrolisis elektrolit elektromagnet elektromagnetik elektromagnetisme elektrometalurgi elektromiografi elektromotif elektron elektronegatif elektronik elektronika elektronis elektropatologi elektropositif elektroskop elektrostatika elektroteknik elektroterapeutika elektroterapi elektrotipe elektrum elemen elementer elemi eleng elevasi elevator eliksir eliminasi eliminir eling elips elipsis elipsoid elipsometer elipsometri eliptis elite elitis elitron elo elok elokuensi elon elongasi elpiji eltor elu eluat eluen elung elus elusi elusian elusif elutriasi eluvial eluviasi eluvium email emanasi emang emansipasi emas emaskulasi emat embacang embah embak embal embalase embalau emban embar embara embarau embargo embarkasi embaru embat embek embel ember embih embik emblem embok embol emboli embolisme embolus embosur embrat embrio embriogenesis embriologi embrionik embuai embuh embun embung embus embut emendasi emeraldin emeritus emetik emetina emfisema emigran emigrasi eminen eminensi emir emirat emis emisi emisivitas emitans emiten emoh emol emolumen emong emosi emosional emosionalisme emotif empal empang empap empar empas empat empati empedal empedu empek empela empelas empenak empeng emper empet empiema empik emping empiri empiris empirisme emplasemen emplek employe empo empoh empok emporium empos empot emprak empu empuan empuk empul empulur empunya emrat emulasi emulator emulsi emulsifikasi emut enak enam enamel enap enartrosis enas enau encal encang enceh encek encel encer encik encim encit encok encot endak endal endang endap endas endasan endemi endemis endilau endoderm endoderma endodermis endofit endogami endogen endokardia endokrin endokrinologi endolimfa endometriosis endometrium endomiksis endomiokarditis endomisium endon endong endoparasit endoplasma endorfin endosemen endosentris endoskeleton endoskop endoskopi endosmosis endosperma endotel endoterm endotermal endotermis endotoksin endrin enduk endul enduro endus endut enek eneng energetik energi energik enes enfitotik engah engap-engap engas enggak enggan enggang engget enggil enggok engkah engkak engkang engkau engket-engket engkoh engkol engkong engku engkuk engsel enigma enjak enjal enjambemen enjelai enjin enjut enkapsulasi enklave enklitik enkode enkripsi enkulturasi enologi enom ensambel ensefalitis ensefalitogen ensefalograf ensefalografi ensefalogram ensefalomielitis ensefalon ensiform ensiklik ensiklopedia ensiklopedis ensopor entah entak entalpi entar entas ente enten enteng entente enteritis enterograf enterologi enteron enteropati enterosel enterostomi enterotoksin enterovirus entit entitas entoderm entogenus entok entomofili entomolog entomologi entong entot entozoa entozoik entre entrepot entri entropi enukleasi enumerasi enuresis envoi enyah enyak enzim enzimolisis enzimologi enzootik eolit eon eosen eosin eozoikum epak epek epentesis epibentos epidemi epidemiologi epidermis epidiaskop epifaring epifil epifiotik epifisis epifit epifiton epifora epigastrium epigenesis epiglotis epigon epigraf epigrafi epigram epik epikotil epikuris epilepsi epileptik epilog epimisium epinasti epinefrina epinurim episentrum episiklik episiotomi episkopal episkopat episode episodik epispora epistaksis epistel epistemologi epistola epitaf epitaksi epitel epitelioma epitermal epitet epizoik epizootik epok epoksi epolet eponim epos epsilon era eradikasi eradiksi erak eram erang erat erata erbis erbium ercis ereh erek-erek ereksi erektor ereng erepsin eret erg ergasiofit ergonomi ergonomika ergonomis ergosterol ergot ergoterapi erik ering erisipelas eritema eritroblas eritrosit erong eror erosentrisme erosi erot erotik erotika erotis erotisisme erotisme erpah erpak erti eru erupsi es esa esai esais esak esek eselon esembling esens esensi esensial esensialitas eskader eskadron eskalasi eskalator eskapisme eskas eskatologi eskatologis esofagus esok esot esoteris estafet ester estesia estetik estetika estetikus estetis estimasi estriol estrogen estron estrus estuari estuarin eta etalase etana etanol etape etatisme etek eter eteris eternit etik etika etiket etil etilena etimologi etimologis etimon etiolin etiologi etis etmoid etnik etnis etnobotani etnograf etnografi etnografis etnolinguistik etnolog etnologi etnologis etnomusikolog etnomusikologi etnopolitik etnosentrisme etologi etos etsa eudaemonisme eufemisme eufemistis eufoni eufonium euforia euforian eugenetika eugenika eugenol eukaliptol eukaliptus eukarion eukariota eulogi euploid eurasia eurihalin europium eurosentris euseksual eusinantropus eutanasia eutektik eutenika eutrofikasi evakuasi evaluasi evaluatif evangeli evangelis evaporasi evaporator evaporimeter evapotranspirasi eversi eviden eviserasi evokasi evokatif evolusi evolusioner evolusionisme ewa eyang eyel faal faali fabel fabula faden fadihat fadil fadilat faedah fafa fagosit fagositosis fagot fahombe fahrenheit fahsya fail fajar fakih fakir faks faksi faksimile fakta faktif faktitius faktor faktual faktur fakultas fakultatif falah falaj falak falakiah falsafah falsafi fam famili familia familier familiisme familisme fana fanatik fanatisme fanfare fani fantasi fantastis fantom farad faraid faraj farak fardu farik faring faringal faringalisasi faringitis farisi farji farmakodinamika farmakokinetika farmakolog farmakologi farmakologis farmakope farmakoseutika farmasi farsakh fasad fasakh fase faset fasia fasid fasih fasihat fasik fasilitas fasilitator fasis fasisme fastabikhulkhairat fatah fatal fatala fatalis fatalisme fatalitas fatamorgana fatanah fatihah fatimah fatir fatom fatometer fatri fatsun fatur fatwa fauna faunistik favorit favoritisme febrin februari federal federalis federalisme federalistis federasi feko fekundasi fekunditas felon felspar feminin feminisme fenakit fengsui fenit fenol fenologi fenomena fenomenal fenomenalisme fenomenologi fenosis fenotipe feodal feodalisme feodalistis feral feri feritin fermen fermentasi fermion fermium feromagnetik feromagnetisme feromon feronikel fertil fertilasi fertilisasi fertilitas fertilizin ferum feses festival fetis fetor fetus fiasko fiat fiber fibrasi fibriblas fibril fibrilasi fibrin fibrinogen fibrokistik fidah fider fidiah fidusia fidyah figur figuran figuratif fiil fikih fikli fikologi fikrah fiksasi fiksi fiktif fikus filamen filantrop filantropi filantropis filaria filariasis filateli filatelik filatelis filharmoni filial filibuster film filmis filo filodendron filogenesis filogeni filolog filologi filologis filopur filosof filosofi filosofis filsafat filsuf filter filtrasi filtrat filum fimbria final finansial finir finis fiolaks firajullah firasat firauniah firdaus firdausi firjatullah firkah firma firman firn fisi fisibel fisibilitas fisik fisika fisiognomi fisiognomis fisiologi fisiologis fisioterapi fisis fiskal fit fiting fitnah fitofag fitofogus fitogeni fitogeografi fitokimia fitologi fitometer fiton fitopatologi fitosanitasi fitososiologi fitosterol fitostrot fitotoksin fitotoksoid fitotopografi fitotron fitrah fitri flakon flamboyan flamingo flanel flat flegma flegmatis fleksi fleksibel fleksibilitas fleksor flensa flip-plop flis floem flop flora floret flotasi flotet flu fluensi fluida fluks fluktuasi fluktuatif fluor fluoresen fluoresens fluorin fluorit fobia fokimeter fokstrot fokus folder foli folikel folio folklor folksong fon fonasi fondamen fondasi fonds fonem fonemik fonemis fonetik fonetis fonik fonis fonograf fonografi fonologi fonologis fonon fonotaktik fonotipi foramen foraminifera forensik forklif forma formal formalin formalistis formalitas forman formasi format formatif formatir formatur formika formula formulasi formulator formulir fornifikasi fornikasi forsep forsir forsit forte fortifikasi fortin forum fosfat fosfina fosfit fosfor fosforesens fosforilase fosforus fosgen fosil foto fotodiode fotoelektron fotoemisi fotogenik fotograf fotografer fotografi fotografis fotograver fotogravur fotokimia fotokonduksi fotokonduktivitas fotokonduktor fotokopi fotokromi fotokromik fotolisis fotolitografi fotometer fotometri fotomikrografi fotomodel foton fotoperiodisme fotosel fotosfer fotosintesis fotostat fototaksis fototropis fototustel fovea foya fragmen fragmentaris fragmentasi fraksi fraksinasi fraktur fraktus frambusia fransium frasa frase fraseologi frater fraternitas freatofit fregat frekuen frekuensi frekuentatif frenologi freon frib frigid frigorigraf frigorimeter frikatif friksi fron front frontal fruktosa frustrasi fuad fugasitas fujur fukaha fukara fuksina fulgurit fuli fulminat fulus fumarol fumigan fumigasi fumigator fundamen fundamental fundamentalis fundamentalisme fundamentalistis fungi fungibel fungisida fungistatik fungoid fungsi fungsional fungsionalisasi fungsionalisme fungsionalitas fungsionaris fungus furfural furkan furnitur furqan furuk fusi fusta fusuk futual futur futurisme futuristik futuristis futurolog futurologi futurologis fyord gaba-gaba gabah gabai gabak gabardin gabas gabir gableg gablek gabor gabro gabruk gabuk gabung gabus gaco gacok gacong gada gadai gadamala gadang gading gadis gado gadolinit gadolinium gadon gaduh gaduk gadung gadungan gaek gaet gafar gaflah gaflat gaftar gafur gaga gagah gagai gagak gagal gagang gagap gagas gagau gagu gaguk gah gaham gahar gahara gahari gaharu gai gaib gail gain gaing gairah gait gajah gajak gaji gajih gajul gajus gakang gakari gala galaba galaganjur galagasi galah galai galak galaksi galaktometer galaktorea galaktosa galaktosemia galaktosuria galan galang galanggasi galar galas galat galau galbani galeng galeri galgal gali galias galib galibut galih galiot galir galium galiung galon galu-galu galuh galung galungan galur galvanis galvanisasi galvanometer galvanometri galvanoskop galyas gam gama gamak gamal gamalisasi gamam gaman gamang gamat gambang gambar gambas gambir gamblang gambling gambuh gambus gambut gambyong gamelan gamet gametangium gametofit gametogenesis gametosit gamik gamis gamit gamma gamopetal gamosepal gampang gampar gamparan gamping gamuh gana gana-gini ganal ganang ganar ganas gancang gancar ganco gancu ganda gandal gandapura gandar gandaria gandarukem gandarusa gandarwa gandasturi gandasuli gandek gandem ganden gandeng gandes gandewa gandi gandik gandin ganding gandok gandola gandos gandringan gandrung gandu ganduh gandul gandum gandung gang ganggam ganggang ganggu ganggut ganglion gangsa gangsal gangsar gangsi gangsir gangster gani ganih ganimah ganja ganjak ganjal ganjar ganjat ganjen ganjil ganjing ganjling ganjur ganoid gantal gantang gantar gantel ganteng ganti gantih gantilan ganting gantol gantole gantung ganyah ganyang ganyar ganyong ganyut gaok gap gapah gapah-gopoh gapai gapil gapit gaple gaplek gaplok gapuk gapura gar gara-gara garah garai garam garan garang garangan garansi garap garasi garau garba garbarata garbis garda gardan gardu garebek gari garib garindin garing garis garit garizah garmen garnet garnis garnisun garong garpu garu garuda garuk garung garut garwa gas gasab gasak gasal gasang gasatrin gasifikasi gasing gasket gasolin gasometer gaster gastrektomi gastrin gastritis gastroenteritis gastroenterolog gastroenterologi gastrointestinal gastronomi gastrula gastrulasi gatal gatot gatra gatrik gatuk gauk gaukang gaul gaun gaung gaut gawai gawal gawan gawang gawar gawat gawir gaya gayal gayam gayang gayat gayau gayem gayeng gayuh gayuk gayun gayung gayut gaz gazal gebah gebang gebar gebeng geber geblak geblek geblok gebogan gebok gebos gebot gebrak gebu gebuk gebung gebyah-uyah gebyar gebyur gecar gecek gecer gecul gedabah gedana-gedini gedang gede gedebak-gedebuk gedebar-gedebur gedebeg gedebok gedebuk gedebung gedek gedembai gedembal gedempol gedeng gedi gedik gedok gedombak gedombrongan gedong gedor gedubang gedung geduyut gegabah gegadan gegai gegak gegala gegaman gegana gegaokan gegap gegar gegares gegas gegat gegau gegep geger gegetar gegetun gegisik gegua geiger geiser gejah gejala gejolak gejos gejuju gel gela gelabah gelabir gelabur geladak geladeri geladi geladir geladrah gelagah gelagap gelagar gelagat gelak gelakak gelalar gelam gelama gelamai gelambir gelandang gelandot gelang gelanggang gelangsar gelantang gelanting gelantung gelap gelapung gelar gelas gelasak gelasir gelatak gelatik gelatin gelatuk gelayangan gelayar gelayut gelebah gelebap gelebar geleber gelebuk geleca gelecik geledah geledang geledek geleding geledur gelegah gelegak gelegar gelegata gelek gelekak gelekek gelema gelemat gelemberan gelembong gelembung gelembur gelempang gelemprang gelenang gelendo gelendong gelendot geleng gelenggang gelentang gelenting gelenyar gelepai gelepar gelepek gelepot gelepung gelepur geler gelesek geleser gelesot geleta geletak geletar geletek geletik geleting geletis geletuk geli geliang geliat gelibir gelicik geliga geligi geligin geligis geligit gelignit gelimang gelimantang gelimbir gelimir gelimpang gelimun gelincir gelincuh gelinding gelinggam gelinggaman gelinggang gelingsir gelinjang gelintang gelintar gelintin gelinting gelintir gelipar gelisah gelita gelitar gelitik gelo gelobak gelobok gelocak gelodar gelodok gelogok gelohok gelojoh gelomang gelombang gelompar gelondong geloneng gelonggong gelongsor gelontor gelopak gelora gelosang geloso gelosok gelotak geluduk geluga gelugu gelugur gelugut geluh geluk gelulur gelumang gelumat geluncur gelundung gelung gelup gelupur gelut gema gemah gemak gemal geman gemang gemap gemar gemas gemaung gemawan gembak gembala gembar-gembor gembel gembeng gembil gembili gembira gemblak gembleng gemblong gemblung gembok gembol gembolo gembong gembor gembos gembreng gembrot gembul gembung gembur gembus gembut gemebyar gemelentam gemeletak gemeletap gemeletek gemeletuk gemelugut gementam gementar gemercak gemercik gemerencang gemerencik gemerencing gemerencung gemeresak gemeresik gemeretak gemeretuk gemeretup gemerlap gemertak gemertuk gemerusuk gemetar gemi gemik gemilang gemilap geming gemini gemintang geminte gemirang gempa gempal gempar gempil gempita gempol gempor gempul-gempul gempur gemrobyos gemuk gemul gemulai gemulung gemuntur gemuruh gen gena genah genahar genang genap gencar gencat gencel gencer gencet gencir gendak gendala gendam gendang gendar gendarmeri gendeng gender genderang genderuwo gendewa gending gendis gendon gendong genduk gendut genealogi genealogis genegin geneng generalis generalisasi generalisimo generasi generatif generator generik genesis genetika genetis geng genggam genggang genggong gengsah gengsi gengsot genial genialitas genikulum genis genit genital genitalia genitif genius genjah genjang genjang-genjot genjer genjik genjot genjrang genjring genjur genom genosida genotipe genre genta gentala gentar gentas gentat gentayang gentel genteng gentian genting gentong gentrifikasi gentur gentus genus genyot geobotani geodesi geofisika geofisis geofon geognosi geografi geografis geohidrologi geokimia geokronologi geolog geologi geologis geomansi geometri geometris geomorfologi geonomi geopolitik geosentris geosinkronis geostasioner geostatika geoteknik geoteknologi geotermal geotermi geotermometer gepeng gepit geplak gepok geprak gepuk gera gerabah gerabak gerabang geradi geragai geragap geragas geragau geragih geragot geraguk gerah geraham gerai gerak geram geraman geramang geramsut geramus gerang gerangan geranggang geranium gerantak gerantang geranyam gerapai gerapu gerat geratak geratih gerau gerawan gerawat gerayah gerayang gerbak gerbang gerbas-gerbus gerbera gerbong gerbus gerda gerdam gerdan gerdum gerebek gerecak gerecok gereget gereh gereja gerejani gerejawi gerek geremet gerempang gerencang gerendel gerendeng gerenek gereneng gereng-gereng gerengseng gerenik gerenjeng gerenjet gerentang gerenyau gerenyeng gerenyet gerenying gerenyit gerenyot gerepe gerepek gerepes geresek geret geretak geretang gergaji gergajul gergasi gergeran gerha gerhana geriak geriak-geriuk geriap geriatrik geribik gericau geridip geridit geriditpidit gerigi gerigik gerigis gerih gerik gerilya gerim gerimis gerincing gerinda gerindin gerinding gering geringging geringgingan geringsing gerinjal gerinjam gerinting gerinyau gerip geripir geripis gerisik gerising gerit geriuk gerlap gerlip germang germanium germinal germisida germo germut gero geroak gerobak gerobok gerobyak gerocok gerodak gerogol gerogot gerohok gerohong gerojok gerombol gerombong gerompok gerong geronggang geronium gerontokrasi gerontol gerontologi geronyot geropes geropyok geros gerosak gerot-gerot gerowong gerowot gerpol gersak gersang gersik gertak gertik gertuk geru gerugut geruh geruit gerumit gerumpung gerumuk gerumut gerun gerundang gerundel gerung gerunggung gerunyam gerup gerupis gerupuk gerus gerut gerutu gerutup gerutus gesa gesek gesel geser gesit gesper gestikulasi geta getah getak-getuk getang getap getar getas getek getem-getem geti-geti getik getil getir getis getok getol getu getuk getun geulis gewang gial giam giat gibah gibang gibas giblet gibtah gidik gigahertz gigantisme gigi gigih gigil gigir gigis gigit gigolo gila gilang gilap gilas gilbet gili gilik giling gilir gim gimbal gimnasium gimnastik gimpal gin ginang gincu ginding ginekolog ginekologi ginekomasti ginesium gingivitis ginglimus gingsir gingsul ginjal ginjean ginkang ginogenesis ginseng gips gipsi gir girah giral girang girap-girap giras girasol giri girik girikan giring giris giro giroskop girostat girostatika gisar gisik gisil gita gitapati gitar gitaris gites gitik gitok giuk giur giwang gizi glabela gladiator gladiol glamor glandula glans glasial glasir glasnos glaukoma glegek glenik glenoid gletser glidik glikogen glikogenesis glikogenolisis glikol glikolisis glikosid glikosida glikosidasa glikosidase glikosuria gliserida gliserol global globalisasi globalisme globe globulin globus glokidium glomerulus glomus glosarium glosem glosematik glositas glositis glotal glotalisasi glotis glukagon glukosa glukosan glukosida gluten gnomon goak gob goba gobak gobang gobar gobek gobet goblok gocap gocek gocoh goda godak godam godek godok godong godot goel gogoh gogok gogos gogrok gohok gohong gojek gojlok gokar gol golak golak-galik golbi golek goleng goler golf golok golong golput gom gombak gombal gombang gombeng gombrang gombroh gombyok gompal gompiok gonad gondang gondas-gandes gondok gondol gondola gondong gondorukem gondrong gong gonggo gonggok gonggong gongli gongseng gongyo goni gonidium goniometri gonjak gonjang gonjang-ganjing gonjing gonjlang gonjlang-ganjling gonjok gonjong gonokokus gonore gonrang gontaganti gontai gontok gonyak gonyeh gonyel gonyoh gopek gopoh gorap gorden gorek goreng gores gori gorila gorilya goroh gorok gorong-gorong gosan gosip gosok gosong gospel got gotes gotik gotong gotri gotrok gotun gowok goyah goyak goyang grabadan grad gradasi gradien gradual gradualisme graf grafem grafemik grafemis grafetik grafik grafika grafis grafit grafitasi grafolog grafologi graha grahita gram gramatika gramatikal grambyang gramofon granat granit granolitik granula granulasi granulosit grapyak grasi gratak gratifikasi gratis gravel graver gravimeter gravitas gravitasi grecok gregarius grehon grempel gres gresek-gresek gria grip griya grogi gronjong gros grosir grup gruwung gua gual guam guanidina guanin guanina guano guar gubah gubal gubang gubel gubernemen gubernur gubit gubris gubuk guci gudam gudang gudangan gude gudeg guderi gudi gudik gudu-gudu gue gugah gugat gugu guguh guguk gugup gugur gugus guit gujirak gujirat gukakas gul gula gulah gulai gulali gulam gulambai gulana gulang-gulang gulat guli guliga guling gulir gulita gulma gulud gulung gulut gum guma gumal gumam gumba gumbaan gumbang gumbar gumboro gumbuk gumebruk gumelaran gumpal gumpil gumuk gumul gumun gun guna gunawan guncang gunci gundah gundal gundala gundang gundar gundi gundik gundu gunduk gundul gung gunggung gunjai gunjing gunolugu gunrit gunseikan guntai guntak guntang guntil gunting guntung guntur gunung gunyam gup gurab gurah guram gurami gurat gurau gurdan gurdi guri gurih gurik gurindam guring gurit gurita gurnadur guru gurub guruh guruk gurun gurung gurur gus gusah gusar gusel gusi gusrek gusti gusul gusur gutasi gutik gutuk guyon guyub guyur habenula habib habibi habibulah habibullah habis habitat habituasi habitus habluk hablun hablur habsyi habuan habuk habung had hadanah hadap hadas hadat hadiah hadir hadirat hadirin hadis hadron hafal hafiz hafnium hagiografi hahnium hai haid haik haiking haiku hail hailai haj hajah hajar hajat haji hajib hajim hajis hak hakaik hakam hakikat hakiki hakim hakimah hakul hakulah hakulyakin hal hala halai-balai halaik halakah halal halalbihalal halaman halang halau halazon halba haleluya halia halilintar halim halimbubu halimun halimunan halipan halitosis halkah halma halo halobion halofili halofit halofita halofob halogen halogenasi halotan halsduk halte halter haluan halus halusinasi halusinogen halwa ham hama hamal hamatum hamba hambali hambar hambat hambur hamburger hamdalah hamdu hamik hamil haminte hampa hampang hampar hampir hamud hamulus hamun hamzah hana hanacaraka hanafi hancing hancur handai handam handaruan handasah handelar handuk hang hangar hangat hanger hanggar hangit hangus hanif hanjuang hansop hantai hantam hantap hantar hantir hantu hanya hanyut hap hapetan haplografi haploid haplologi hapus hara harak harakah harakat harakiri haram harap harawan harbi hardik harem harendong harfiah harga hari haribaan harimau haring harini haris harisah harit harkat harmoni harmonik harmonika harmonis harmonisasi harmonium harnet harpa harpis harpun hart harta hartal haru haruan harum harungguan harus has hasab hasad hasai hasan hasar hasib hasid hasil hasrat hasta hasta-wara hasud hasut hasyiah hasyis hati hatif hatta hatur haud haudah haukalah haul haur hauri haus haustorium haver hawa hawar hawari hawiah hayat hayati hayo heban hebat heboh hebras hebring hedonis hedonisme hegelianisme hegemoni hegemonik hegemonisme hegemonnisme heiho heksadesimal heksagon heksahedron heksaklorida heksameter heksana heksapoda hektar hektare hektograf hektogram hektoliter hektometer hela helah helai helat helicak helikopter heling heliofit heliofobi heliograf heliogram heliometer heliosentrik helioskop heliotaksis helioterapi heliotrop heliotropisme helipad helium helm helmintologi hem hemat hematit hematite hematofobia hematologi hematom hematometra hematuri hembak hembalang hembus hemeralopi hemikordat hemiplegia hemisfer hemodialisis hemofilia hemoglobin hemolisis hemopoiesis hemopteran hemoragi hemoroid hemosit hemositometer hemostasis hemostatik hempap hempas hendak hendam hendel heng hengit hengkang hening henoteisme henry hentar henti henyak hepar hepatitis heptagon heptahedron heptameter heptana heraldik heran herba herbarium herbisida herbivor herbivora herder hereditas herediter heregistrasi heresi hering hermafrodit hermafroditisme hermetis hernia hero heroik heroin heroisme herpes herpetolog herpetologi hertz hesperidin heterodin heterodoks heterofemi heterofil heterofit heterogamet heterogami heterogen heterogenitas heterograf heterografi heteroklitus heteronim heteronimi heteronomi heteroseksual heteroseksualitas heterosfer heterosiklis heterosis heterospora heterostili heterotrof heterozigot heuristis hewan hewani hia hialin hialit hias hiatus hibah hibal hibat hibernasi hibiskus hibob hibrida hibridis hibridisasi hibuk hibur hidang hidatod hidayah hidayat hidrasi hidrat hidraulik hidraulika hidraulis hidrida hidrodinamika hidrofili hidrofit hidrofobia hidrofoil hidrofon hidrogen hidrogenasi hidrogeologi hidrograf hidrografi hidrogram hidrokarbon hidroklorida hidrokori hidroksida hidroksil hidrolika hidrolisis hidrologi hidrometeorologi hidrometer hidrometri hidromini hidronan hidropati hidroperoksida hidroponik hidropsoma hidrosfer hidrosiklon hidroskop hidrostatika hidrostatis hidroterapi hidrotermal hidu hidung hidup hiena hierarki hierarkis hieroglif hifa higiene higienis higrograf higrogram higrometer higrometri higroskop higroskopis higrotermograf higrotermogram hijab hijaiah hijau hijrah hijriah hikayat hikmah hikmat hilal hilang hilap hilau hilir hilofagus himanga himar himen himenium himne himpun hina hinap hinayana hindar hindi hindu hinduisme hingga hinggap hinggut hio hiosiamina hiosin hip hipantium hiperaktif hiperamnesi hiperbarik hiperbol hiperbolis hiperemia hiperestesia hipergami hiperkelas hiperkinesis hiperklas hiperkorek hiperkritis hiperlipemia hipermetropia hiperon hiperopia hiperparasit hiperplasia hiperseks hiperseksual hipersensitif hipersonik hipersonika hipertensi hipertonik hipertradisional hipertrikosis hipertrofi hipervitaminosis hipnosis hipnoterapi hipnotis hipnotisme hipoblas hipodermis hipodermoklisis hipodrom hipofisis hipofremia hipogen hipoglikemia hipokondria hipokotil hipokrisi hipokrit hipokritis hipolimnion hipomastia hipomnesia hiponim hipopituitarisme hipoplankton hipoplasia hipopotamus hiposentrum hipotaksis hipotek hipotensi hipotenusa hipotermia hipotesis hipotetis hipotiroid hipotiroidisme hipotonik hipovitaminosis hipsometer hipui hirap hirau hirsutisme hiru-biru hiru-hara hirudin hiruk hirup his hisab histamina histerektomi histeria histeris histerisis histidina histogeni histokimia histologi histon histopatologi histori historikus historiografi historis historisisme historisitas hit hitam hitung hiu hiyayat hobat hobi hobo hodadoda hodah hodometer hoi hokah hoki hol holi holisme holistis holmium holobentos holoenzim holofit holofitik holofrasis hologamet hologami holograf holografis hologram holokrim holokrin holoplankton holosen holozoik homeostasis homili hominid hominoid homo homofon homofoni homogami homogen homogeni homogenitas homograf homografi homogram homoiotermal homolog homologi homonim homonimi homorgan homoseks homoseksual homoseksualisme homoseksualitas homosfer homospora homoterm homozigot honae honcoe honji honor honorarium honorer honorifik hop hopagen hopbiro hopkantor hopyes horak horas hore horizon horizontal hormat hormon hornblenda horor horoskop hortikultura hortikulturis hoskut hospital hostel hostes hosti hot hotel howitzer huakiau hubar hubaya-hubaya hububan hubulwatan hubung huda hudai hudhud hudud hufaz huh hujah hujaj hujan hujat huji hujin hujung hukah hukama hukum hula-hula hulam huler hulu hulubalang hulul hulur huma human humaniora humanis humanisasi humanisme humanistis humanitas humaniter humas humbalang humektan humerus humidifikasi humiditas humifikasi humin humor humoris humoristis humorolog humus hun huncue huni hunjam hunjuk hunkue hunus hura-hura hurah huria huriah hurikan huru-hara huruf hus husnulkhatimah hut hutan hutang huyung ialah iambus iatrogenik iba ibadah ibadat ibadurahman iban ibar-ibar ibarat ibayuh ibid ibidem ibing iblis ibni ibnu ibra ibrit ibtida ibtidaiah ibu ibul ibun ibunda ibung ibus icak-icak ida idafi idah idam idap idarah idas ide ideal idealis idealisasi idealisme idealistis idem identifikasi identik identitas ideofon ideograf ideografi ideografis ideogram ideologi ideologis ideosinkretik idep idiil idiolek idiom idiomatis idiomatologi idiosi idiosinkrasi idiosinkretik idiot idola idrak idu iduladha idulfitri ifah ifrit iftar iftitah iga igal igau iglo ihdad ihram ihsan ihsanat ihsas ihtifal ihtikar ihtilam ihtimal ihwal ijab ijabat ijajil ijarah ijas ijazah ijbar ijeman ijil ijmak ijmal ijon ijtihad ijtimaiah ijtimak ijuk ikab ikal ikamah ikan ikat ikebana ikhbar ikhlas ikhtiar ikhtiari ikhtilaf ikhtiogeografi ikhtiosarkotoksisme ikhtiotoksisme ikhtisar ikhwan iklan iklim ikon ikonis ikonograf ikonografi ikonoklasme ikonometer ikrab ikram ikrar iktibar iktidal iktikad iktikaf iktiografi iktiolit iktiologi iktiologis iktirad iktiraf iktisab ikuh ikut ilafi ilah ilahi ilahiah ilahiat ilai ilak ilam-ilam ilanun ilar ilas ilat ilegal iler iles ileum ilham ili ilian iling ilmiah ilmu ilu iluminasi ilusi ilusif ilusionis ilustrasi ilustratif ilustrator imago imaji imajinasi imajinatif imajiner imak imam imamah imamologi iman imanen imanensi imani imbak imbal imbang imbas imbau imbesil imbesilitas imbibisi imbit imbuh imigran imigrasi iming-iming imitasi imitatif imitator imkan imla imlek imobilisasi impak impas impase impedans impedansi impek imperatif imperfek imperfeksi imperial imperialis imperialisme imperium impersonal impersonalia impersonalitas impi impit implan implantasi implemen implementasi implikasi implisit implosif implosit impor importasi importir impoten impotensi impregnasi impresariat impresario impresi impresif impresionis impresionisme impresionistik imprimatur improvisasi impuls impulsif imsak imsakiah imtihan imun imunisasi imunitas imunokimia imunokompromi imunologi imunologis imunosupresi imunoterapi ina inadaptabilitas inai inang inangda inap inartikulat inas inaugurasi inayat inca incang-incut incar inci incit incling incrit incu incut indah indang indap indarus indayang indebitum indehoi indekos indeks inden independen inderawasih indeterminisme indigenos indigo indik indikan indikasi indikatif indikator inding indisipliner indium individu individual individualis individualisasi individualisme individualistis individualitas individuasi indoktrinasi indolen indolensi indologi indonesia indonesianisasi indra indraloka indranila indria indriawi indu induk induksi induktans induktansi induktif induktor indung indusemen industri inefisiensi inersia infak infanteri infantil infantilisasi infantilisme infarktus infeksi inferensi inferensial inferior inferioritas inferno infertil infertilitas infiks infiltrasi infiltrometer infinitif infiradi inflamasi inflasi inflatoar infleksi infleksibel infleksif infloresen infloresens influensa influenza info informal informan informasi informatif informatika inframerah infrasonik infrastruktur infus inga ingar ingat ingau inggang-inggung inggris inggu inggung ingin ingkah ingkar ingkir ingresif ingsar ingsun ingsut ingus inheren inhibisi inhibitor ini inisial inisiasi inisiatif inisiator injak injap injeksi injil injin inkarnasi inkarserasi inkarsunah inkaso inklaring inklinasi inklinometer inklusif inkognito inkompabilitas inkompatibilitas inkompeten inkomplet inkonfeso inkonsisten inkonsistensi inkonstitusional inkonvensional inkorporasi inkremental inkubasi inkubator inkulturasi inlander inohong inokulasi inovasi inovatif inovator insaf insan insanan insang insani insanulkamil insar insek insekta insektari insektisida insektivor insektivora insektologi inseminasi insentif insersi inses inset insiden insidental insinerator insinuasi insinuatif insinye insinyur inskripsi inslan insolven insomnia inspeksi inspektorat inspektur inspirasi instabilitas instalasi instalatur instan instansi insting instingtif institusi institusional institut instruksi instruksional instruktif instruktur instrumen instrumental instrumentalia instrumentalis instrumentasi insubordinasi insulator insuler insulin insulinde insya intai intan integral integralistik integrasi integrasionis integritas integumen intel intelek intelektual intelektualisasi intelektualisme inteligen inteligensi inteligensia intelijen intendans intens intensi intensif intensifikasi intensional intensitas interaksi interaksionistik interaktif interdepartemental interdependen interdiksi interdisipliner interegnum interelasi interen interes interesan interetnik interferens interferensi interferometer interferon interglasial interim interinsuler interior interjeksi interkom interkoneksi interkonsonantal interkontinental interlokal interlokutor interlud intermeso intermezo intermolekuler intern internal internalisasi internasional internasionalisasi internat internir internis internuntius interogasi interogatif interogator interpelan interpelasi interpelator interpiu interpolasi interpretasi interpretatif interpretator interpreter intersepsi intertestial intertidal interupsi interval intervensi intervensionisme interviu interzona inti intifadah intiha intikad intim intima intimasi intimidasi intip intipati intisari intoksikasi intoleran intonasi intradermal intrakalimat intrakurikuler intralinguistis intramembran intramolekul intramuskuler intransitif intraseluler intrauniversiter intravaskuler intravena intrik intrinsik intro introduksi introjeksi introspeksi introver intrusi intuisi intuitif intumesensi invaginasi invalid invasi invensi inventaris inventarisasi inventif inventor inventori inventoriminat inversi invertebrata investasi investigasi investigatif investor invitasi invois involusi inyik inzar inziaj iodin ion ionisasi ionosfer iota ipar ipis ipon iprit ipuh ipuk ipung iqamat iqra ira iradat iradiasi irafah irah-irahan iram irama iras irasional irasionalitas iri iridium irigasi irigator irik iring iris irit iritabilitas iritasi iritatif ironi ironis irsyad irung irus isa isak isalohipse isap isbat iseng isentropik isi isim isis isit iskemia islah islam islami islamiah islamis islamisasi islamisme islamologi isoaglutinin isobar isobarik isobat isobron isodin isodinamik isofase isofen isofet isoflor isofon isogamet isogami isoglos isogon isogram isohalin isohel isohiet isohips isokal isokalori isokemi isokeraunik isokor isokorik isokronisme isolasi isolasionisme isolatif isolator isoleks isolemen isolir isomer isometrik isomorf isomorfis isomorfisme isonefel isonomi isopal isopiestik isoplet isoriza isosilabisme isotah isoterm isotermal isotop isotrop isotropik isovolumik isra israf istal istan istana istanggi istaz istazah istiadat istianah istiazah istibdad istibra istidlal istidraj istifham istigasah istigfar istihadah istihsan istikamah istikharah istikhlaf istiklal istikmal istilah istima istimaiah istimewa istimna istimtak istinggar istinja istiqlal istirahat istislah istislam istisna istitaah istiwa istri isu isya isyarat isytiak isyu italik item iterasi iterbium itibak itibar itidal itifak itihad itik itikad itikaf itil itisal itlak itrium itu iudisasi iur iwad iya izah izin jaat jab jabal jabang jabar jabariah jabat jabir jables jabrik jabung jadah jadam jadayat jadi jaduk jadwal jaga jagabaya jagabela jagal jagang jagapati jagaraga jagat jagawana jago jagra jagrak jaguar jagung jagur jah jaha jahan jahanam jahar jaharu jahat jahe jahil jahiliah jahiriah jahit jahul jail jainisme jaipong jais jaiz jaja jajah jajak jajal jajan jajar jaka jakal jakas jaket jaksa jaksi jakun jala jalabria jalad jalak jalal jalan jalang jalangkote jalangkung jalar jali jalibut jalil jalin jalma jalu jalur jam jamaah jamadat jamah jamak jamal jaman jamang jambak jambal jamban jambang jambar jambat jambe jambiah jambian jamblang jambon jambore jambret jambu jambul jambulan jambur jamhur jamiah jamiatul jamik jamil jamin jamis jamiyah jamiyatul jampal jampen jampi jampuk jamrah jamrud jamu jamung jamur janabah janabijana janah janat janda jangak jangan jangar jangat janggal janggelan janggi janggolan janggung janggut jangka jangkah jangkang jangkap jangkar jangkat jangkau jangki jangkih jangkih-mangkih jangking jangkir jangkit jangkrik jangkung jangla jangol jani janik janin janjang janji jantan jantang jantina jantuk jantung jantur janturan januari janubi janur jap japan japin japu japuk jara jarab jarah jarak jaram jaran jaranan jarang jaras jarem jargon jari jariah jariji jarimah jaring jarit jarjau jaro jarotan jarum jarwa jas jasa jasad jasadi jasmani jasmaniah jasus jaswadi jatah jatayu jati jatilan jatmika jatuh jatukrama jauh jauhar jauhari jauza jawab jawang jawanisasi jawara jawat jawawut jawer jawi jawil jaya jayacihna jayapatra jayasong jayastamba jayeng jaz jazam jazirah jazirat jebab jebah jebai jebak jebang jebar jebat jeblok jeblos jebluk jebol jebor jebrol jebuh jebung jebur jeda jeding jedot jegal jegang jegil jegogan jegung jejabah jejak jejaka jejal jejap jejas jejengkok jejer jejunum jejuri jeket jeksi jel jela jelabak jelabir jeladan jeladren jeladri jelaga jelagra jelah jelai jelajah jelak jelalat jelamprang jelanak jelang jelangak jelangkung jelantah jelapak jelapang jelar jelarang jelas jelata jelatang jelau jelawat jelejeh jelek jelempah jelengar jelentik jelepak jelepok jeli jelih jelijih jelimet jelimpat jeling jelir jelit jelita jelma jelu jeluak jeluang jelujur jeluk jelum jelungkap jeluntung jelunut jelur-jelir jelus jelut jelutung jem jemaah jemaat jemah jemala jemang jemari jemaring jemawa jemba jembak jembalang jembar jembatan jembel jember jembiah jembrana jembut jemeki jemerlang jempalik jempalit jempana jemparing jempol jempul jemput jemu jemuas jemuju jemur jenahar jenak jenaka jenama jenang jenangau jenat jenawi jenayah jenazah jendal jendala jendel jendela jendera jenderal jendol jendul jenela jeneng jenewer jeng jengah jengang jengat jengek jenggala jenggar jengger jengget jengglong jenggot jengguk jenggul jenggut jengit jengkal jengkang jengkek jengkel jengkelit jengkeng jengker jengket jengki jengking jengkit jengkol jengkolet jengkot jenglong jenguh jenguk jengul jenis jenius jenjam jenjang jenjeng jentaka jentang jentayu jentelmen jentera jentik jentur jenu jenuh jepa jepet jepit jeprat jepret jepun jeput jera jerabai jeradik jeragih jerah jerahak jerahap jerait jeram jeramah jerambah jerambai jerambang jerambung jerami jeran jerang jerangan jerangau jerangkah jerangkak jerangkang jerangkong jerap jerapah jerat jerau jeraus jerawat jerba jerbak jereket jeremak jeremba jerembap jerembat jerembet jerembun jerempak jereng jerepet jeri jeriau jerigen jerih jeriji jeriken jering jeringing jerit jerjak jerkah jerkat jermal jermang jernang jernih jero jeroan jerohok jerojol jerongkang jerongkes jerongkok jerongkong jerubung jeruji jeruju jeruk jerukun jerukup jerum jerumat jerumbai jerumun jerumus jerun jerung jerungkau jerungkis jerungkung jerunuk jerupih jesben jet jetis jetsam jetset jewer jiawang jib jibaku jibilah jibrail jibril jibti jibun jicap jicapgo jicing jidal jidar jidat jidur jigong jigrah jih jihad jihat jijik jijit jijitsu jika jikalau jil jila jilah jilam jilat jilbab jilid jim jimahir jimak jimakir jimat jimawal jimbit jimpit jin jinak jinayah jindra jinem jineman jineng jingap jingau jingga jinggring jingkat jingkik jingkrak jingo jingoisme jingu jinjang jinjing jinjit jinsom jintan jip jipang jiplak jipro jir jirak jiran jirat jirian jirus jisim jitah jitak jitok jitu jiwa jiwat jiwatman jiwit jizyah jlegur joang jobak jobong jodang jodoh jodong jogan jogar joget joging joglo johan johar johari jojing jojol jok joki jolak jolek joli jolok jolong jolor jombang jomlo jompak jompo jongang jonget jongga jonggol jonggolan jongjorang jongkang jongkar-jangkir jongkat-jangkit jongki jongko jongkok jongkong jongos jonjot jontoh jontor jontrot joran joreng jori jorjoran jorok jorong josna jota jotang jotos joule jrambah jreng jua juadah juak jual juan juandang juang juar juara jubah jubel jubin jublag jublek judek judes judi judo judogi judoka judul juek juga juhi juhut juih juita juja jujah jujai jujitsu juju jujuh jujur jujuran jujut jukstaposisi jukut julab julai julang julat juli juling julir julo juluk julung julur jumadilakhir jumadilawal jumantan jumantara jumat jumbai jumbil jumbo jumbuh jumbul jumeneng jumhur jumjumah jumlah jumpa jumpalit jumpang jumpelang jumpul jumput jumrah jumud jun junam jundai jung jungat junggang jungkal jungkang jungkar jungkat jungkir jungkit jungkol jungur jungut juni junior junjung junta juntai juntrung junub junun jupang jura juragan jurai jurang juri jurik juring juris jurit jurnal jurnalis jurnalisme jurnalistik juru juruh jurung jurus jus justifikasi justru juta jute juvenil juz kaabah kaba kabah kabak kabang-kabang kabar kabaret kabat kabau kabel kabihat kabil kabilah kabin kabinet kabir kabisat kabit kaboi kabriolet kabruk kabu-kabu kabuki kabul kabumbu kabung kabupaten kabur kabus kabut kaca kacak kacam kacamata kacang kacapiring kacapuri kacar kacau kacau-balau kacauan kacek kacer kaci kacici kacip kacir kaco kacrek kacu kacuk kacukan kacung kad kada kadahajat kadal kadam kadang kadar kadariah kadas kadaster kadasteral kadastral kadaver kade kademat kadensa kader kadera kaderisasi kades kadet kadi kadim kadipaten kadir kadiriah kadmium kado kadofor kadok kadru kadung kadut kaedah kaf kafaah kafah kafan kafarat kafe kafeina kafetaria kafi kafil kafilah kafir kafiri kaftan kagak kaget kagok kagum kah kahaf kahak kahan kahang kahar kahat kahin kahrab kahwa kahwaji kahyangan kaidah kaifiah kaifiat kail kailalo kaimat kain kaing kainit kainofobia kais kaisar kait kajai kajang kajangan kajen kaji kak kakaban kakafoni kakagau kakah kakak kakaktua kakanda kakang kakao kakap kakas kakawin kakbah kakek kakerlak kaki kakodil kakofoni kakografi kakok kakologi kakostokrasi kakrupukan kaksa kaktus kaku kakuminal kakus kala kalah kalai kalajengking kalaka kalakanji kalakati kalakeran kalakian kalam kalamba kalamdan kalamin kalamisani kalamkari kalandar kalander kalang kalap kalar kalas kalat kalau kalaupun kalawija kalaza kalbi kalbu kaldera kaldron kaldu kalebas kaleidoskop kaleidoskopis kalem kalempagi kalender kaleng kali kalian kaliber kalibit kalibrasi kalibut kalicau kalifornium kaligraf kaligrafi kaligrafis kalih kalikausar kaliki kalimah kalimantang kalimat kalimatullah kalimatusyahadat kalimayah kalimpanang kalingan kalio kaliper kalipso kaliptra kalis kalistenik kalium kalk kalkalah kalkarium kalkasar kalkausar kalkopirit kalkosium kalkulasi kalkulator kalkulus kalkun kalo kalomel kalong kalongwese kalongwewe kalor kalori kalorimeter kalorimetri kalorisitas kalowatan kalpataru kalsedon kalsiferol kalsifikasi kalsinasi kalsit kalsium kalui kalumet kalung kalus kalut kam kama kamajaya kamal kamalir kamantuhu kamar kamarban kamariah kamas kamat kamba kamban kambang kambar kambeh kambeli kamber kambi kambing kambium kambrik kambrium kambuh kambus kambut kamelia kamera kamerad kamfana kamfer kamfor kamhar kami kamikaze kamil kamilmukamil kamir kamis kamisa kamisol kamisosolen kamit kamitua kamka kamkama kamomil kamp kampa kampai kampalogi kampanologi kampanye kampas kampemen kamper kampil kamping kampiun kampos kampret kampuh kampul kampung kampus kamrad kamsen kamsia kamu kamuflase kamus kan kana kanaah kanaat kanabis kanak-kanak kanal kanalisasi kanan kanang kancah kancap kanceh kancera kancil kancing kancung kancut kanda kandang kandar kandas kandel kandela kandi kandidat kandidiasis kandil kandis kandul kandung kandut kane kang kangar kangen kangka kangkang kangkung kangmas kangsa kangsar kangtau kanguru kanibal kanibalisasi kanibalisme kanigara kanilem kanina kanisah kanjal kanjang kanjar kanjeng kanji kanker kano kanoman kanon kanonir kanonis kanopi kans kansel kanselari kanselir kanser kanstof kanta kantan kantang kantar kantata kanti kantih kantil kantilever kantin kanto kantong kantor kantuk kantung kanun kanvas kanya kanyon kaok kaolin kaon kaos kaotis kap kapa kapabel kapah kapai kapak kapal kapan kapang kapar kaparinyo kapas kapasitans kapasitas kapasitor kapat kapel kapela kaper kapi kapilaritas kapiler kapiran kapis kapisa kapit kapita kapital kapitalis kapitalisme kapitalistis kapitan kapitol kapitulasi kapitulum kaplares kaplars kapling kaplok kapok kapon kaporit kappa kaprah kapri kaprikornus kapsalon kapsel kapstan kapster kapstok kapsul kapten kapu kapuk kapung kapur kapurancang kara karabin karaeng karaf karagen karah karahah karakter karakterisasi karakteristik karakterologi karam karamba karambol karamel karang karangkitri karangwulu karantina karaoke karap karapaks karapan karar karas karat karate karategi karateka karau karavan karawitan karbiah karbid karbida karbohidrase karbohidrat karboksil karbol karbolat karbolik karbon karbonado karbonan karbonat karbonil karbonisasi karborundum karburasi karburator karcis kardamunggu kardan kardia kardiak kardigan kardil kardinal kardiograf kardiografi kardiogram kardiolog kardiologi kardiovaskular karditis kardus karel karembong karena karengga kareseh-peseh karet kargo kari karib karibu karier karies karih karikatur karikatural karikaturis karil karim karimah karina karinasi karisma karismatik karismatis karitas karitatif karkas karkata karkum karkun karma karmina karminatif karnaval karnivor karosel karoseri karotena karotenoid karotis karpai karpel karper karpet karpopodil karsa karsinogen karsinogenik karsinologi karsinoma karst karteker kartel karti kartika kartilago kartografi kartogram karton kartonase kartotek kartu kartun kartunis karu karuan karuhun karun karung karunia karunkel karusi karut karya karyah karyasiswa karyat karyawisata kas kasa kasab kasabandiah kasad kasah kasai kasak-kusuk kasam kasang kasap kasar kasasi kasatmata kasau kasdu kasein kasemat kasemek kasep kaserin kaserol kaset kasi kasid kasidah kasih kasihan kasiku kasim kasima kasino kasintu kasip kasir kasiterit kaskade kaskaya kasmaran kasmir kasmutik kaspe kasrah kasregister kassia kasta kastal kastanyet kastel kasti kastrasi kastroli kasturi kasual kasualisme kasualitas kasuari kasuarina kasui kasuis kasuistik kasur kasus kasut kaswah kata katabalik katabatik katabolisme katadrom katafalk katafora katah katai katak kataka katakana kataklisme katakomba katalase katalepsi katalina katalis katalisasi katalisator katalisis katalisit katalog katalogisasi katalogus katamaran katang-katang katapel katar katarak katarsis katartik katastrofe katatoni katatonia katawi kate katebelece katedral kategori kategorial kategoris kategorisasi katek katekese katekis katekisasi katekismus katekumen katel katelum kater katering kates kateter katetometer kati katib katibin katifah katifan katik katil katimaha katimumul kation katir katirah katiti katode katok katolik katrol katuk katul katun katung katup katut katvanga katwal kau kaukab kaukasoid kaukus kaul kaula kauli kaum kaung kaupui kaus kausa kausal kausalitas kausatif kaustik kaustiksoda kaut kavaleri kaveling kaver kaviar kawa-kawa kawah kawak kawal kawan kawang kawanua kawasan kawat kawi kawih kawijayan kawin kawista kawruh kawuk kawula kawung kaya kayai kayak kayambang kayan kayang kayangan kayau kayu kayuh kayun kebab kebabal kebah kebaji kebal kebam kebas kebat kebaya kebayan kebel kebelet kebembem kebin kebiri keblangsak keblinger kebo kebon kebuk kebul kebuli kebun kebur keburu kebut kebyar kecai kecak kecalingan kecam kecambah kecamuk kecandan kecantol kecap kecapi kecar kece kecebong kecek kecele keceng kecepek kecer kecewa keci keciak kecibak kecibeling kecik kecil kecimik kecimpring kecimpung kecimus kecipak kecipir kecipuk kecit keciut kecoak kecoh kecombrang kecong kecrek kecu kecuali kecubung kecuh-kecah kecumik kecundang kecup kecut kedabu kedadak kedah kedai kedak kedal kedaluwarsa kedam kedang kedangkai kedangkan kedangsa kedap kedasih kedau kedaung kedayan kedebong kedek kedekai kedeki kedekik kedekut kedelai kedele kedemat kedemplung kedempung kedengkang kedengkik keder kedera kederang kedewaga kedi kedidi kedik kedikit kedip kedodoran kedok kedondong kedongdong kedongkok kedot keduduk keduk kedul kedumung kedung kedut keferdom kehel keibodan kejai kejam kejamas kejan kejang kejap kejar kejat kejawen kejen kejer keji kejibeling kejip kejolak kejora keju kejuju kejur kejut kek kekah kekal kekam kekandi kekang kekapas kekar kekara kekas kekat kekau kekawin kekeba kekebik kekeh kekek kekel kekemben kekep keker keki kekitir kekok kekol kekrupukan kelab kelabak kelabang kelabat kelabau kelabu keladak keladan keladau keladi kelah kelahi kelai kelak kelak-kelik kelak-keluk kelakah kelakanji kelakar kelalang kelam kelamai kelamarin kelambai kelambir kelambit kelambu kelambur kelamin kelamkari kelana kelandera kelang kelang-kelok kelangkan kelangkang kelanjar kelantang kelap kelapa kelar kelara kelarah kelarai kelaras kelari kelas kelasa kelasah kelasak kelasi kelat kelati kelawan kelayan kelayang kelayu kelder kelebat kelebek kelebet kelebu kelebuk kelebut keledai keledang keledar keledek kelejat kelek kelekap kelekatu kelelap kelelawar kelelesa kelelot kelemak-kelemek kelemantang kelemayar kelemayuh kelembahang kelembai kelembak kelemban kelembuai kelempai kelemping kelemton kelemumur kelemur kelencer kelendara keleneng kelengar kelenggara kelengkeng kelengkiak kelening kelenjar kelentang kelenteng kelentik kelenting kelentit kelentong kelentung kelenung kelenyit kelep kelepai kelepak kelepat kelepek kelepet kelepik kelepir kelepit kelepuk kelepur keler kelereng kelesa kelesah keleseh kelesek kelesot keletah keletak keletang keletar keleti keletik keletuk keletung kelewang keli kelian keliar kelibang kelibat kelicap kelici kelicik kelih kelijak kelik kelika kelikah kelikat keliki kelikih kelikik kelikir keliling kelilip kelim kelimat kelimpanan kelimpungan kelimun kelimut kelinci kelincir kelindan keling kelingking kelingsir kelining kelinjat kelintang kelintar kelinting kelip kelipat kelir keliru kelis kelisera kelisere kelit keliti kelitik keliwon kelobot kelobotisme kelocak keloceh kelodan keloelektrovolt keloid kelojot kelok kelokak kelola kelolong kelom kelombeng kelompang kelompen kelompok kelon keloneng kelonet kelong kelongkong kelongsong kelontang kelontang-kelantung kelontong kelonyo kelop kelopak kelor kelorak kelos kelosok kelotok keloyak keloyang keloyor kelp kelu kelua keluai keluak keluan keluang keluangsa keluar keluarga kelubak kelubi keluburan keluh kelui keluih keluk kelukup kelukur keluli kelulu kelulus kelulut kelumit kelumpang kelumun kelun keluna kelunak kelung kelupas kelupur keluron keluruk kelurut kelus kelusuh-kelasah kelut kelutum keluwung keluyuk keluyur kemah kemal kemala kemam kemamang keman kemandang kemandoran kemang kemangi kemarau kemari kemarin kemaruk kemas kemat kematu kematus kemayu kembal kembali kemban kembang kembar kembara kembatu kembayat kembeng kembera kembili kemboja kembol kembu kembuk kembung kembur kembut kemeja kemejan kemekmek kemelut kemenakan kemendalam kemendang kemendur kementam kemenyan kemerakan kemesu kemi kemih kemik kemilap kemiluminesens kemiri kemit kemlaka kemlandingan kemloko kemoceng kemokinesis kemon kemopsikiatri kemoterapi kempa kempal kempang kempas kempek kempes kempetai kempis kempit kemplang kempot kempu kempuh kempul kempunan kempung kemput kempyang kemu kemucing kemudi kemudian kemudu kemukus kemul kemumu kemuncak kemuncup kemundir kemung kemungkus kemuning kemunting kemurgi kemut kemutul ken kena kenaf kenal kenan kenang kenanga kenap kenapa kenapang kenari kenas kencan kencana kencang kencar kenceng kencing kencit kencong kencrang-kencring kencreng kencung kencur kendaga kendal kendala kendali kendana kendang kendara kendati kendayakan kendeka kenderi kendi kendil kendit kendo kendong kenduduk kendung kendungan kendur kenduri kenek keneker kenem kenematik kenes keng kengkang kengkeng kenidai kenikir kening kenohong kenong kenop kensel kental kentang kentar kentara kenteng kentrung kentung kentut kenur kenya kenyal kenyam kenyang kenyat kenyat-kenyit kenyet-kenyut kenyi kenyih kenyir kenyit kenyut keok keong kep kepada kepah kepai kepak kepal kepala kepalang kepam kepang kepar keparat kepayang kepecong kepek kepel kepencong kepeng keper keperancak kepet kepetang kepialu kepiat kepik kepil kepincut kepinding keping kepingin kepinis kepinjal kepiri kepis kepit kepiting keplak kepleset keplok kepodang kepoh kepol kepompong keponakan kepot keprak keprek kepret kepris kepruk kepuh kepuk kepul kepulaga kepundan kepundung kepung kepurun keputren kepuyuk kera kerabang kerabat kerabik kerabu keracak keracap keraeng kerah kerahi kerai kerajang kerajat kerak kerakah kerakal kerakap kerakeling keram kerama keraman keramas keramat keramba kerambil kerambit keramboja keramik keramikus kerampagi kerampang keramunting keran kerancang keranda kerang kerang-keroh kerangas kerangka kerangkai kerangkeng kerani keranjang keranjat keranji keranjingan keranta kerantong kerap kerapu keras kerasan kerat keratabasa keratin keratitis keratoelastin keraton kerau kerawai kerawak kerawang kerawat kerawit kerbang kerbat kerbau kerbuk kercap-kercip kercap-kercup kercing kercit kercup kercut kerdak kerdam kerdil kerdom kerdut kere kerebok kereceng kerecik keredak keredep keredok keredong kerek kereket kerekot kerekut keremi keremot kerempagi kerempeng kerempung keremus keren kerencang kerencung kerendang kereneng kereng kerengga kerenggamunggu kerengkam kerengkiang kerentam kerentang kerenting kerenyam kerenyot kerepas kerepek kerepes kerepot kerepyak kerese kerese-pese keresek kereseng keresot kereta keretan keretek keretot keretut kereweng keri keriang-keriut keriap kerias keriau kerical kericau keridas keridik kerih kerik kerikal kerikam kerikil kerikit kerimut kerinan kerincing kerinding kering keringat keriningan kerinjal kerinjang kerinjing kerintil kerinting kerip keripik keriput keris kerisi kerisik kerising kerisut kerit keritik keriting keriuk keriut kerja kerjang kerjantara kerjap kerkah kerkak kerkap kerkau kerkop kerkup kerlap kerling kerlip kermak kermanici kermi kernai kerneli kernet kernu kernyat-kernyut kernyau kernyih kernying kernyit kernyut kero kerobak kerobat kerobek keroco kerocok kerogen keroh kerok kerokot keromong keron keroncang keroncong keroncor kerong kerongkongan kerongsang kerontang kerop keropak keropas-keropis keropeng keropok keropong keropos kerosak kerosek kerosi kerosin kerosok kerosong kerot kerotak kerotot keroyok kerpai kerpak kerpas kerpubesi kerpuk kerpus kers kersai kersak kersang kersani kersen kersik kersuk kertaaji kertah kertak kertang kertap kertas kertau kertuk kertus keruan kerubim kerubin kerubung kerubut kerucil kerucut kerudung keruh keruing keruit keruk kerukut kerul keruma kerumit kerumuk kerumun kerumus kerun kerung kerunkel keruntang-pungkang kerunting keruntung kerunyut kerup kerupuk kerut kerutak kerutup keruyuk kes kesah kesak kesal kesam kesambet kesambi kesan kesana kesandung kesang kesangsang kesap-kesip kesasar kesat kesatria kesek kesel keselak keseleo kesemek kesengsem keseran keseser keset kesi kesiap kesik kesima kesimbukan kesini kesip kesitu kesiur keskul kesmaran kesohor kesomplok kesongo kesot kesrakat kesting kesturi kesu-kesi kesuh-kesih kesuk-kesik kesuma kesumat kesumba kesup kesusu kesut keta ketaban ketai ketak ketakar ketakong ketal ketam ketambak ketampi ketan ketang ketap ketapak ketapang ketapek ketar ketarap ketat ketaton ketawa ketaya ketayap ketegar ketek ketel ketela ketemu keten ketena keteng ketepel ketepeng keter ketes keteter ketgat keti ketiak ketial ketiap ketiau ketiban ketiding ketik ketika ketil ketilang ketimbang ketimbis ketimbul ketimbung keting ketinjau ketinting ketip ketiplak ketipung ketirah ketis ketitir ketlingsut ketogenesis ketok ketola ketombe keton ketonemia ketonggeng ketonuria ketopong ketoprak ketosa ketrek ketu ketua ketuat ketuban ketuir ketuk ketul ketumbar ketumbi ketumbit ketumbu ketumpang ketun ketungging ketup ketupa ketupat ketupuk ketur ketus kev kewalahan keweni kewer kewes kewuh kha khabis khadam khadim khafi khair khairat khalas khalayak khali khalifah khalifatulah khalifatullah khalik khalikah khalikul khalil khalilullah khalis khalwat khamar khamir khamsin khamzab khanjar kharab khas khasi khasiat khat khatam khatib khatifah khatimah khatulistiwa khauf khaul khawas khawasulkhawas khawatir khayal khayali khazanah khi khianat khiar khidaah khidmah khidmat khilaf khilafiah khinzir khisit khitah khitan khitbah khizanatulkitab khoja khojah khotbah khuduk khulafa khuldi khuluk khunsa khurafat khusuf khusus khusyuk kia kiah kiai kiak kial kiam kiamat kiambang kian kiang-kiut kiani kiap kiar kiara kias kiasi kiasmus kiat kiaupau kibang kibar kibas kibernetika kibir kiblat kiblik kibriah kibul kicang-kecoh kicang-kicu kicau kici kicik kicu kicuh kicut kida-kida kidal kidam kidang kidar kidul kidung kifayah kifoskaliose kifoskaliosis kihanat kijai kijang kijil kijing kikih kikik kikil kikir kikis kikitir kikuk kikus kila kilah kilai kilan kilang kilap kilar kilas kilat kilau kili kilik kilir kiln kilo kilogram kilohertz kilokalori kiloliter kilometer kiloton kilovolt kilowatt kilowattjam kilus kim kima kimah kimantu kimar kimbah kimbang kimbul kimia kimiawi kimkha kimlo kimo kimograf kimono kimpal kimpul kimpus kimput kimus kina kinang kinantan kinanti kinasa kinasih kinca kincah kincak kincang kincau kincir kincit kincung kincup kindap kinematika kinematograf kinesika kinesimeter kineskop kinestesia kinestesiometer kinestesis kinetik kinetika kinetokardiografi kingking kingkip kingkit kingkong kini kinine kinja kinjat kinjeng kinred kintaka kintal kinte kintil kinyang kio kios kipa kipai kipang kipas kiper kiprah kiprat kipsiau kipu kir kira kiraah kiraat kirab kirai kiramat kiran kirana kirap kiras kirau kirbat kiri kirik kirim kirinyu kirip kiris kirita kirmizi kiru kiruh kirung kisa kisah kisai kisar kisas kisat kisi kisik kismat kismis kisruh kista kisut kiswah kit kita kitab kitabulah kitang kitar kitik kitin kiting kitir kitorang kitri kits kiu kiuk kiwari kiwi kizib klaim klakklik klakson klamidospora klan klandestin klangenan klante klarifikasi klarinet klasemen klasifikasi klasik klasikal klasis klasisisme klausa klaustrofobia klaustrum klausul klaver klavikor klavikula klaviola kleder kleidotomi kleistogami klem klemensi klen klenengan klengkeng klenik klenteng klep klepon klepsidra kleptofobi kleptoman kleptomania kleptomaniak klerek klerikal klerikus klerus klien klik kliker klimaks klimakterium klimaktorium klimatografi klimatolog klimatologi klimis klimograf klimosekuen klimoskop klin klinik klining klinis klinisi klinometer klip kliping klir kliring klise klistron klitelum klitik klitoris kliwon kliyengan kloaka klon klona kloning klonograf klonus klop klor kloral kloramina klorat klorida kloridimeter klorin klorinasi klorit klorobenzena klorofil kloroform kloroformat klorokuin klorolignin kloroplas kloroprena klorosis kloset klub kluntang-kluntung klusium knalpot knop knot koa koagel koagregasi koagulan koagulasi koak koaksi koaksial koala koalisi koana koar kobah kobak kobalamin kobalt kobar kober koboi koboisme kobok kobol kobongan kobra kocah-kacih kocak kocar-kacir kocek koci kocilembik kocoh kocok kocolan kocong kocor koda kodak kode kodein kodeks kodi kodifikasi kodok kodominan kodrat kodrati koe koedukasi koefisien koeksistensi koenzim koersi koersif kofaktor kofein kofermen kognat kognatif kognisi kognitif koh kohabitasi koheren koherensi kohesi kohesif kohir kohlea kohol kohong kohor koil koin koinseden koinsiden koinsidensi koipuk koit koitus koja kojah kojang kojoh kojol kojor kok koka kokah kokaina kokainisasi kokainisme kokang kokarde kokas koki kokila koklea kokoa kokoh kokok kokokbeluk kokol kokon kokosan kokot kokpit koksa koktail kokurikuler kokus kol kola kolaborasi kolaborator kolagen kolak kolam kolang-kaling kolaps kolaret kolase kolateral kolator kolega kolegial kolegialitas koleh-koleh kolek koleksi kolekte kolektif kolektivis kolektivisasi kolektivisme kolektivitas kolektor kolembeng kolemia koleng koleoptil kolera kolese kolesom kolesterin kolesterol koli kolibri koligasi kolik kolimasi kolina kolintang koliseng kolitis kolkhoz kolodion kolofon kologen koloid koloidal kolok kolokasi kolokium kolom kolomben kolon kolone kolonel kolong koloni kolonial kolonialis kolonialisme kolonis kolonisasi kolonoskop kolonye kolor kolorimeter kolorimetri kolosal kolosom kolostomi kolostrum kolot kolportir kolt kolum kolumela kolumnis kolumnus kolusi koluvium kom koma komaliwan koman komandan komandemen komanditer komando komaran komat-kamit kombat kombinasi kombo kombor kombusio komedi komedian komendur komeng komensal komensalisme komentar komentator komersial komersialisasi komet komfortabel komidi komik komikal komikus kominusi komis komisar komisariat komisaris komisi komisioner komisura komit komite komitmen komkoma komoditas komodo komodor kompak kompanyon komparasi komparatif komparator kompartemen kompas kompatibel kompatibilitas kompatriot kompendium kompeni kompensasi kompes kompeten kompetensi kompetisi kompetitif kompetitor kompi kompilasi kompilator komplain kompleks kompleksitas komplemen komplementer komplet komplikasi komplikatif komplimen komplot kompon komponen kompong komponis kompor kompos komposer komposisi komposit kompositum komprador komprang komprehensif kompres kompresi kompresor kompromi kompromistis kompulsi kompulsif komputer komputerisasi komtabilitas komunal komunalisme komunalistik komune komuni komunikabilitas komunikan komunikasi komunikatif komunikator komunike komunis komunisme komunistis komunistofobi komunistofobia komunitas komutator komuter konan konco koncoisme kondang kondangan konde kondektur kondensasi kondensat kondensator kondensor kondilus kondisi kondom kondominium kondomisasi kondor kondrin kondroblas konduite konduksi konduktans konduktimeter konduktivitas konduktor kondusif koneksi koneksitas konektor konfederasi konfeksi konferensi konfesi konfigurasi konfiks konfirmasi konflik konform konformitas konfrontasi konfrontatif kongenital kongesti kongkalikong kongko kongkoan kongkong kongkret konglomerasi konglomerat kongregasi kongres kongresis kongsi konifera konis konjugan konjugasi konjungsi konjungter konjungtif konjungtiva konjungtivitis konjungtor konjungtur konkaf konklaf konklusi konklusif konkologi konkomitan konkordansi konkordat konkresi konkret konkretisasi konkuisnador konkuren konkurensi konkurs konoid konon konosemen konotasi konotatif konperensi konsekrasi konsekuen konsekuensi konsekutif konseli konseling konselor konsensus konsentrasi konsentrat konsentrik konsentris konsep konsepsi konsepsional konseptor konseptual konseptualisasi konser konsertina konserto konservasi konservasionis konservatif konservatisme konservator konservatori konservatorium konsesi konsesif konsesional konsiderans konsiderasi konsili konsiliasi konsinyasi konsinyatir konsisten konsistensi konsistori konsol konsolasi konsolidasi konsonan konsonansi konsonantal konsorsium konspirasi konspiratif konspirator konstabel konstan konstanta konstantagravitasi konstatasi konstatatif konstatir konstelasi konstipasi konstituante konstituen konstitusi konstitusional konstitusionalisme konstriksi konstriktor konstruksi konstruktif konstruktivisme konsul konsulat konsulen konsuler konsultan konsultasi konsumen konsumer konsumerisme konsumsi konsumtif kontak kontal-kantil kontaminasi kontan kontang-kanting konte konteks kontekstual kontekstualisme kontemplasi kontemplatif kontemporer konten konter kontes kontestan kontet kontiguitas kontinen kontinental kontingen kontinu kontinuitas kontinum kontoid kontol kontra kontrabande kontrabas kontradiksi kontradiktif kontraindikasi kontrak kontraksi kontraktor kontraktual kontraproduktif kontras kontrasepsi kontraseptif kontravensi kontribusi kontributor kontrol kontrolir kontroversi kontroversial kontur konus konveks konveksi konvektif konvensi konvensional konvergen konvergensi konversasi konversi konveyor konvoi konvolusi konvulsan konvulsi konyak konyal konyan konyol kooperasi kooperatif kooperativisme kooperator kooptasi koordinasi koordinat koordinatif koordinator kop kopah kopaiba kopak kopal kopat-kapit kopbal kopek kopel kopelrim koper koperasi kopet kopi kopiah kopilot kopling koplo kopok kopolimer kopong kopra koprafagia koprak kopral koprok koprol koprolit kopula kopulasi kopulatif kopyok kopyor kor koral koralit koran korano korban korden kordial kordit kordon korduroi kored koreferensialitas korek koreke koreksi korektif korektor korelasi korelatif korenah koreng koreograf koreografer koreografi koreografis kores koresponden korespondensi koret koridor korion kornea kornel korner kornet koroh koroid korok korologi korona koronal koroner korong korosi korosif korporasi korporat korporatif korporatisme korps korpulensi korpus korsase korsel korselet korset korsleting korteks kortikulus korting kortison korugator korundum korup korupsi koruptif koruptor korve korvet kosak-kasik kosakata kosar kosbas kosek kosekan kosel kosen koset kosinus kosmetik kosmetilogi kosmetolog kosmetologi kosmetologis kosmis kosmogoni kosmografi kosmologi kosmologis kosmonaut kosmopolit kosmopolitan kosmopolitanisme kosmos kosmotron kosokbali kosong kostum kota kotah kotai kotak kotak-katik kotaklema kotek koteka koteks koteng koterek kotes kotiledon kotipa kotok kotong kotor kotrek kovalensi kover kowan kowek koyak koyam koyan koyok krai krakal kram krama kranapaksa krangeyan kraniologi kraniometri kraniotomi kranium krans krasis krayon kreasi kreatif kreativitas kreator krebo krecek kredibilitas kredit kreditabel kreditor kredo krem kremasi krematori krematorium kreol kreolin kreolisasi kreosol kresendo kresol kretin kribo kricak krida krifoli krim kriminal kriminalis kriminalisasi kriminalitas kriminolog kriminologi kriminologis kring krio kriofil kriofit kriogen kriogenika krioterapi kripta kriptogam kriptografi kriptogram kriptol kripton krisan krisantemum krisis krisma krisoberil krisofil krisolit krisopras krista kristal kristalisasi kristalografi kristaloid kristalosa kristen kristiani kristus kriteria kriterium kritik kritikus kritis kritisi kriya krobongan kroco kroket krol krom kromat kromatid kromatika kromatin kromatis kromatofor kromatografi kromit kromium kromo kromofil kromofob kromogen kromong kromosfer kromosom kromotropi krompyang kronem kroni kronik kroniometri kronis kronisme kronobiologi kronogram kronologi kronologis kronometer kronosekuen kronoskop krosboi kroto kru kruistik kruk krukat krusial krustasea ksatria ksi kuaci kuadran kuadrat kuadratika kuadratur kuadratus kuadrenium kuadriliun kuadripartit kuadrisep kuadrupel kuadrupleks kuadruplet kuah kuai kuak kuala kualat kuali kualifikasi kualitas kualitatif kualon kuang kuangkiut kuangwung kuantifikasi kuantitas kuantitatif kuantum kuap kuar kuari kuarik kuark kuarsa kuarsit kuart kuartal kuarter kuarterner kuartet kuartil kuarto kuas kuasa kuasar kuasi kuat kuatren kuau kuaya kuayah kuayan kubah kubak kubang kubik kubil kubin kubis kubisme kubistik kubit kuboid kubra kubti kubu kubul kubung kubur kubus kucai kucak kucam kucandan kucar-kacir kucek kucel kucica kucil kucindan kucing kucir kucup kucur kuda kudai kudang kudap kudeta kudi kudian kudis kudu kuduk kudung kudup kudus kue kueni kuesioner kuetiau kufu kufur kui kuih kuil kuilu kuin kuing kuini kuinina kuintal kuintesens kuintet kuintil kuintiliun kuintuplet kuir kuis kuit kuitansi kujang kujarat kujung kujur kujut kuk kukabura kukai kukang kukila kuku kukuh kukuk kukul kukup kukur kukuruyuk kukus kulah kulai kulak kulakasar kulan kulansing kulasentana kulat kulawangsa kuli kuliah kulik-kulik kulikat kulim kulimat kuliner kulintang kulir kulit kulkas kulminasi kulon kulot kult kultivar kultivasi kultur kultural kulturisasi kultus kulub kuluk kulum kulup kulur kulut kulzum kuma-kuma kumai kumal kuman kumandang kumanga kumat kumba kumbah kumbang kumbar kumbik kumbu kumbuh kumena kumico kuminter kumis kumkuma kumpai kumpal kumpar kumpi kumpul kumuh kumulasi kumulatif kumulonimbus kumulus kumur kumus kumut kunang-kunang kunani kunar-kunar kunarpa kunca kuncah kuncen kunci kuncir kuncit kuncung kuncup kundai kundang kundi kundur kunduran kunfayakun kung kungfu kungkang kungki kungkum kungkung kuning kuningan kunjung kuno kunta kuntau kuntilanak kuntit kuntuan kuntul kuntum kuntung kunut kunyah kunyam kunyit kunyuk kuorum kuosien kuota kup kupa kupahan kupak kupang kupas kupat kupat-kapit kupe kupel kupi kupil kuping kupir kuplet kupluk kupnat kupon kuproprotein kuprum kupu-kupu kupui kupur kur kura kurai kurambit kurang kurap kuras kurasani kurasao kuratif kurator kuratorium kurau kurawal kurban kurcaci kuren kuret kuretase kuria kuricak kurigram kurik kurikuler kurikulum kuring kuriositas kuripan kurir kuririk kurium kurkatovium kurkuma kurltase kurma kurs kursemangat kursi kursif kursor kursus kurtase kurun kurung kurus kuruyuk kurva kurvalinier kurvatur kus kusa kusal kusam kusanin kusat-mesat kusau kusen kusik kusir kuskus kuspis kusruk kusta kusu kusuf kusuk kusuma kusut kuta kutaha kutak kutang kutat kutat-kutet kuteks kuteri kuti kutik kutikula kutil kutin kuting kutip kutu kutub kutubaru kutubusitah kutuk kutung kutut kuud kuwu kuwuk kuwung-kuwung kuwur kuya kuyang kuyu kuyuh kuyup kwartet kwartir kwasiorkor kweni kwetiau kwosien laal lab laba labah-labah labak labang labas label labelum laberang labi-labi labial labialisasi labil labiodental labiovelar labirin labium laboran laboratoris laboratorium labrak labrakan labrang labres labrum labu labuda labuh labun labur labut lacak laci lacur lacut lada ladah ladam ladan ladang laden ladi lading ladu ladung lafal laga lagak lagam lagan lagang lagau lagi lagiah lago lagonder lagu laguh-lagah laguna lagwu lah lahab lahad lahak lahan lahang lahap lahar lahir lahiriah lai laici laif laik lailah lailatulkadar lain lais laja lajak lajang lajat lajnah laju lajur lak lakab lakak lakar lakara laken lakeri laki laklak laklakan lakmus laknat laknatullah lakon lakonik lakonisme lakrimator laksa laksamana laksana laksatif laksmi laktase laktasi laktat laktogen laktoglobulin laktometer lakton laktosa laku lakum lakuna lakur lakustrin lala lalah lalai lalak lalandak lalang lalap lalat lalau laler lali lalim lalu lalu-lalang lam lama lamalif laman lamang lamar lamat-lamat lambai lambak lamban lambang lambar lambat lambda lambe lambert lambit lambo lambu lambuk lambung lambur lamdukpai lamela lamender lamin lamina laminah laminasi laminating lampai lampam lampan lampang lampar lampas lampau lampeni lampes lampias lampik lampin lamping lampion lampir lampit lampok lampor lampu lampung lampus lamtoro lamtoronisasi lamun lamur lamusir lana lanang lanar lanau lanbau lanca lancang lancap lancar lancia lancing lancip lancit lancong lancung lancur lancut landa landahur landai landak landang landap landas landau landors landrad landuk landung landur lang langah langak-languk langau langen langendrian langenswara langgah langgai langgam langgan langgang langgar langgas langgayan langgeng langguk langgung langi langir langis langit langka langkah langkai langkan langkang langkap langkara langkas langkat langkau langking langkisan langkitang langkong langkup langlai langlang langsai langsam langsang langsar langsat langse langseng langsep langsi langsing langsir langsuir langsung langu langut lanhir lanja lanjai lanjak lanjam lanjang lanjar lanjau lanji lanjuk lanjung lanjur lanjut lanolin lanset lansia lansir lanskap lantah lantai lantak lantam lantan lantang lantanum lantar lantas lantera lantesari lantik lantin lanting lantip lantun lantung lantur lanugo lanun lanus lanyah lanyak lanyau laocu laos laoteng lap lapah lapak lapang lapar laparoskop laparoskopi lapat-lapat lapektomi lapel lapih lapik lapili lapir lapis lapislazuli lapo lapor laptop lapuk lapun lapur lara larah larai larak laram larang larap laras larat larau largisimo largo lari larih larik laring laringal laringitis laringoskop laris larnaks laron lars laru larung larut larva larvarium las lasa lasah lasak lasana lasat laser lasi lasinia laskar laso lasparaginase lasuh lat lata latah latak latam latar latas lateks laten latensi lateral laterit latif latifundium latih lating latis latma latosol latuh latuk latung latur lauh lauk laun laung laur laut lauya lava lavase lavendel lavender lawa lawah lawak lawalata lawamah lawan lawang lawar lawas lawat lawazim lawe lawean lawi lawina lawon lawrensium layah layak layam layan layang layap layar layas layat layer layon layu layuh layuk layung layur layut laza lazim lazuardi leak lebah lebai lebak lebam leban lebang lebap lebar lebaran lebas lebat leber lebih lebuh lebuk lebum lebun lebung lebur lecah lecak lecap lecat leceh lecek lecer lecet leci lecit leco lecok lecuh lecun lecup lecur lecut ledak ledang ledek ledeng ledes leding ledos ledre leduk ledung lefa lega legak-legok legal legalisasi legalitas legam legap legar legasi legasteni legat legataris legato legator lege legek legen legenda legendaris leger leges leghorn legi legih legio legislasi legislatif legislator legisme legit legitimaris legitimas legitimasi legitimitas legiun lego legok legong legu legum legunder legundi legung legup-legup leha-leha lehar leher lei leja lejang lejar lejas lejit lejok leka lekah lekak-lekuk lekam lekang lekap lekap-lekup lekar lekas lekat lekemia lekir lekit lekok lekosit leksem leksikal leksikograf leksikografi leksikografis leksikolog leksikologi leksikon leksikostatistik leksis lekton lektor lektur leku lekuh-lekih lekuk lekum lekun lekung lekup-lekap lela lelabah lelah lelai lelaki lelancur lelang lelangon lelangse lelap lelar lelas lelat lelatu lelawa lele leleh lelembut lelemuku lelep leler leles lelet lelewa leli lelonobroto leluasa lelucon leluhur leluing lelung lelungit leluri lem lema lemah lemak lemang lemari lemas lemata lemau lembaga lembah lembai lembak lembam lemban lembang lembap lembar lembayung lembega lembek lembeng lembidang lembing lembok lembora lembu lembung lembur lemburu lembut lemena lemender lemes lemidi lemo lemon lempah lempai lempang lempap lempar lempari lempaung lempem lempenai lempeng lemper lemping lempit lempoh lempuh lempuk lempung lempuyang lempuyangan lemur lemuru lemusir lena lenan lencana lencang lenceng lencet lenci lencir lencit lencong lencun lenda lendaian lendeh lender lendir lendot lendung lendut leng lenga lengah lengai lengak lengan lengang lengar lengas lengat lenge lenggak lenggana lengganan lenggang lenggara lenggek lengger lenggok lenggong lenggor lenggundi lenggut lengit lengkai lengkanas lengkang lengkap lengkara lengkeng lengkesa lengket lengkiang lengking lengkitang lengkok lengkong lengkuas lengkung lengkur lengoh lengong lengos lengseng lengser lengset lenguh lengung lening lenis lenitrik lenja lenjan lenjaran lenjing lenjuang lenong lenor lens lensa lenser lenset lenso lentam-lentum lentang lentang-lentok lenteng lentera lentik lenting lentisel lentoid lentok lentong lentuk lentum lentung lentur lentus lenung lenyah lenyai lenyak lenyap lenyau lenyeh lenyet lenyut leo leonid leontin leot lepa lepai lepak lepang lepap lepas lepat lepau lepe lepek leper leperi lepes lepet lepih lepik lepit leplap lepoh lepok lepot lepra leproma lepromin leproseri leptodos leptoskop leptosom lepu lepuh lepuk lepur lerah lerai lerak lerang lerap lereng leret lerok lerot lerum les lesa lesak lesan lesang lesap lesat lesbi lesbian lesbianisme leseh lesek leset lesi lesing lesir lesit lesitin lesitina lesnar lesot lesplang lestari lestek lesterung lesu lesung lesus lesut leta letai letak letal letalitas letang letargi lete-lete letek leter leterseter letih letik leting letis letnan letoi letos letraset letuk letum letung letup letur letus leuca leukemia leukoderma leukofit leukoma leukonisia leukopenia leukoplakia leukore leukorea leukosit leukositometer leukositosis level lever leveransir levertran levirat levitin levulosa lewa lewah lewar lewat lewisid lewu leyeh leyot lezat liabilitas lian liana liang liangliong liar lias liat liau libas libat libei liberal liberalis liberalisasi liberalisme liberalistis liberasi liberator libero libidis libido libitum libra librasi libreto libur licak licau lici licik licin licurai lid lidah lidas lidi lidid lifo lift lifter liga ligamen ligan ligar ligas ligasi ligat ligatur ligih lignin lignit lignoselulosa lignosulfonat lignotuber lihai lihat lik likantropi likas likat likir liku likuid likuida likuidasi likuiditas likur likut lil lila lilah lilan lilau lili lilin liliput lilit lillahi lima liman limar limas limau limbah limbai limbak limban limbang limbat limbing limbubu limbuk limbung limbur limfa limfadema limfadenitis limfadenoma limfadenosis limfaderitis limfangioma limfatik limfoblartoma limfoblas limfoblastoma limfografi limfoma limfonodus limfopenia limfosit limfositopenia limfositosis limit limitasi limitatif limnetik limnologi limnoplankton limpa limpah limpap limpapas limpas limpau limpit limpoh limpung limun limusin limut lin linang linau lincah lincak lincam lincir lincun lindak lindang lindap lindas lindik lindis lindu lindung lindur linear linen ling lingar lingat lingga linggam linggata linggayuran linggi linggis lingkap lingkar lingkawa lingkis lingkung lingkup linglung lingsa lingsang lingsir lingsu lingua linguafon linguis linguistik lini linier linimen lining linjak linoleum linsang lintabung lintadu lintah lintang lintap lintar lintas lintibang linting lintir lintuh lintup linu linuhung linyak linyar lio liofilisasi liong liontin lipai lipan lipas lipase lipat lipektomi lipemia lipid lipiodol lipit liplap lipoksidase lipolisis lipoprotein lipstik lipu lipur liput lir lira lirida lirih lirik liris lis lisah lisan lisani lisensi lisimeter lisis lisol lisong lisplang lister listeria listrik lisu lisus lisut litah litak litani liter literator literer litium litografi litologi litoral litosfer litotes litotomi litsus liturgi liturgis liuk liung-liung liur liut liver liwa liwan liwat liwet loak lob loba lobak loban loberci lobi lobster locok locot lodan lodeh lodoh lodong log logam logaritma logat logawiah logika logis logistik logo logogram logopedia logotip loh loha lohok lohor loji lok loka lokacipta lokakarya lokal lokalis lokalisasi lokan lokananta lokap lokasi lokastiti lokatif lokatikranta lokatraya lokawarta lokawidura lokawigna lokawiruda lokawisata lokcuan lokek lokeswara loket loki lokia lokika lokio loklok lokomobil lokomotif lokos loksek loksun loktong lokus lokusi lolak loleng loloh lolohan lolong lolos lomba lombar lombok lombong lomek lomot lompat lompayang lompok lompong lonan loncat loncek lonceng loncer lonco loncos londang londong loneng long longak-longok longdres longgar longgok longgor longitudinal longo longok longong longser longsor longtorso lonjak lonjong lonjor lonsong lontai lontang-lanting lontang-lantung lontar lontara lonte lontok lontong lonyok lop lopak lopek loper lopis lopor lor lorah loran lorat lorber lorek loreng lori lornyet lorong lorot los lose losin losion losmen loso losong lot lota lotak lotek loteng lotis lotong lotre lotus lowong loya loyak loyal loyalis loyalitas loyang loyar loyo loyong lozenge luah luak luang luap luar luas luat luban lubang luber lubuk lucah lucu lucup lucut ludah ludat ludes luding ludruk lues lugas lugu lugut luhak luhmahful luhung luhur luih luik luing luk luka lukah lukat lukeh lukis luks luku lukut lulai luli luluh luluk lulum lulup lulur lulus lulut lum lumai lumang lumar lumas lumat lumayan lumba-lumba lumbal lumbu lumbung lumen lumer lumi-lumi luminositas lumpang lumpektomi lumpia lumping lumpuh lumpuk lumpur lumrah lumsum lumuh lumur lumus lumut lunak lunas lunau luncai luncas luncung luncur lundang lundi lundu luner lung lungguh lungkah lungkang lungkum lunglai lunglung lungsar lungse lungsin lungsung lungsur lungun lunjur lunta luntang luntang-lantung luntas luntur lunyah lunyai lup lupa lupat lupi lupuh lupuk lupung lupus luput lurah lurik luru lurub luruh lurus lurut lus lusa lusin lustrum lusuh lut lutetium luti lutu lutung lutut luweng luwes luyak luyu luyut maab maaf mabriuk mabrur mabuh mabuk mabul macakal macam macan macapat mace macet macis mad mada madah madali madam madang madani madar madat madewi madi madia madik madinding madmadah mado madona madras madrasah madu madukara madumangsa madya maesan maesenas maestro mafela mafhum mafia mafioso mafsadah mafsadat mag magainin magalah magandi magang magasin magel magenta magersari magfirah magfirat magi magis magister magistrat maglub magma magnesium magnesol magnet magnetik magnetika magnetis magnetisme magnetit magnetometer magnetor magnetostatika magnitudo magrib magribi magrur magun mah maha mahabah mahabintang mahadewa mahadewi mahaduta mahaguru mahah mahajana mahakala mahakarya mahakuasa mahal mahamen mahamenteri mahamulia mahang mahaparana mahapatih mahar maharaja maharajalela maharana maharani mahardika maharesi maharupa mahasiswa mahasiswi mahasuci mahatahu mahatma mahatur mahayana mahbub mahbubah mahbubat mahdi maherat mahesa maheswara mahfuz mahia mahimahi mahir mahkamah mahkota mahligai mahmud mahoni mahraj mahram mahsul mahsyar mahwu mahyong mahzurat mai maido maimun main mair mairat maizena maja majaan majakane majakaya majakeling majal majalah majas majasi majati majedub majekeling majelis majemuk majenun majikan majir majizat majong maju majuh majuj majun majung majusi maka makadam makadasang makadok makalah makalangkang makam makan makantah makantuh makanya makao makaopo makar makara makaroni makas makbud makbul makcik makcomblang makda makdan makelar makerel maket makhdum makhluk makhraj maki makin making makiyah makjuj maklaf maklum maklumat maklun makmal makmum makmur makna maknawi makramat makrame makrifat makrifatullah makro makroekonomi makrofita makrofotografi makroftalmus makrogametosit makrohistori makrokosmos makrokriminologi makrolinguistik makromelia makrometeorologi makromolekul makrosefalik makroskopis makrosmatik makrosmatis makrososiologi makruf makruh maksi maksiat maksila maksim maksimal maksimum maksud maksum maktab maktub makua makul makula makulat makurung makuta makyong makzul mal mala malabau malabsorpsi malafide malafungsi malagandang malagizi malah malai malaik malaikat malaikatulmaut malaise malak malaka malakama malakat malakit malakofili malakologi malakut malam malan malang malangbang malap malapari malapetaka malapraktek malapraktik malar malaria malas malasia malasuai malatindak malau maldistribusi male maleman maleo maleolus mali-mali maligai malih malik maliki malikuljabar malikulmuluk malim maling malis malisol malka malnutrisi maloklusi malt maltase maltosa malu malun malung mam mama mamah mamai mamak mamalia maman mamanah mamanda mamang mamano mamat mambang mambek mambo mambruk mambu mambung mamduhah mami mamik mamlakat mamografi mampai mampat mampir mampu mampung mampus mamut man mana manah manai manajemen manajer manajerial manakala manakan manakib manalagi manasik manasongo manasuka manau mancanegara mancawarna manci mancis mancung mancur manda mandah mandai mandala mandalika mandam mandar mandarin mandat mandataris mandau mandeh mandek mandelevium mandi mandibula mandil mandir mandiri mandolin mandor mandraguna mandril mandrin mandul mandung maneken manerisme manfaat mang mangan mangap mangas mangau mangayubagya mangga manggah manggala manggar manggis manggung mangir mangkak mangkanya mangkar mangkas mangkat mangkel mangkih mangkir mangkok mangkubumi mangkuk mangkus mangsa mangsi mangu mangun mangut mani mania maniak manifes manifestasi manifesto manik manik-depresif manikam manikdepresi manikmaya manikur manila manimba manipol manipulasi manipulatif manipulator manira manis manise manja manjapada manjau manjeri manjing manjung manjur manol manometer manora manostat manset mansiang mansukh manta mantan mantap mantari mantel manten manti mantik mantiki mantisa mantissa mantra mantram mantri mantu mantuk manual manufaktur manufakturing manuk manumisio manumpak manunggal manusia manusiawi manuskrip manut manuver manuwa manyala manyar manzil manzilah maois map mapak mapalus mapan mar mara marah maraja marak marakas marambung maramus maranta marapulai maras maraton marbling marbut marcapada mardatilah mardatillah mardud mare marem maret marfuk marga margalit margarin margasatwa margin marginal marginalisasi marginalisme margrit marhaban marhaen marhaenis marhaenisme marhum marhumah mari maria marikan marikh marikultur marimu marina marinade marine marinir marinyo marinyu marital maritim mariyuana marjik marjinal mark marka markado markah markas markasit marketri markis markisa markoni markonis markusip marlin marmelade marmer marmot maro marpaud mars marsaoleh marsekal marsepen marsose martabak martaban martabat martandang martil martini martir maru marus marut marwas marxisme marzipan mas masa masai masak masakan masakat masala masalah masam masap masase masayu masbuk masdar masehi masektomi maser maserasi mashaf masif masih masin masinal masing-masing masinis masir masjid masjidilaksa masjidilharam maskanat maskapai maskara maskat maskawin masker maskodok maskon maskot maskulin maskulinitas maskumambang maslahat masnawi masohi masoi masokhis masokhisme masokisme mason masori masrum massa massal mastautin mastektomi master masterplan mastik mastitis mastodon mastuli masturbasi masuk masuliah masya masyakah masyarakat masygul masyhadat masyhur masyrik masyuk mat mata matador matahari matakao matalamat matan matang mate matematika matematikus matematis materi material materialistis materiil mati matine matlak matoa maton matra matras matres matriarkal matriarkat matriks matrikulasi matrilineal matrilokal matris matronim matros matu matur maturasi maturitas mau mauizah maujud maujudat maukhid maukif maukuf maula maulai maulana maulhayat maulid maulidurasul maulud maung maupun mausoleum maut mauz mawadah mawar mawas maweda mawin mawon mawut maya mayam mayang mayangda mayapada mayas mayat mayeng mayokratio mayones mayor mayoret mayoritas mayung mayur mazarin mazbah mazhab mazi mazkur mazmumah mazmur mbah mbak mbakyu mbeling mbok mbokmas meander mebel mecis medali medalion medan medang mede media medial median mediasi mediastinum mediator medik medikamentosa medikasi mediko medikolegal medikus medil medio medis medisinal medit meditasi mediterania medium medok medu medula medusa mega megabyte megafon megah megak megakredit megal-megol megalit megalitikum megalomania megalomaniak megalopolis megalosit megamerger megan megaohm megap-megap megapolis megaproyek megar megaspora megasporangium megasporofil megat megaton megatren megatruh megawatt meger megrek-megrek mei meiosis meja mejam mejan mejana mejeng mek mekanik mekanika mekanikgraha mekanis mekanisasi mekanisme mekanolinguistik mekap mekar meko mekonium mel melabuai melambang melamin melanesia melangkup melanin melanisme melankolia melankolis melankonis melanoderma melar melarat melas melase melasma melati melawah melayu melek melela melempem meleng meler melesek meleset melik melilin melinjo melintir melit melitofili meliwis melodi melodika melodius melodrama melodramatik melodramatis melompong melon melor melotot melpari melukut melulu melur mem memang memar membal memble membran memedi memek memelas memo memoar memorabilia memorandum memorat memori memorial mempan mempelai mempelam mempelas mempelasari mempening memper mempitis memplak mempurung memutah mena menaga menak menampun menang menantu menara menat mencak mencelat menceng menceret mencit mencla-mencle menclok mencok mencong mencos mencret mendak mendap mendapa mendeleka mendelevium mendeng mendiang mendikai mending mendira mendoan mendonan mendong mendreng mendu mendung mendur mendura mendut menep menepaat mengah mengangah mengap mengapa mengapmendam mengeh mengerawan mengerna menget mengga menggala menggusta mengi mengiang mengicip mengirat mengkal mengkali mengkara mengkaras mengkelan mengkerang mengkeret mengking mengkirai mengkirik mengkis mengkona mengkuang mengkudu mengok mengor mengot mengsol mengsong mengung menhir meni meningitis menir meniran meniskus menit menjangan menong menopause menor menoragia menostaksis mens menserendahi mensiang menstruasi mensurasi mentah mentak mental mentalitas mentang mentari mentaruh mentas mentaus mentega menteleng menteng mentereng menteri mentibu mentifakta mentigi mentilau mentimun mentis mentok mentol mentolo mentor mentora mentua mentul menu menuet menung menur meong mepet meracang meraga meragi merah merajak merak merakan meralgia merambai merambung merana merang meranggi merangsi merangu meranti merapu merat merawal merawan merbah merbau merbaya merbuk merbulan mercak-mercik mercon mercu mercusuar merdangga merdeka merdesa merdinah merdu merduk mere merek mereka merem merembung mereng meres mergat merger mergul meri meriam meriang merica meridian merih merik merikan merikarp merinding mering meristem merjan merkah merkantilisme merkubang merkuri merkurium merkurius merkuro merkurokrom merlilin merlimau merogoni merosot merot merpati merpaud merpitis merpoyan merserisasi mersik merta mertamu mertapal mertayam mertega mertelu mertua meru meruap merubi merunggai merut merwatin mes mesa mesan mesara mesem mesin mesiu mesjid meskalin meskalina meski mesmerisme mesoderm mesodermik mesofili mesofit mesolitik mesolitikum mesometeorologi mesomorf meson mesopause mesosfer mesotel mesotoraks mesozoa mesozoikum mesra mester mesti mestika mestizo mesui mesum mesut meta metabahasa metabolik metabolis metabolisme metabolit metafil metafisik metafisika metafora metaforis metah metai metal metalik metalinguistik metalografi metaloid metalurgi metalurgis metamorf metamorfis metamorfisme metamorfosis metana metanefros metanol metari metasenter metastasis metatarsus metatesis metazoa mete meteor meteorit meteorograf meteorogram meteoroid meteorologi meteorologis meter meterai metil metode metodik metodis metodologi metonimi metonimia metrik metris metro metrologi metromini metronimik metronom metronomis metropolis metropolisasi metropolitan metroragia metrum meunasah mewah mewari mewek mezanin mezbah mezosopran mi miak miana miang miap miasma midar midi midik midodareni mieloma migrain migran migrasi migren mihrab mihun miiofili mijil miju mik mika mikat mikologi mikoprotein mikosis mikotoksin mikraj mikro mikroanalisis mikroangiopati mikrob mikrobe mikrobiologi mikrobiologis mikrobisida mikrobus mikroekonomi mikroelektronika mikroelemen mikrofag mikrofarad mikrofilm mikrofita mikrofon mikrofotografi mikrogelombang mikrograf mikrografika mikrogram mikrohabitat mikrohistori mikrohm mikroklimat mikrokomputer mikrokosmos mikrolet mikrolinguistik mikrolit mikrom mikromanipulasi mikrometer mikrometri mikron mikroorganisme mikroprosesor mikrosefalia mikrosekon mikroskop mikroskopis mikrospora mikrotom mikrovilus mikrowatt mikser miksoedema mil milad milenium miliampere miliar miliarder miliaria milibar milieu miligram milik mililiter milimeter milimikron milimol milioner milir milisi militan militansi militer militerisme militeristis miliun miliuner milivolt milu mim mimbar mimeograf mimesis mimi mimik mimikri mimis mimisan mimosa mimpi min mina minangsraya minat minder mindi mindoan mindring mineral mineralisasi mineralogi mineralogis minggat minggir minggu minhaj mini miniatur minibasket minibus minikar minikata minikomputer minim minimal minimarket minimum minium minor minoritas minta mintak mintakat mintakulburuj mintal minterat mintuna minum minus minyak mioglobin miokardia mioma miop miopia miosis miotik mipis mirah mirai mirakel mirat miriapod mirih mirik miring mirip miris mirmekofag mirmekofili mirmekologi misa misai misal misan misantrop misbah misdinar misi misil misiologi misionaris misioner miskal miskin miskram misoa misofobia misogami misoginis mispersepsi miss mistar mister misteri misterius mistik mistis mistisisme mistri misuh miswat mitasi mite mitisida mitogen mitologi mitologis mitos mitosis mitra mitraliur mizab mizan mnemonik moa mob mobil mobilet mobilisasi mobilisator mobilitas moblong mobokrasi mochi modal modalitas modar mode model modeling modem moderamen moderat moderato moderator modern modernisasi modernisme modernitas modernomaniak modifikasi modifikatif modin modis modiste modol modul modular modulasi modulator modus mofet moga mogok mohair mohon mohor mojah mojang mok moka mokal moke moko moksa mol mola molar mole molek molekul molekuler moler moles molibden molibdenum molor molos molotov moluska momen momental momentum momok momong momot monarki moncong moncor mondar-mandir mondeling mondial mondok mondolan mondong monel moneter mong monggo monggol mongkok mongmong mongolisme mongoloid monisme monitor mono monoatom monodi monodrama monofag monofobia monofonir monogam monogami monogini monografi monogram monokarpa monokel monokini monoklin monoklinal monokotil monokotiledon monokrasi monokrom monokromatis monokromator monoksida monokultur monolingual monolit monolitik monolog monoloyalitas monomania monomer monoploid monopoli monopolistik monopsoni monorel monosakarida monosem monosemantik monosemi monosilabel monosilabisme monosit monospermi monoteis monoteisme monotipe monoton monsinyur monster monstera monsun montase montering montir montit montok monumen monumental monyet monyong monyos mop mopela mopit morak moral moralis moralisasi moralisme moralistis moralitas morat-marit moratorium morbiditas morbili mordan moreng mores morf morfem morfemik morfemis morfin morfinis morfofonem morfofonemik morfofonemis morfofonologi morfogenesis morfologi mori moril mormon moron morong morse mortalitas mortar mortir mosaik mosi mosok moster mota motel motif motivasi motivator moto motor motorik motoris motorisasi motorsaikel moyang mozah mozaik mu mua muadin muai muak muakadah mual mualaf mualamat mualif mualim muamalah muamalat muanas muara muarikh muasasah muasir muat muazam muazin mubah mubalig mubaligah mubarak mubarat mubazir mubtadi mubut mucikari mud muda mudah mudakar mudarabah mudarat mudasir mudat mudigah mudik mudra mudun mufaham mufakat mufarik mufasal mufasir muflis mufrad mufsidin mufti mugabat muhabah muhadarah muhadat muhajat muhajir muhajirin muhal muhalil muhami muhammad muharam muhasabah muhib muhibah muhit muhlikah muhrim muhsin muhtasyam muih mujadalah mujadid mujahadat mujahid mujahidin mujair mujang mujarab mujarad mujari mujbir mujtahid mujtamak mujur muk muka mukabalah mukadam mukadas mukadim mukadimah mukadin mukadis mukah mukalaf mukalid mukaram mukatabah mukena mukhabarah mukhalaf mukhalafah mukhalif mukhalis mukhlis mukhtasar mukibat mukim mukimin mukjizat mukmin mukminat mukminin mukoprotein mukosa mukositis muktabar muktamad muktamar muktamirin muktazilah mukun mula mulai mulakat mulamasah mulas mulat mulato mulazamah mulhid mulia mullah mulsa multazam multibahasa multidimensi multidisipliner multietnik multifaset multifungsi multigravida multiguna multikompleks multikrisis multikultur multikulturalisme multilateral multilingual multilingualisme multimedia multimeter multimilioner multinasional multinegara multiorgan multipara multipel multipleks multiplikasi multiplikator multipolar multiprosesor multirasial multirasialisme multiseluler multivalen multivalensi multivitamin muluk mulur mulus mulut mumayiz mumbang mumbul mumbung mumet mumi mumifikasi mumpung mumpuni mumuk mumur mumut munafik munafikin munajat munajim munasabah muncang munci muncikari muncrat muncul muncus mundam munding mundu mundur mung munggu munggur mungil mungkar mungkin mungkir mungkum mungkur mungmung mungsi mungut munib munjung muno munsyi muntaber muntah muntaha muntu muntul muntup munyuk muon mupaham muparik mupus mur mura murad muradif murah murai murakab murakabi mural muram murang muras murba murbei murca muri murid muring muris murka murni mursal mursyid murtad muruah murung murup murus mus musaadah musabab musabaqah musafir musafirin musakat musala musang musara museolog museologi museum mushaf musibah musik musikal musikalisasi musikalitas musikolog musikologi musikologis musikus musim musisi muskil muskovit muslih muslihat muslim muslimat muslimin muslin musnah muspra mustahak mustahik mustahil mustaid mustajab mustak mustaka mustakim mustamik mustang musuh musyarakah musyarakat musyarik musyawarah musyawarat musyrik musyrikin musytak musytari mutabar mutagen mutah mutakadim mutakalim mutakhir mutaki mutalaah mutamad mutan mutasawif mutasi mutawif mute mutiara mutih mutilasi mutisme mutlak mutmainah mutu mutualisme mutung muwafakat muwahid muwajahah muwakal muwakil muwari muzah muzakar muzakarah muzaki muzamil muzawir muzhab naam nabatah nabati nabi nabtun nabu nada nadi nadim nadir naf nafar nafas nafi nafiri nafkah nafsi nafsu nafta naftal naftalena naftena naftol naga nagam nagara nagari nagasari nahak nahas nahdiyin nahi nahkoda nahu naib naif naik naim najam najasah najasat najis nak naka nakal nakara nakhoda nal nala nalam nalar nali nalih naluri naluriah nama namaskara namatad namatium nambi namnam nampan namun nan nanah nanang nanap nanaplankton nanar nanas nandu nandung nang nangka nangkoda nangkring nangui naning nanofarad nanofosil nanogram nanometer nanti napal napalm napas napuh naqal naqli nara narablog narapati narapidana narapraja narasi narasumber naratif narator narkolepsi narkomaniak narkose narkosis narkotik narpati narsis narsisisme narsisme narwastu nas nasab nasabah nasakh nasal nasalisasi nasar nasehat nasel nasi nasib nasihat nasion nasional nasionalis nasionalisasi nasionalisme nasionalistis nasionisme naskah nasofaring nasrani nasti nasut natal natalis natalitas natang natar natijah nativis nativisme nativistik natolokal natrium natur natura natural naturalis naturalisasi naturalisme naturalistis naturopatis naung nauplius nausea nautik nautika nautikal nautilus nauzubillah navigasi navigator nawa nawaitu nawala nawalapradata nayaka nayam nayap nazam nazar nazi naziisme nazim nazir ndoro ndoroisme neala nealogi nebeng nebula nebulium neces necis nefoskop nefrektomi nefridium nefrit nefritis nefroblastoma nefrologi nefron nefrosis negara negasi negatif negativisme negativistik neger negeri negosi negosiasi negosiator negrito negro negroid negus neka nekad nekara nekat nekel neko nekrofag nekrofagus nekrofili nekrofilia nekrogeografi nekrolog nekrologi nekromansi nekropolis nekropsi nekrosis neksus nektar nelangsa nelayan nemagon nematoda nematologi nematosida nematosis nenda nendatan nenek nenekanda nenen nenenda nener nenes neng neodarwinisme neodimium neofeodalisme neofeodalistis neoiknologi neoimpresionisme neokarpi neoklasik neoklasisisme neoklasisme neokolonialisme neoliberalisme neolit neolitik neolitikum neologi neologisme neolokal neon neonatal neonatus neontologi neoplasma neoplatonisme neoprena neositosis neotipologi neovirus neozoikum nepotis nepotisme neptunium neptunus neraca nerak neraka neritik neritopelagik neritoplankton neroglia nervasi nervur nesa nestapa nestor net neting neto netra netral netralis netralisasi netralisme netralitas neural neuralgia neurastenia neuritis neuroblastoma neuroglia neurolinguistik neurolog neurologi neurologis neuron neurosis neurotik neurotransmiter neustonologi neutrino neutron newton ngabei ngaben ngablak ngabur ngakngikngok ngalau ngalor-ngidul nganga ngap-ngap ngapain ngarai ngeang ngebet ngebut ngeceng ngeden ngedumel ngelindur ngemil ngenas ngengat ngenyek ngeong ngeres ngeri ngiang ngilu ngoko ngos-ngosan ngot-ngotan ngowos ngoyo ngung nia niaga nian niasin niat nibung nica nidasi nidera nidikola nidulus nifak nifas nih nihil nihilis nihilisme nijas nik nikah nikel nikmat nikotin niktigami nila nilai nilakandi nilam nilau nilon nimbostratus nimbrung nimfomania ninabobo ning ningnong ningrat nini ninik ninitowok niobium nipah nipis nira niraksara nirgagasan nirgesekan nirguna nirkabel nirlaba nirleka nirmala nirselera nirwana nirwarta nisab nisan nisbah nisbi niscaya niskala nista nistagmus nistatin nitrat nitrifikasi nitrobenzena nitrofili nitrofit nitrogen nitrogliserin nitroselulosa niyaga noa nobat nobelium noda nodulus nodus noem noja noken noktah nokturia nokturnal nol nomad nomenklatur nomina nominal nominalisasi nominalisme nominasi nominatif nominator nomine nomogram nomokrasi nomor nomplok non nona nonagresi nonaktif nonblok nondepartemen nondepartemental none nonekonomi noneksakta nonfiksi nonformal nong-nong nongol nongrata nonhistoris noni nonilium nonindustri nonintervensi nonius nonkimia nonkombatan nonkonvensional nonkooperasi nonkooperatif nonmedis nonmigas nonmiliter nonok nonol nonong nonpatogenik nonpemerintah nonpolitik nonpredikatif nonpribumi nonproduktif nonprofit nonprotein nonsens nonsilabis nonstandar nonstop nonteknis nontradisional nonverbal nopek norak norit norma normal normalisasi normatif nosologi nostalgia nostrum not nota notabene notariat notaris notasi notes notifikasi notok notula notulis nova novel novela novelet novelis november novena novokaina nrima nuansa nubuat nudis nudisme nugat nugraha nujum nukil nukleat nukleolus nukleon nukleoprotein nukleus nuklida nuklir nulipara numeralia numerik numeris numismatika nun nunatak nung nunsius nunut nur nuraga nurani nurbisa nuri nuriah nus nusa nusaindah nusakambangan nusantara nusyu nusyus nutan nutasi nutfah nutriea nutrisi nutrisionis nutrisisme nuzul nuzulul nyai nyak nyala nyalang nyalar nyalawadi nyale nyali nyaman nyambing nyamik nyamleng nyampang nyamplung nyamuk nyamur nyana nyang nyanya nyanyah nyanyang nyanyar nyanyi nyanyu nyanyuk nyapang nyapnyap nyarang nyarik nyaring nyaris nyata nyatuh nyawa nyawang nyelekit nyemplong nyentrik nyenyai nyenyak nyenyat nyenyeh nyenyep nyenyet nyepi nyeri nyerocos nyi nyilih nyingnying nyinyir nyiri nyiru nyit nyiur nyolnyolan nyolo nyoman nyong nyonya nyonyeh nyonyong nyonyor nyungsung nyunyut nyureng nyut oase oasis obar obat obduksi obelisk obeng obesitas obi obituarium objek objektif objektivisme objektivitas oblak oblasi obligasi oblong obo obor obral obras obrol obrus observasi observatorium obsesi obsesif obsidian obsolet obstetri obstruen obstruksi obversi obviatif obyek obyektif obyektivisme obyektivitas oceh odalan ode odekolonye odinometer oditur odoh odol odometer odontoblas odontoid odontologi odoran oedipus-kompleks oersted ofensif oferte ofisial ofset oftalmia oftalmoskop oga ogah ogah-agih ogak-agik ogak-ogak ogam ogel ogok ogonium ohm ohmmeter oikumene oja ojeg ojek ojok okarina oke oker oklokrasi oklusi oklusif oknum okok oksalat oksiasetilena oksida oksidan oksidasi oksidator oksigen oksigenase oksimoron oksitetrasiklin oksiton oktaf oktagon oktahedron oktal oktana oktet oktober oktroi okulasi okuler okulis okultis okultisme okupasi okupasional olah olahraga olak olak-alik olanda olang-aling oleander olefin oleh olek oleng oleografi oleometer oleovitamin oles olet oleum oli olia oligarki oligofagus oligofrenia oligopoli oligopolistis oligopsoni oligosen oligositemia oligotrofik oliman olimpiade oliva olivin olok olong-olong om oma ombak ombang-ambing ombyok omega omel omikron omnibus omnivor omnivora omong ompol ompong ompreng omprong ompu omset omslah omzet onagata onak onani onar oncat once oncek oncen oncer oncom oncor onde-onde ondel-ondel onder onderdil onderdistrik onderneming onderok ondo ondoafi ondok ondos oneng-oneng ong ongahangih ongeh onggok ongji ongkang ongkok ongkos ongok ongol-ongol oniks onkogen onkologi onomasiologi onomastika onomatologi onomatope ons onslah ontogeni ontologi ontologis ontran-ontran onyah-anyih onyak-anyik onyang onyok onyot oogenesis oolit opa opak opak-apik opal opalesen opas opasitas opelet open opendim openkap oper oper "v," opera operasi operasional operasionalisasi operatif operator operet operkulum opini opisometer opium oplah oplet oplos opmak opname oponen opor oportunis oportunisme oportunistis oportunitas oposan oposisi oppo opsen opseter opsi opsin opsinder opsiner opsional opsir opstal optatif optik optika optimal optimalisasi optimis optimisme optimistis optimum optis optisien optoelektronika optometri optometris opus orak orak-arik orakel oral oralit orang orang-aring oranye orasi orat-oret orator oratoria oratoris oratorium orbit orbita orbital orde order ordi ordinal ordinasi ordinat ordiner ordner ordo ordonans ordonansi oren oreng oreol oret organ organdi organel organik organis organisasi organisator organisatoris organisir organisme organismus organogram organon orgasme orgasmik orgel orien oriental orientalis orientasi origami orion orisinal orisinalitas orkes orkestra orkestrasi ornamen ornamental ornamentasi ornitologi ornitologis ornitosis orografi orografik orografis orok orong-orong ortodidaktik ortodoks ortodoksi ortodrom ortoepi ortografi ortografis ortoklas ortopedagogik ortopedi ortopedis ose osean oseanarium oseanografi oseanologi osifikasi osikel osilasi osilator osilograf osilogram osiloskop oskilator oskulum osmium osmometer osmoregulasi osmose osmosis osomosis ostentasi osteoblas osteoklas osteologi osteopati osteoporosis ostium otak otak-atik otak-otak otar otek otentik oto otobus otofon otologi otomat otomatis otomatisasi otomobil otomotif otonom otonomi otopet otorisasi otoritas otoriter otoritet otoskop otot ototipi oval ovarium ovasi oven over overaktif overakting overal overdosis overkompensasi overpopulasi overproduksi oversimplifikasi overste oviduk ovipar oviparitas ovipositor ovitesis ovovivipar ovulasi ovulum ovum oyak oyek oyok oyong oyot ozokerit ozon ozonisasi ozonisator ozonometer pabean pabrik pabrikan pabrikasi pacai pacak pacal pacangan pacar pacat pacau pace pacek paceklik pacet pacih pacik pacok pacu pacuk pacul pada padah padahal padak padam padan padang padas padasan padat padepokan padi padma padmasana padmi padri padu padudan paduk paduka paduraksa paedofil paes pagan paganisme pagar pagas pagebluk pagelaran pagi pagina pagoda pagon pagositosis pagu pagun pagupon pagut paguyuban pah paha pahala paham pahang pahar pahat paheman pahit pahlawan pahter pai paidon pail pailit paing paip pair pais paitua paja pajak pajan pajang pajuan pajuh pakai pakal pakan pakanira pakansi pakar pakaryan pakat pakau pakcik pakde pakem paket pakihang pakihi paking pakis paklik pakma pakpui pakpung paksa paksi paksina pakta pakter paku pakuh pakuk pakuncen pakus pal pala paladium palagan palai palak palaka palam palamarta palang palapa palar palari palas palasik palat palatabilitas palatal palatalisasi palatografi palatogram palatum palau palawa palawija paldu pale palem palen paleoantropologi paleobotani paleoekologi paleogeografi paleografi paleografis paleoklimatologi paleolitik paleolitikum paleontologi paleosen paleozoikum pales palet pali paliatif paliatip palindrom paling palinologi palis palit palka pallawa palmarosa palmin palmistri palmit palmitat palolo palpasi palsu paltu palu paluh palun palung palut pamah paman pameget pamer pamflet pamit pamong pamor pampa pampan pampang pampas pampat pamper pampiniform pamrih pamungkas pan pana panah panai panakawan panar panas panasea panau panca pancabicara pancabuta pancacita pancadarma pancaindera pancaindra pancaka pancakara pancakembar pancal pancalima pancalogam pancalomba pancalongok pancamarga pancamuka pancang pancaniti pancapersada pancar pancaragam pancarajadiraja pancaroba pancarona pancasila pancasilais pancasona pancasuara pancasuda pancausaha pancawalikrama pancawara pancawarna pancawarsa panci pancing pancir pancit panco pancong pancung pancur pancut pandai pandak pandam pandan pandang pandau pandawa pandega pandemi pandemik pandialektal pandir pandit pandom pandu panekuk panel panelis panembahan panembrama panen panewu pangabekti pangah pangan pangeran pangestu panggak panggal panggang panggar panggau panggih panggil panggon panggu panggul panggung pangkah pangkai pangkal pangkas pangkat pangkek pangkin pangking pangkon pangku pangkung pangkur panglima pangling panglong pangolat pangonan pangpet pangpung pangreh pangrehpraja pangrukti pangsa pangsek pangsi pangsit panguk pangur pangus panik paniki panil panili paninggil paningset panir paniradia panitera panitia panja panjak panjang panjar panjarwala panjat panjer panji panjing panjul panjunan panjut pankreas pankromatis pankronis panlektal panleukapema panleukopenia panoptikum panorama panser pantai pantak pantalon pantang pantar pantas pantat pantau panteis panteisme panteistis pantek pantekosta panteon panter panti pantik panting pantis panto pantofel pantograf pantomim pantri pantul pantun panus panutan panyembrama pao-pao papa papacang papah papain papak papakerma papan papar paparazi papas papat papatong papi papil papila papirus papras paprika papui par para parab parabasis parabel parabiosis parabola paradam parade paradigma paradigmatis paradiso paradoks paradoksal parados paraf parafasia parafemia parafin parafrasa parafrase parafrenia paragaster paragog paragon paragraf parah parak paralaks paraldehida paralel paralelisasi paralelisme paralelogram paralgesia paralinguistik paralinguistis paralipsis paralisis paralitis param paramaarta paramarta paramasastra paramedis paramen parameter paramiliter parampara paran parang paranoia paranoid paranormal paranpara parap parapalatal parapati paraplasme paraplegia parapodium parapsikolog parapsikologi paras parasetamol parasintesis parasit parasitisme parasitoid parasitologi parasitoma parasitopolis parasut parasutis parataksis parataktis paratesis paratifus paratiroid parau pare parenial parenkim parental parentesis parestesia parewa parfum parga parhelion pari paria parididimis parih parik parikan paring paripurna paris parit paritas pariwara pariwisata parji parka parket parkinson parkinsonisme parkir parkit parlemen parlementaria parlementarisme parlementer parmitu paro parodi paroki parokial parokialisme parolfaktori paron paronim paronisia paronomasia parotitis pars parser parsi parsial partai partenogenesis partial partikel partikelir partikularisme partisan partisi partisipan partisipasi partitif partitur partner partus paru paruh parun parut parvenu parwa pas pasah pasai pasak pasal pasang pasanggiri pasar pasara pasaraya pasase pasasir pasat pascabedah pascadoktoral pascajual pascakawin pascakrisis pascalahir pascalarva pascalikuidasi pascamodern pascamodernisme pascaoperasi pascapanen pascaperang pascaproduksi pascareformasi pascasarjana pascausaha pascayuwana paseban paser paset pasfoto pasi pasien pasif pasifikasi pasifisme pasigrafi pasik pasilan pasim pasimologi pasir pasirah pasit pasiva paskah pasmat pasmen pasok pasowan paspor pasrah pasta pastel pasteur pasteurisasi pasti pastiles pastor pastoral pastoran pastur pastura pasu pasuel pasuk pasumandan pasung patah pataka patam patang patar patek patela paten pater patera pateram paternalis paternalisme paternalistis patet patetis patgulipat pati patih patik patikim patil patin patina pating patio patirasa patiseri patka patogen patogenesis patogenik patois patok patokimia patol patola patolog patologi patologis patolopolis patos patra patrap patri patriark patriarkat patrilineal patrimonium patriot patriotik patriotisme patroli patron patronasi patrun patuh patuk patung patungan patut pauh pauhi pauk paul paun paung paus pause paut paviliun pawai pawak pawaka pawana pawang pawiyatan pawukon paya payah payang payar payau payet payon payu payudara payung peang pecah pecai pecak pecal pecara pecat pece pecel peci pecicilan pecinan pecok pecuk pecun pecut peda pedada pedadah pedagog pedagogi pedagogis pedak pedaka pedal pedanda pedang pedapa pedar pedas pedati pedel pedena pedendang pedengan pedepokan pedestrian pedet pedewakan pediatri pedih pedikur pedis pedisel pedogenesis pedok pedologi pedoman pedometer pedongkang pedot peduli pedunkel pedusi pedut pegagang pegah pegal pegan pegang pegar pegari pegas pegat pegawai pego pegoh pegon peguam pegun pehong pei pejajaran pejaka pejal pejam pejatian pejera pek peka pekaja pekak pekakak pekan pekarang pekasam pekaseh pekat pekatu pekatul pekau pekerti pekik peking pekir pekis pekiwan pekojan peksi pektik pektil pektin peku pekuk pekulun pekung pekur pel pelabi pelabur pelaga pelagas pelagis pelagra pelah pelak pelalah pelamin pelampang pelampung pelan pelana pelancar pelanduk pelang pelangai pelanggi pelangi pelangkin pelangpang pelantar pelanting pelas pelasah pelasik pelaspas pelasuh pelat pelata pelatuk pelawa pelayon pelbagai pelbak pelbet pelebat pelebaya pelebegu pelebon pelecet pelecok peleh pelek pelekat pelekok pelekuk pelembaya pelencit pelengak pelengan pelengset pelepah peles pelesat peleset pelesir pelesit pelet peletek peletik peleting peleton pelias pelihara pelik pelikan pelikel pelinggam pelinteng pelintir pelipir pelipis pelir pelisir pelisit pelit pelita pelitur pelo pelog peloh pelojok pelonco pelong pelopor pelor pelorot pelosok pelosot pelota pelotaris pelotot pelples pelpolisi pels peluang peluh peluit peluk peluluk pelulut pelumpung pelungpung pelupuh pelupuk peluru peluruh pelus pelvis pemair pemali pemarip pematah pematang pemayang pembarap pembayan pemendak pemeo pemetikan pemidang pemindang peminggir pempek pemuda pemudi pemuras pen pena penak penaka penalti penampan penampang penanggah penaram penasaran penat penatu penatua penca pencak pencalang pencar pencet pencil pencok pencong pencu pencut penda pendaga pendahan pendak pendam pendapa pendar pendaringan pendek pendekar pendet pendeta pending pendok pendongkok pendopo pendua penduk pendulum penembahan penes penetrasi penetron penewu pengalasan penganak penganan pengang pengantin pengap pengapuh pengar pengaruh pengat pengatu pengawinan pengeng pengerih pengga penggaga penggah penggal penggar penggawa penghulu pengin pengkal pengkar pengki pengkis pengkol pengkor pengos penguin pengulun peni peniaram pening peningset penis penisilin penisilinat penitensi peniti penjajap penjalin penjara penjaruman penjor penjuna penjura penjuru penmes penologi penomah pensi pensil pensiun pentagin pentagon pentagor pentagram pentahedron pental pentameter pentan pentana pentang pentar pentas pentatonik pentil pentilasi penting pentode pentol pentosa pentotal pentung penuh penyap penyek penyet penyok penyu peok peot pepagan pepah pepak pepaku peparu pepas pepat pepatah pepaya pepe pepek pepeling peper pepermin pepes pepet pepindan peplum pepsin pepsina pepsinogen peptida peptidase peptik pepton pepuju pepunden pepung peputut per pera perabot perabung perada peragat perah perahu perai peraji perak peraka peram perambut peran perancah perang perangah perangai peranggang peranggu peranggul perangin perangkap perangkat peranjat peranti peranye perap peras peras-perus perasat perasukan perat perata perawan perawas perawi perawis perbal perban perbani perbatin perbawa perbegu perbekel perca percaya percik percis percit percul percuma perdah perdana perdata perdeo perdikan perdom perdu pere peredus pereh perei perek perekik perempuan perencah perenggan perengkat perengus perengut perenial perenkum perenyak perenyuk perepat peres peresau peresih perestroika peretel perewa perfek perfeksi perfeksionis perfeksionisme perfektif perforasi perforator performa pergam pergat pergata pergedel pergi pergok pergol pergola perhati peri peria perian periang periantium peribahasa periboga peribudi peridi perifer periferal periferalis periferi perifiton perifrasa perifrase perifrastis perige perigel perigi perih perihal perihelion perikarditis perikardium perikemanusiaan perikondrium periksa perilaku perimbas perimeter perimisium perimpin perimping perinci perincit perineorium perineum perineurium pering peringgan peringgi peringgitan peringis peringkat perintah periodat periode periodik periodisasi periodonsium periodontium periorbita periosteum perirana perisa perisai periskop perispora peristalsis peristaltik peristerit peristiwa peristonium perit peritoneum peritonitis periuk perivaskuler perjaka perji perkakas perkale perkamen perkara perkasa perkedel perkelang perkolar perkolasi perkolator perkoler perkosa perkusi perkutut perlahan perlak perlambang perleng perlente perlenteh perli perlina perling perlintih perlip perlit perlop perlu perlup perlus permadani permai permaisuri permak permalin permana permanen permanganat permasan permata permeabel permeabilitas permen permil permisi permisif permutasi pernah pernak pernekel pernik pernikel pernis perogol perohong peroi peroksida peroksidase peroksisom peroksisoma peroman perompak peron peroneal peronyok perop perosok perosot perot perpatih perpetuasi perponding pers persada persangga persegi persekot persekusi persen persentase persentil persepsi perseptif perseptivitas persero persetan perseus perseverasi persih persik persil persis persisi perslah persneling person persona personal personalia personalisme personalitas personel personifikasi perspektif perspektivisme persuasi persuasif pertal pertama pertepel pertiwi pertua perturbasi pertusis peruak peruan peruang perubalsem perudang peruk perum perumpung perun perunggu perunjung perupuk perus perusi perut perversi perwara perwira pes pesa pesai pesak pesakin pesam pesan pesanggrahan pesantren pesara pesat pesawat pese peseh pesek peser pesero peset pesi pesiar pesimis pesimisme pesimistis pesing pesirah pesisir pesok pesolot pesona pesong pesta pestaka pestisida pestol pesuk pesut pet peta petah petai petak petaka petal petala petaling petam petamari petan petanen petang petaram petarang petarangan petaruan petas petatang-peteteng petatus petegian petek petel petenteng petepete peterana peterseli petes peti petia petik petikrah petikut petilan peting petinggi petiolus petir petis petisi petitih petitum petogram petola petopan petor petrodolar petrografi petrogram petrokimia petrol petrolatum petroleum petrologi petromaks petsai petuah petuding petuduh petuk petunia peturun petus petut pewaka pewat peyek peyorasi peyot phi piadah piagam piah piak pial piala pialang pialing pialu piama piang pianggang pianggu pianika pianis piano pianola piantan piara piarit pias piaster piat piatu piawai pica picah picik picing picis picit pico picu picung pidana pidato pidi piezoelektrik piezoelektrisitas piezometer pigmen pigmentasi pigmi pigura pihak pijah pijak pijar pijat pijin pijinasi pijit pika pikap pikat pikau pike piket pikir piknik piknometer pikofarad pikogram pikolo piktografi piktogram pikul pikun pikup pil pilah pilak pilang pilar pilas pilaster pilau pileh pilek pileren pilih pilin pilis pilon pilong pilorus pilositas pilot pilsener pilu pilus pimpel pimpin pimping pin pina-pina pinak pinang pinar pincang pincuk pincut pinda pindah pindai pindang pines pinga pingai pinggah pinggan pinggang pinggir pinggul pingit pingkal pingkau pingpong pingsan pingul pinis pinisepuh pinisi pinjal pinjam pinjung pinset pinta pintal pintan pintang pintar pintas pintil pintu pintur pinus piogenik pion pioner piong pionir pipa pipet pipi pipih pipil pipis pipit pir pirai piramid piramida piramidal pirang piranograf piranogram piranometer pirasat pirau pireksia pirektik piretrum pirian piriform pirik piring pirit pirofilit pirofobia piroksen pirolisis piromania pirometalurgi pirometer piroteknik pirsa piruet pirus pis pisah pisang pisau pises pisiformis pisik pisin pisit pisitan pisovonus pispot pistol pistom piston pisuh pit pita pitak pitam pitanggang pitar pitarah pitawat piting pitiriasis pitis pitometer piton pitot pitut piuh piung piut piutang pivot piwulang piyik piza plafon plagiarisme plagiat plagiator plagioklas plakat plaket plaksegel plamir plan planaria planel planet planetarium planetoid plang plangkan planimeter planimetri planing planisfer plankton plano planologi planologis planospora plantase planula plasenta plaser plasma plasmodium plasmosis plastid plastik plastin plastis plastisitas plastogami plastometer plastron platelet platform platina platinoid platinum platisma plato platonik platonisme plaza plebisit pleidoi pleiogami pleistosen pleksus plengkung pleno pleonasme pleopod plerem plester pletora pleura plinplan plintat-plintut plinteng plintit pliosaurus pliosen ploi ploidi plombir plonci plonco plong plonga-plongo plontos plosif plot plug plumbago plumbum plumbung plural pluralis pluralisme pluralistis pluriform plus pluto plutokrasi plutonik plutonium pluvial pluviograf pluviometer pneumatika pneumatofos pneumatokista pneumonia poal poces poci pocok pocong podemporem podikal podium poetika pof pogrom pohon poikilohalin poikiloterm poin point poise poiseuille pojok pok pokah pokeng poker poket poko pokok pokrol poksai pokta pol pola polah polan polang polarimeter polarimetri polaris polarisasi polaritas poldan polder polemik polemis polen poleng polenter poler poles polet poliandri poliantus poliester polifagia polifase polifoni poligam poligami poligini poliglot poliglotisme poligon poligraf polihalin polikel poliket poliklinik polikrom polikultur polimer polimerisasi polinia polio polip polipeptida polipetal poliploid polipropilena polis polisakarida polisemi polisentrisme polisepal polisi polisiklis polisilogisme polisindeton polisional polispermi polister politbiro politeis politeisme politeistis politeknik politena politik politika politikus politis politisasi poliuretan polivini polizoa polka polkadot polmah polo polok polones polong polonium polonter polos polusi polutan polutif poma pomade pomologi pompa pompang pompon pompong pon ponakan ponco pondamen pondar ponderabilitas pondik pondoh pondok pondong pongah ponggang ponggok pongkol pongsu poni ponil ponok ponor pons pontang-panting ponten pontoh ponton poo pop popelin popi popok popor popularisasi popularitas populasi populer populis populisme pora porah porak-parik porak-peranda porak-poranda porfiria pori porisitas porno pornografi pornografis porok porong poros porositas porot porselen porsi porta portabel portal portepel portik portir porto portofolio pos pose poser posesif posisi positif positivisme positivistik positron positronium poskar poso posologi postar poster posterior postulat postur pot potas potasium potator potehi potel potensi potensial potensiometer potia potlot potol potong potret poundal poyang praanggapan praba prabu pradana pradesa pradesain pradini praduga pragmatik pragmatika pragmatis pragmatisme prah prahara prahoto prairi praja prajaksa prajurit prakala prakarsa prakarya prakata prakilang prakira prakondisi prakonsepsi praksis praktek praktik praktikan praktikum praktis praktisi pralahir pramenstruasi prameswari pramodern pramubakti pramubarang pramubayi pramudi pramugara pramugari pramujasa pramuka pramukamar pramuniaga pramupintu pramuria pramusaji pramusiwi pramutamu pramuwisata pramuwisma pranala pranata pranatacara pranatal prangas prangko pranikah prapalatal prapatan prapendapat praperadilan prapromosi prapuber prapubertas prapuna prapustaka prarasa prarekam praremaja prasaja prasangka prasaran prasarana prasasti prasawya prasejahtera prasejarah prasekolah praseminar praseodimium prasetia prasi prasmanan prastudi prasyarat pratersier pratinjau prawacana prawira prayang prayitna prayojana prayuwana preadvis preambul preantena preasetabulum predasi predator predestinasi predikat predikatif prediksi predisposisi preferensi prefiks prehistori prei prekositas prekursor preliminer prelude preman prematur premi premis premium premolar prenatal prenjak preparat preposisi prepotensi prerogatif pres presbiopia presbiterium preseden presensi presentabel presentasi presentil preservasi presesi presiden presidensial presidentil presidium presiositas presipitasi presisi preskripsi preskriptif prestasi prestise prestisius presto presumsi pretel pretensi prevalensi preventif preview prewangan pria priagung priayi pribadi pribumi prihatin prima primadona primas primata primbon primer primitif primogenetur primordial primordialisme primpen pringas-pringis pringgitan prinsip prinsipiil prioritas pripih pris prisma prit privasi privat privatisasi prive privilese pro proaktif probabilitas problem problematik procot prodemokrasi prodeo produk produksi produktif produktivitas produsen produser proenzim prof profan profanitas profase profesi profesional profesionalisme profesionalitas profesor profetik profil profilaksis profit profitabel profitabilitas proforma progeni progesteron prognosis program programa progres progresif progresivitas prohibisi proklamasi proklamator proklitik proksimal proksimat prokurasi prokurator prolat prolegomena proleksem proletar proletariat proletarisasi proliferasi prolog promenade prometium prominen prominensia promiskuitas promontorium promosi promotif promotor promovendus pronomina pronominal pronominalisasi prop propaganda propagandis propana propelan propeler properti propfan propilena propinsi proporsi proporsional proposal proposisi propulsi prosa prosais prosede prosedur prosedural prosenium proses prosesi prosesor proskonion proskriptivisme prosodi prosodis prospek prospeksi prospektif prospektus prostaglandin prostat prostitusi protagonis protaktinium protandri protandris protasis proteid protein proteinuria proteksi proteksionisme protektif protektorat proteolisis proteolitik protes protese protesis protestan protestantisme protista proto protofon protogenesis protokol protokoler protolisis protolitik proton protoneolitik protoplasma protoraks prototipe protozoa protrombin protuberansia provinsi provinsialisme provisi provisional provitamin provokasi provokatif provokator provokatur provos proyek proyeksi proyektil proyektor prudensial prurigo psalm psamolitoral pseudo pseudokata pseudomorf pseudonim psi psike psikiater psikiatri psikis psikoanalisis psikodrama psikofarmakologi psikofisiologis psikokinesis psikolepsi psikolinguistik psikolog psikologi psikologis psikometri psikometrika psikomotor psikomotorik psikoneurosis psikopat psikopati psikopatologi psikosastra psikoseksual psikosis psikosomatik psikoteknik psikoteknis psikoterapi psikotes psikotropika psikrofili psikrometer psikrometri psitakosis psoriasis pterodaktil pteropoda ptialin ptomaina puadai puah puak puaka pual pualam puan puas puasa puatang pub puber pubertas pubesens publik publikasi publisis publisistik publisitas pucang pucat pucik pucuk pucung pudar pudat pudel puder pudi puding pudur puerpera puerperium pugak pugar pugas puguh puih puing puisi puitis puitisasi puja pujangga puji pujuk pujur pujut pukah pukal pukang pukas pukat pukau puki pukul pul pula pulai pulan pulang pulas pulasan pulasari pulau pulen pulih pulik pulover pulp pulpa pulpen pulper pulsa pulsar pulsasi puluh pulun pulung pulut puma pumpun pun punah punai punakawan punar punat punca puncak punci pundak punden pundi punding punduh punduk pundung pung pungak-pinguk punggah punggai punggal punggawa pungguk punggung punggur pungkah pungkang pungkas pungkur pungli pungsi pungtuasi punguk pungut punia punjul punjung punjut punk puntal punti puntianak puntir puntuk puntul puntung punuk punya pupa pupil pupu pupuan pupuh pupuk pupur pupus puput pura purba purbakala purbani purbasangka purbawisesa purdah pure purgatif puri purifikasi purik puring puris purisme puristis puritan puritanisme purna purnabakti purnaintegrasi purnajabatan purnajual purnakarya purnama purnapugar purnasarjana purnatugas purnawaktu purpura purser puruk puruk-parak purun purus purusa purut purwa purwakanti purwapada purwarupa pus pusa pusak pusaka pusang pusar pusara pusat puser pusing puskesmas puso puspa puspadana puspadanta puspamala pusparagam puspas puspawarna puspita pusta pustaha pustaka pustakaloka pusu pusung pusut putar putat puter puti putih putik puting putra putranda putrawali putrefaksi putresin putri putriditas putu putus putut puvi-puvi puyan puyeng puyer puyonghai puyu puyuh qaf qari qariah qasar qiamulail qiraah qiraat qudsi quran raba raba-rubu rabak raban rabana rabani rabas rabat rabet rabi rabies rabik rabit rabiulakhir rabiulawal rabotase rabu rabuk rabulizat rabun rabung rabut racak racau racik racuh racun rad rada radaah radah radai radak radang radar radas raden rades radi radiah radial radian radians radiasi radiator radif radikal radikalisasi radikalisme radiks radikula radin radio radioaktif radioaktivitas radiogenetika radiogoniometer radiogoniometri radiograf radiografi radiogram radioisotop radiokarbon radiokimia radiolisis radiolog radiologi radiolokasi radiometer radiosonde radiotelefoni radiotelegrafi radiotelegrafis radioterapi radis radium radius radon radu radurisasi rafak rafaksi rafe rafi rafia rafidi rafik raflesia raga ragam ragang ragas ragawi ragi ragib ragil ragu raguk ragum ragung ragut rahak rahang rahap raharja rahasia rahat rahayu rahib rahim rahimakallah rahimakumullah rahmah rahman rahmat rahmatullah rahu rai raib raigedeg raih raimuna rais raja rajab rajabiah rajah rajalela rajam rajang rajapati rajawali rajim rajin rajok rajuk rajul rajungan rajut rak raka rakaat rakah rakanita rakap rakat rakawira rakbol raket rakila rakis rakit rakitis rakna raksa raksabumi raksasa raksi rakuk rakung rakus rakut rakyat rakyu ralat ralip ram rama rama-rama ramadan ramah ramai ramal ramanda ramania rambah rambai rambak ramban rambang rambat rambeh rambih rambu rambun rambung rambut rambutan rambuti rames rami ramin ramirezi rampa rampai rampak rampang rampas rampat ramping rampok rampuh rampung rampus ramu ramus rana ranah ranai ranap ranca rancah rancak rancam rancang rancap rancau rancu rancung randa randa-rondo randah randai randajawan randak randat randau randek randi randu randuk randung rang rangah rangai rangak rangam rangas rangga ranggah ranggak ranggas ranggeh ranggi ranggit ranggul ranggung rangin rangina rangka rangkai rangkak rangkam rangkang rangkap rangkaya rangket rangkiang rangkik rangking rangkit rangkok rangkul rangkum rangkung rangkup rangkus rangkut rango-rango rangrang rangrangan rangsang rangu rangum rangup rani ranjah ranjang ranjau ranji ranjing rankine ransel ransum rantai rantam rantang rantas rantau rante ranti ranting rantuk rantus ranum ranyah ranyang ranyau ranyun rap rapah rapai rapak rapal rapang rapat rapel rapi rapiah rapik rapor rapsodi rapu rapuh rapun rapung rapus raraha rarai rarak rarangan raras ras rasa rasai rasam rasamala rasan rasau rasberi rase rasem rasi rasia rasial rasialis rasialisme rasian rasio rasional rasionalis rasionalisasi rasionalisme rasionalitas rasisme raster rasuk rasul rasuli rasulullah rasyid rasywah rat rata ratah ratap ratib ratifikasi ratna ratu ratus rau raudah raudatul raudatulatfal raum raun raung raup raut rawa rawah rawai rawak rawan rawang rawat rawatib rawi rawin rawit rawon rawuh raya rayah rayan rayang rayap rayau rayon rayonisasi rayu razia reagen reagensia reak reaksi reaksioner reaktan reaktans reaktansi reaktif reaktivitas reakton reaktor reaktualisasi real realis realisasi realisme realistis realitas realokasi realpolitik reasuransi reaumur reba rebab rebah rebak reban rebana rebas rebat rebeh rebek rebes rebet rebewes reboisasi rebon rebu rebuk rebung rebus rebut reca recak receh recet recik recok recup reda redah redaksi redaksional redaktur redam redang redap redefinisi redih redik redoks reduksi reduksionisme redum redup reduplikasi redusir redut reedukasi reekspor referat referen referendaris referendum referensi referensial reflasi refleks refleksi reflektif reflektor reformasi reformis refraksi refraktometer refraktor refrein refrigerator regah regan regang regas regat regata regel regen regenarasi regenerasi reges regi regio region regional regionalisme register registrasi regisur reglemen reglementer regol regresi regresif regu reguk regularisasi regulasi regulatif regulator reguler regup rehab rehabilitasi rehabilitatif rehal rehat rehidrasi reideologisasi reindoktrinasi reinkarnasi reintegrasi reinterpretasi reinvestasi reja rejab rejah rejan rejang rejasa rejeh rejeng rejuk rek reka rekah rekal rekalkulasi rekalsitran rekam rekan rekanalisasi rekanita rekap rekapangan rekapitalisasi rekapitulasi rekat rekata rekayasa reken rekening rekes rekisitor rekisitur reklamasi reklame reklasering reklasifikasi rekognisi rekoleksi rekombinan rekombinasi rekomendasi rekonsiliasi rekonstruksi rekonstruktif rekonvensi rekor rekreasi rekrut rekrutmen reksa rekstok rektifikasi rekto rektor rekuiem rekuisisi rekuisitor rekurs rel rela relai relaks relaksasi relang relap relas relasi relatif relativisasi relativisme relativitas relau relban relevan relevansi reli reliabel reliabilitas relief religi religiositas religius relik relikui relikwi relokasi reluk relung rem rema remah remai remaja remak remanen remang remas rematik rematisme rematoid rembah rembang rembas rembat rembega rembes rembet rembih rembuk rembulan rembunai remburs rembut remedi remedial remediasi remeh remenia remet remi remiak remiling reminisensi remis remisi remoh rempa rempah rempak rempela rempelas rempenai rempeyek rempong rempuh rempuk rempus remujung remuk remunerasi remunggai rena renah renai renaisans renal renang rencah rencak rencam rencana rencang rencat renceh renceng rencet rencong renda rendabel rendah rendam rendang rendemen rendeng rendet rendong renegosiasi renek renes reng rengadean rengap rengas rengat rengeh rengek rengeng rengga renggam renggang renggat renggek rengges rengginang renggut rengit rengkah rengkam rengkeh rengket rengkit rengkong rengkudah rengkuh rengrengan rengsa rengus rengut renik renin renium renjana renjatan renjeng renjis renjong renjul renkinang renovasi renta rentabilitas rentak rentaka rental rentan rentang rentap rentas rente renteng rentenir rentet renti rentik renumerasi renung renvoi renyah renyai renyam renyang renyap renyau renyeh renyek renyem renyuk renyut reog reol reologi reometri reorganisasi reorientasi reostat reot rep-repan repang reparasi repas repatrian repatriasi repek repertoar repertorium repes repet repeten repetisi repetitif repetitor repih replik replika repolarisasi repormir reportase reporter reposisi repot representasi representatif represi represif reproduksi reprografi reptil reptilia republik republiken repuh repui reput reputasi rerak rerangka reranting reras rerata reresanan rerongkong rerot rerugi reruntuk resa resah resak resam resan resap resbang resek resensi resensor resep resepsi resepsionis reseptif reseptor reserse resersir reservat reserve reservoir reses resesi resi residen residivis residivisme residivistis residu resik resiko resimen resin resinol resipien resiprok resiprokal resistan resistans resistansi resistor resital resitasi resmi resolusi resonan resonansi resonator resor resorpsi resorsinol resosialisasi respek respirasi respirator responden respons responsi responsif restan restiformis restitusi restoran restorasi restriksi restriktif restrukturisasi restu restung resu resultan resume resurjensi ret reta retail retak retardasi retas retek retenidos retensi retet retih retikuler retina retinakulum retinitis retok retorik retorika retoris retorsi retradisionalisasi retreatisme retret retribusi retro retroaktif retrofleks retrofleksi retrogresi retrogresif retrolingual retromamal retromandibuler retrospeksi retur retus reumatismos reuni reunifikasi revaksinasi revaluasi revans reverberasi revisi revisibilitas revisionis revitalisasi revolusi revolusioner revolver rewak rewan rewanda rewang rewel rewet reyal reyot rezeki rezim rho ria riadat riah riak rial riam rian riang riap rias riba ribang ribat ribatat riben riboflavin ribosom ribu ribut rica ricau ricik ricuh rida ridan ridi riding ridip ridu rigai rigi-rigi rihat rihlah riil rijal rijalugaib rijalulgaib rijang rikuh rileks rilis rim rima rimas rimata rimba rimbas rimbat rimbawan rimbun rime rimis rimpang rimpel rimpi rimpuh rimpung rinai rincih rincis rincu rindang rinding rindu ring ringan ringgit ringih ringik ringin ringis ringkai ringkas ringkih ringkik ringking ringkuk ringkus ringsek ringsing rini rinitis rinjing rinoskop rintang rintas rintih rintik rintis rinyai riol ripit ripta ripuh ripuk ririt risa risak risalah risau riset risi risik risiko risit riskan rit ritel ritma ritme ritmis ritual ritul ritus riuh riuk riung rival rivalitas riwan riwayat robak-rabik robat-rabit robek roboh robok robot robotika rocet roda rodan rodat rodensial rodentisida rodi rodium rodok rodolit rodong roga rogoh rogok rogol roh rohani rohaniah rohmat rohulkudus roi rojeng rojol rok rokade roker roket roki rokok rol rolet rolpres roma roman romanistik romansa romantik romantika romantikus romantis romantisisme romawi rombak rombang-rambing rombeng rombik rombohedron romboid rombok rombong rombus romet romok romol-romol romong rompak rompal rompang rompeng rompes rompi rompoh rompok rompong rompyok romsus romusa rona ronce roncet ronda rondah-rondih ronde rondo rondok roneo rong rongak rongga ronggang ronggeng ronggok ronggong rongkoh rongkok rongkol rongkong rongos rongrong rongseng rongsok ronta rontek rontgen rontok ronyeh ronyok ropak-rapik rorehe rorod ros rosario rosbang rosela roseng roseola roset rosin rosok rosot rotan rotasi rotator roti rotograf rotok rowa rowot royak royal royalti royan royemen royer royong rua ruadat ruah ruai ruak ruam ruang ruap ruas ruat ruaya ruba-ruba rubah rubai rubaiat ruban rubanat rubel rubela rubeola rubiah rubidium rubik rubin rubing rubrik rubu rubung rucah rudah rudal rudapaksa rudi rudimen rudin rudu rudus rugbi rugi ruh ruhbahnat ruhban ruhbanat ruhbaniat ruilslag ruing ruit rujah rujak ruji rujuk rukam rukhsah rukiah ruko ruku rukuh rukuk rukun rukyat rukyatulhilal rum rumah rumal rumba rumbah rumbai rumbia rumbing rumbu rumen rumenia rumi rumin ruminansi ruminansia rumit rumor rumpakan rumpang rumpi rumpil rumpon rumpun rumput rumrum rumuk rumung rumus runcing runcit runding rundu-rundu runduk rundung rungau runggas runggu runggu-rangga rungguh runggut rungkau rungkuh rungkun rungkup rungu rungus rungut runjam runjang runjau runjung runtai runtang-runtung runtas runti runtih runtuh runtun runtut runut runyam runyut ruok rupa rupee rupiah rurut rusa rusak rusuh rusuk rutab rute rutenium ruterfordium rutin rutuk rutup ruwah ruwat ruwet ruyak ruyap ruyung ruyup saadah saadin saanen saat sab-sab saba sabah sabak saban sabana sabang sabar sabas sabasani sabat sabatikal sabda sabel saben sabet sabi sabil sabilillah sabit sabitah sableng sablon sabo sabot sabotase sabsab sabtu sabuk sabun sabung sabur sabut sad sadah sadai sadak sadang sadap sadar sadariah sadarulkalam sadarusalam sadau sadel sadik sadin sading sadir sadis sadisme sadistis sado sadrah sadran sadu sadur saf safa safar safari safi safih safinah safinatunajah safir safrah safron safsaf safsah saga sagai sagang sagar sagitarius sagon sagu saguer sagur sah sahabat sahaja saham sahan sahang sahap sahara saharah sahaya sahayanda sahda sahdu sahi sahib sahibulbait sahibulhajat sahibulhikayat sahifah sahih sahir sahkan sahmura sahur sahut sai saif sailan sailo saing sains saintis sair sais saja sajadah sajak sajang sajen saji sak saka sakai sakal sakang sakap sakar sakarida sakarimeter sakarin sakarosa sakat sake sakelar sakelek sakhawat sakhi sakhrat sakhsi saki sakinah saking sakit saklek sakral sakramen sakramental sakramentalia sakratulmaut sakrilegi sakristi sakrokoksigeal sakrolumbal sakrum saksama saksang saksi saksofon sakti saku sakura sal salaf salah salai salak salam salang salar salaris salasal salat salatin saldo sale saleh salem salep sali salib salihah salim salin salina salindia salindra saling salinisasi salinitas salinometer salip salir salira salivasi salju salmon salmonela salon salpeter salping saltasi salto saluir saluk salung salur salut salvarsan salvo sama samad samak saman samanera samaniah samapta samar samara samarium samas samawi samba sambal sambalewa sambang sambangan sambar sambat sambau samben sambet sambi sambil sambiloto sambit sambuk sambung sambur sambut sami samidra samijaga samin samir samo-samo samovar sampa sampah sampai sampak sampakan sampan sampang sampanye sampar samparan sampat sampean sampek sampel samper sampeyan sampil sampilik samping sampir sampling samplok sampo sampu sampuk sampul sampur samsak samsam samseng samsir samsiti samsu samudra samuh samum samun samurai sana sanad sanak sanat sanatogen sanatorium sanatulhijriah sanatulmiladiah sanawiah sanca sanda sandal sandang sandar sandel sandera sandi sanding sandiwara sando sandung sandungan sanering sang sanga sangai sangan sangar sangat sangau sangga sanggah sanggam sanggama sanggan sanggang sanggar sanggarunggi sanggat sanggep sanggerah sangging sanggit sanggrah sanggraloka sanggul sanggup sanggurdi sangha sangih sangir sangit sangka sangkak sangkakala sangkal sangkala sangkan sangkar sangkil sangku sangkul sangkur sangkuriang sangkut sangkut-paut sangli sangling sanglir sangon sangrai sangsai sangsam sangsang sangsi sangu sanguifikasi sangulun sangyang sani sanik sanitas sanitasi saniter sanjai sanjak sanjang sanjung sanksi sano sansai sanseviera sanskerta santa santai santak santam santan santap santase santau santer santet santiaji santing santir santo santonin santri santun santung sanubari sap sapa sapai saparantu sapat sapau sapersi sapi sapih sapir sapit sapogenin saponin saprofit sapta saptadarma saptamarga saptapesona sapu saput saputangan sar sara saradasi saraf sarak saran sarana sarang sarangan sarap sarasehan sarat sarau sarden sardencis sarean sareh sarekat saren sarengat sarhad sari saridele sarik sarikan saring sarira sarirah sarit sarjana sarju sarkasme sarkastis sarkode sarkoderma sarkofagus sarkolema sarkologi sarkoma sarkoplasma saron sarsaparila sartan saru saruk sarung sarut sarwa sasa sasak sasakala sasana sasando sasap sasar sasau sasi sasian sasis sasmita sastra sasus sat satai satang satanologi satar sate satelit satih satin satinet satir satire satiris sato satori satpam satria satron satu saturnus saturometer satwa satyagraha satyalencana satyawacana sau saudagar saudara saudari sauh saujana sauk saum sauna saung saur saus saut sauvinis sauvinisme sauvinistis saw sawab sawah sawai sawala sawan sawang sawangan sawar sawat sawer sawi sawit sawo sawut saya sayak sayang sayap sayat sayembara sayet sayib sayid sayidani sayidi sayidina sayu sayung sayup sayur seba sebab sebahat sebai sebak sebal sebam sebar sebarang sebarau sebasah sebat sebaur sebekah sebel sebelas sebeng sebentar seberang seberhana sebet sebit seblang sebrot sebu sebuk sebum sebun sebura seburas seburu seburus seburut sebut secang seceng secerek secina sedahan sedak sedam sedan sedang sedap sedat sedatif sedativa sedawai sedekah sedekap sedelinggam sedeng sederhana sederum sedia sediakala sedih sedikit sedimen sedimentasi sedimenter sedingin sedong sedot sedu seduayah seduh sefalopoda sefalotoraks seg sega segah segak segala segan seganda segani segar segara segata segeger segeh segel segenap segera segi segianya segitiga segmen segmental segmentasi segregasi seguna seh seharah sehat sehingga seia seilometer sein seismik seismograf seismogram seismolog seismologi seismometer sejahtera sejajar sejak sejarah sejarawan sejari sejat sejati sejingkat sejuk sek seka sekadar sekah sekak sekakar sekakmat sekal sekala sekali sekaligus sekalipun sekalor sekam sekan sekang sekap sekapar sekar sekarang sekarat sekat sekata sekaten sekati sekaut sekeber sekebun sekedeng sekeduduk sekedup sekelat sekelebatan sekelian sekema sekendal sekendi sekengkeng sekepat sekeram sekeri sekerindangan sekering sekesel seketeng sekh sekian sekilwak sekip sekira sekiram sekitar seko sekoci sekoi sekolah sekon sekongkol sekonyong-konyong sekop sekopong sekoteng sekrap sekresi sekret sekreta sekretariat sekretaris sekretin sekring sekrip sekrup seks seksi seksmaniak seksolog seksologi seksologis sekstan sekstet seksual seksualitas sektarian sektarianisme sekte sektor sektoral sekuas sekui sekul sekularis sekularisasi sekularisme sekularitas sekuler sekulir sekunar sekunder sekunyit sekuritas sekuriti sekutu sel sela selabar selaber selaberak selada seladang seladon selagi selai selain selaju selak selaka selakarang selaku selalu selam selamat selamba selampai selampe selampek selampit selan selancak selancang selancar selang selangat selangka selangkang selangkup selanting selap selapan selaput selar selara selarak selaras selarung selasa selasar selasih selat selatan selawah selawat selawe selaya selayun selayur sele selebaran selebran selebrasi selebritas selebriti selebu seleder selederi seledri seleguri selekeh selekoh selekor seleksi selekta selektif selektivitas seleler selembana selembubu selempada selempang selempukau selempuri selendang selender selendro selenggara selengkatan selenium selenografi selenologi selentang-selenting selentik selenting seleo selepa selepang selepat selepe seleper selepetan selepi selera selerak selerang seleret selesa selesai selesma seletuk seleweng selia seliap selibat selibu selibut selidik seligi seligit selimang selimpang selimpat selimut selinap selindung seling selingar selingkit selingkuh selingkup selip selipar selir selira selirak selirat seliri selisih selisik selisip selisir selit seliwer selo selofan selok seloka selokan seloki selom selomot selompret selon selonding selong selongkar selongsong selonjor selonong selop seloroh selot seloyak seloyong selter seluang seluar selubung seludang seludu seluduk seludup selui seluk seluk-beluk selukat selukung seluler seluloid selulosa selulup selulur selumar selumbar selumbari selumbat selumu selumur seluncur selundat selundup selungkang selungkup selup selupan selupat selurah seluru seluruh selusuh selusup selusur selut sema semadi semafor semah semai semaja semak semalu semambu semampai semampang semampat seman semanak semandan semandarasa semandarasah semandera semang semangat semanggi semangka semangkok semangkuk semantan semantik semantis semantung semaput semara semarai semarak semaram semarmendem semat semata semawang semawar semaya semayam semayi sembab sembabat sembada sembagi sembah sembahyang sembai sembak sembam sembap sembar sembarang sembari sembat sembawang sembayan sembelih sembelit sember semberap semberip sembesi sembeta sembiang sembilan sembilang sembilik sembilu sembir sembirat semboyan sembrani sembrono sembuang sembuh sembul sembung sembunyi sembur semburat semburit semecah semedera semejana semeleh sememeh semen semena semenanjung semenda semendarasa semenggah semenjak semenjana sementang sementara sementasi sementelah sementung semerawang semerbak semerdanta semesta semester semi semiang semidiurnal semifinal semifinalis semiidiom semikonduktor semilat seminai seminar seminari seminaris seminau semiologi semiotik semiotika semipermanen semir semitisme semivokal semok sempada sempadan sempak sempal sempalai sempana sempang sempat sempelah sempena sempil sempit semplak sempoyong sempoyongan semprit semprong semprot sempul sempur sempuras sempurna semrawut semringah semsem semu semua semunding semunian semur semut sen sena senak senam senamaki senandika senandung senang senangin senantan senantiasa senapan senapati senar senarai senario senat senator senawan senawar senawat senawi senda sendal sendalu sendam sendang sendar sendarat sendaren sendat sendawa sendayan sendayang sendel sendeng sender senderik senderung senderut sendi sending sendiri sendocong sendok sendon sendorong sendratari sendu senduduk senduk senen senewen seng sengaja sengal sengam sengangar sengangkar sengap sengar sengar-sengir sengarat sengaring sengat sengau sengelat senget senggak senggang senggara senggat senggau senggayut senggerahan senggeruk sengget senggiling senggol senggora senggugu senggugut sengguk senggulung senggut sengih sengingih sengir sengit sengkak sengkal sengkalan sengkang sengkar sengkarut sengkawang sengkayan sengked sengkedan sengkek sengkela sengkelang sengkelat sengkeling sengkelit sengkenit sengker sengketa sengkil sengkilit sengkuang sengkuap sengon sengsai sengsam sengsara sengsem sengsurit senguk sengungut sengut seni senigai senil senilitas seniman senin senior senioritas senja senjak senjang senjata senjolong senjong senohong senonoh senoyong sensasi sensasional sensibel sensibilitas sensitif sensitivitas sensor sensoris sensual sensualisme sensualitas sensur sensus senta sentada sentadu sentagi sentak sentaka sental sentali sentana sentap sentara senteng senter senterpor senti sentiare sentiasa sentigram sentil sentiliter sentimen sentimental sentimentalitas sentimentil sentimeter senting sentiong sentiung sentol sentong sentosa sentra sentral sentralisasi sentralistis sentrifugal sentripetal sentrum sentuh sentuk sentul sentung senu senuh senuk senunggang senur senyampang senyap senyar senyawa senyum senyur seok seolah-olah sep sepada sepah sepai sepak sepakat sepal sepala-pala sepam sepan sepanar sepandri sepang sepangkalan separasi separatis separatisme separbang sepasin sepat sepatbor sepatu sepeda sepedas sepegoh sepekuk sepel sepele sepeling sepen sepenuh seperah seperantu sepersi seperti sepesan sepet sepetir sepi sepih sepiker sepir sepit seples sepoi seprai seprei sepsis september septima septum sepuh sepuit sepuk sepukal sepul sepulih sepupu sepur seput sera serabi serabut serabutan seraga seragam serah serahi serai serak serakah seram serama serambi serampang serampin serampu serampuk seran serana seranah serandang serandau serandib serandung serang serangga serangguh seranggung serangkak serangsang serani seranograf seranometer seranta serap serapah serapat serasa serasah serasi serat seratah serati seratung serau seraumeter seraut serawak serawal seraya serba serbaada serbaakal serbabaru serbabisa serbadua serbaemas serbaguna serbah-serbih serbaindah serbak serbakeemasan serbakurang serbamacam serban serbaneka serbaputih serbarumah serbasalah serbasama serbasusah serbat serbausaha serbet serbi serbu serbuk serdadu serdak serdam serdang serdawa serdi serdih serealia serealin sereat serebral serebrospinal serebrum seregang sereh serembah-serembih seremban seremoni seremonial serempak serempet serempu serendah serendeng sereng serengam serengeh serengit serenjak serenjang serenta serentak serep seresin seret sergah sergam sergap sergut seri serial seriap seriat seriawan seribulan seriding serigading serigala serigunting serik serikat serikaya serimala serimpet serimpi serimpung serindai serindit sering seringai seringing seriosa serit serium serius serkah serkai serkap serkup serlah serling sermangin sermet sernak sero serobeh serobok serobot serografi seroja serok serologi serombong serompok serondeng serondok serondol serondong serong seronok seroplastik seropot serositas serosoh serot seroyong serpentina serpih sersan serse sersi serta sertifikasi sertifikat sertu seru seruak seruas seruda serudi seruduk serugah serugat seruh serui seruit seruk serul seruling serum serumat serumen serumpu serun serunai serunda serundang serundeng seruni serunjang seruntun serupih seruput seruru serut serutu seruyuk servis sesah sesai sesaing sesajen sesak sesal sesam sesamoid sesanti sesap sesar sesat sesawi sesenap seser sesi sesil sesira sesium sesoca sespan sestina sesuai sesuatu sesumbar set seta setabelan setagen setai setaka setakona setal setambun setan setana setang setangan setanggi setapak setaria setat setawar setebal seteger seteheng setek seteker setel setela seteleng setem setempel seten setenggar seter seteranah seteru setewel seti setia setiabu setiar setiga setik setin setinggi setip setir setirman setiwel setoka setokin setolop setom seton setop setoples setor setori setoter setra setrap setrat setren setreng setrik setrika setrimin setrip setruk setrum setrup setti setu setuil setum setung setup seturi seturu seudati sewa sewah sewaka sewal sewar sewat sewot sewu sfenoidal sferoid sferometer sfigmograf sfigmomanometer sfikmograf sfingofili sfingter sfinks sia siaga siah siak siakap siakon sial sialang sialit siam siamang sian sianamida sianang siang sianggit sianida sianometer sianometri sianosis siantan siap siap-sedia siap-siaga siapa siapuh siar siarah siarat siasat siat siau sibak sibar sibernetika sibilan sibir sibuk sibur sibusuk sice sicerek sida sidai sidamukti sidang sidat siderit sidi sidik siding sidomukti sidratulmuntaha siduga siduk sif sifat sifatullah sifer sifilis sifilobia sifiloid sifir sifon sigai sigak sigando sigap sigar sigaret sigasir sigenting siger sigi sigilografi sigma signifikan signifikansi signifikasi sigot sigung sih sihir sijik sijil sika sikah sikai sikak sikap sikari sikas sikat sikedempung sikeras sikik sikikih sikin sikit siklik siklis sikloid siklon sikloparafin siklotron siklus siksa siku sikudidi sikudomba sikut sil sila silabel silabis silabus silah silalatu silam silampukau silang silap silara silase silat silaturahmi silau silengah silet silih silik silika silikat silikon silikona silikosis silinder silindris silindroid silir silium silo silogisme silok silologi silometer siloptik silsilah silt silu siluet siluk siluman silungkang silvika silvikultur silvisida simak simalakama simalu simaung simbah simbai simbang simbar simbat simbion simbiosis simbiotis simbiou simbok simbol simbolis simbolisme simbukan simbur simetri simetris simfisis simfoni simifisis simile simpai simpak simpan simpang simpang-siur simpanse simpat simpati simpatik simpatisan simpel simpetal simping simpir simpleks simplifikasi simplistis simposium simpuh simpuk simpul simpur simtom simtomatis simtomatologi simulasi simulator simulfiks simultan simuntu sin sinaga sinagoga sinagoge sinambung sinanaga sinansari sinar sinatan sinau sinawar sindap sinden sinder sindeton sindikalisme sindikasi sindikat sindir sindrom sindur sineas sinekdoke sinektika sinema sinemapleks sinemaskop sinematik sinematograf sinematografi sinematografis sinemikrografik sineol sinepleks sinergi sinergis sinergisme sineskop sinestesia sinetron sing singa singahak singelar singga singgah singgan singgang singgasana singgel singgir singgit singgul singgung singit singkak singkang singkap singkat singkeh singkek singkil singkir singkong singkup singkur singlet singsat singse singset singsing singularis singulum singulun singunen sini sinis sinisme sinjang sinklin sinkonina sinkope sinkretis sinkretisasi sinkretisme sinkron sinkronis sinkronisasi sinkronisme sinode sinolog sinologi sinom sinoman sinonim sinonimi sinopsis sinoptis sinovia sinovial sinovitas sinovitis sinrili sinse sintagma sintagmatis sintaksis sintaktis sintal sintar sintas sinter sinterklas sintese sintesis sintetik sintetis sinting sintir sintonik sintren sintua sintuk sintulang sintung sinu sinuhun sinus sinusal sinusitis sinusoid sinyal sinyalemen sinyalir sinyo sinyokolas sio sioca siong siongka sip sipahi sipai sipangkalan sipat sipatung sipedas sipesan sipi sipil sipir sipit sipolan sipongang sipu sipulut siput sir sira sirah siram sirangkak sirap sirat siratalmustakim siraut sirene sirep siri siriasis sirib sirih sirik siring siringitis sirip sirkam sirke sirkol sirkuit sirkulasi sirkuler sirkumfiks sirkumfleks sirkus sirlak sirna sirokumulus sirop sirostratus sirsak siru sirup sirus sis sisa sisal sisalak sisi sisih sisik sisip sisir sista sistaltik sistem sistematik sistematika sistematis sistematisasi sistematisir sistemis sistemisasi sisterna sistitis sistole sistolik sisurut siswa siswi sit sita sitak sitat siter siti sitinggil sitir sitokrom sitolilis sitolisis sitologi sitoplasma sitrat sitrin sitrun situ situasi situasional situn situs siuh siuk siul siuman siung siur siut sivilisasi siwalan siwaratri siwer sizigi skafa skala skalanisasi skalar skalop skandal skandium skarifikasi skatola skatologi skedul skelet skema skematis skenario skene skeptis skeptisisme sketsa ski skiameter skiatika skilot skip skiping skisma skizofrenia skizoid sklerenkima sklerosis skleroterapi skolastik skolastikus skolastisi skolastisisme skombroid skop skopometer skor skorbut skorpio skors skorsing skrin skrining skrip skripsi skrobikulus skrotum skuadron skuas skuat skuos skuter slagorde slah slang slebor slendro sling slintat-slintut slip slof slogan smes smokel snob snobisme soak soal soang soarma soba soban sobat sobek sobok soda sodet sodium sodok sodomasosisme sodomi sodomia sodor soe sofa sofis sofisme sofistri sofitel soga sogan sogang sogo sogok sohar sohib sohor sohun soja sok soka sokah soker soket sokom sokong sol solah solak solang solanina solar solarimeter solder solek solempis solenoide solfatar solfatara solid solidaritas solider soliditas solilokui solinometer solipsisme solis soliter solo solois solok solokan solot solum solusi solvabilitas solven som soma somah somasi somatis somatomegali sombok sombol sombong sombrero someng somnambulis somnambulisme sompek sompeng somplak somplok sompoh sompok sompong sompret sonar sonata sonatina sondai sondanco sondang sondase sondek sonder sondok sondong soneta songar songel songgeng songket songkok songkro songong songsong sonik sono sonogram sonokeling sonor sonoran sontak sontek sontok sontoloyo sop sopak sopan sopek sopi sopir soporifik sopran sorak sorang sorban sorbet sore sorek soren sorgum sori sorog sorok sorong sorot sortir sosi sosial sosialis sosialisasi sosialisme sosialistis sosio sosio-kultural sosiobiolog sosiodemokrasi sosiodrama sosiokultural sosiolek sosiolinguistik sosiolog sosiologi sosiologis sosiometri sosionasional sosiopat sosis sositet sosoh sosok sosor soto sotoh sotong sotor soun sowan sowang soyak spageti spalasi span spanduk spaning sparing spasi spasial spasmodis spasmus spastik spatbor spatula spedometer spektakel spektakuler spektator spektograf spektogram spektrograf spektrogram spektrokimia spektrometer spektroskop spektrum spekuk spekulan spekulasi spekulatif spekulator speleologi spelter sperma spermaseti spermatid spermatofora spermatogenesis spermatosit spermatozoa spermatozoid spesial spesialis spesialisasi spesialistis spesies spesifik spesifikasi spesimen spidol spidometer spikul spil spina spion spionase spiral spiralisasi spirilum spirit spiritis spiritisme spiritual spiritualisasi spiritualisme spiritus spirometer spons sponsor spontan spontanitas spora sporadis sporangium sporofil sport sportif sportivitas spring sprint sprinter sputnik sputum sreg sregep srempet sri srigading srigunggu srigunting srikandi srikaya srimanganti sripah sripanggung sriti stabil stabilisasi stabilisator stabilitas stabilizer stadion stadium staf stafilitis stagnan stagnasi staking stalagmit stalagmometri stalaktit stalinisme stalon stamba stambon stambuk stambul stamen stamina stan standar standardisasi stanplat stanum stanza stapler staples start starter stasi stasioner stasis stasiun statis statistik statistika statistis stator status statuta statuter stearat stearin steatit steatosis stegodon steik stek steker stela steling stema stemma stempel sten stengun steno stenografer stenografi stenogram stensil step stepa stepler steradian stereo stereofoni stereofonik stereognosis stereograf stereografi stereoisomerisme stereokimia stereometri stereoskop stereotip stereotipikal steril sterilisasi sterilitas steroid steroidal sterol stetoskop stevador stibium stigma stigmata stik stiker stilbestrol stilir stilistika stilograf stimulan stimulans stimulasi stimulatif stimulator stimulus stipendium stipulasi stirena stoikiometri stok stokastik stoker stol stoliditas stolon stomata stomatitis stomatogastrik stomatoskop stop stoper stopkeran stopkontak stoples stori stormking strabotomi strata strategem strategi strategis stratifikasi stratigrafi strato stratokumulus stratopause stratopouse stratosfer stratum stratus streng streptokokus streptomisin stres striker strimin strip striptis stroberi strobila stroboskop stroke stromking strontium struktur struktural strukturalisasi strukturalisme struma studen studi studio stuko stupa sua suah suai suak suaka suam suami suaminda suang suangi suap suar suara suarang suargaloka suari suasa suasana suat suatu sub subak subal subam suban subang subbab subbagian subdirektorat subentri suberat suberin subetnik subfilum subgeneralisasi subgenus subhana subhanallah subhat subirigasi subjek subjektif subjektivisme subkategorisasi subkelas subklas subkontraktor subkultur sublema subletal sublim sublimasi sublimat submarine submukosa subordinasi subordinat suborganisasi subsider subsidi subskrip subsonik substandar substansi substansial substantif substitusi substitutif substrat subtil subtonik subtropik subuco subuh subunit subur subversi subversif subyek subyektif subyektivisme suceng suci suda sudah sudet sudi sudip sudoriferus sudra sudu suduayah suduk sudung sudut suf sufah sufal sufi sufiks sufisme sufrah sugar sugesti sugi sugih suguh sugul sugun suh suhad suhian suhu suhuf suhun suiseki suit sujana sujen suji sujud suka sukacita sukade sukamandi sukan sukar sukarela sukaria sukat sukduf suke suket suki suklapaksa sukma sukrosa sukses suksesi suksesif suku sukuisme sukun sula sulah sulalah sulalat sulam sulang sulap sulat-sulit sulbi sulfanasi sulfanilamida sulfat sulfhidril sulfolipid sulfonamida sulfur sulfurasi suli sulih suling sulit sultan sultanat sultani suluh suluk sulung sulur sulut sum sumah sumarah sumare sumasi sumba sumbang sumbangsih sumbar sumbat sumbel sumber sumbi sumbing sumbu sumbuk sumbul sumbung sumbur sumbut sumeh sumengit sumilir sumir sumirat sumo sumpah sumpal sumpek sumpel sumping sumpit sumsum sumur sumurung sun sunah sunam sunan sunat sunatullah sunbulat sundai sundak sundal sundang sundari sundep sunduk sundul sundus sundusin sundut sungai sungga sunggi sungging sunggit sungguh sungguhpun sungil sungkah sungkai sungkal sungkan sungkap sungkawa sungkem sungkit sungkuk sungkum sungkup sungkur sungkuran sungsang sungu sungut suni sunjam sunti suntiabu suntih suntik sunting suntuk sunu sunukung sunyata sunyi sup supa supai supaya supel super superblok supercepat superfisial superfosfat superheterodin superinfeksi superintenden superior superioritas superjet superkomputer superkonduktivitas superkonduktor superlatif superlativisme superlunar supermarket supermen supernatural supernova superskrip superskripsi supersonik superstar superstruktur supervisi supervisor suplai suplemen suplementasi suplesi supletoar suporter suportif supra supraalami suprafiks supramolekuler supranasional suprarene suprarenoma suprasasti suprasegmental supremasi supresif supresor surah surahi surai suralaya suraloka suram surat surati surau suraya surealis surealisme suren surfaktan surga surgaloka surgawi suri surian surih surili surjan surogat surplus suruh suruk surup surut survei surya suryakanta suryani sus susah susastra suseptibilitas susila susilat suspender suspensi suster susu susuh susuk susul susun susung susup susur susut sut sutan suten sutil sutra sutradara sutura suul suun suuzan suvenir suwarnabumi suwarnadwipa suwir suwita svedberg swa swabakar swabela swadana swadarma swadaya swadesi swadidik swadisiplin swagriya swahara swaharga swaimbas swak swakaji swakarsa swakarya swakelola swakendali swakontradiksi swalayan swanama swanggi swapraja swarabakti swasembada swasensor swasraya swasta swastanisasi swastiastu swastika swatabur swatantra swausaha sweter swike swimpak swipoa syabah syabas syafaat syafakat syafii syah syahadat syahadatain syahbandar syahda syahdan syahdu syahid syahriah syahsiah syahwat syair syairi syajar syajarah syak syaka syakban syakduf syakhsi syakir syal syala syam syamali syaman syamanisme syamsi syamsiah syamsir syamsu syantung syar syarab syarah syarak syarat syarbat syarekat syariat syarif syarifah syarik syarikat syatar syaulam syawal syeir syekh syeti syiar syikak syin syirik syiwa syiwaratri syogun syok syubhat syuhada syukur syumuliah syur syura syurah syuriah syuruk syuting taajul taala taaruf taasub taat taawud taazur tabah tabak tabal taban tabar-tabar tabarak tabaruk tabayun tabe tabel tabela tabelaris tabernakel tabia tabiat tabib tabii tabiin tabik tabir tablet tablig tablo tabloid tabo tabok tabrak tabu tabuh tabuhan tabula tabulasi tabulator tabulatur tabun tabung tabur tabut tabzir taci tadabur tadah tadaruk tadarus tadbir tadi tadir tadung tadwin taekwondo taeniasis taf tafadal tafahus tafakur tafeta tafsir tagak tagal tagan tagar tageh tagih tago tagut tahajud tahak tahal tahalul tahan tahana tahang tahap tahar taharah tahbis tahi tahiat tahil tahir tahkik tahkim tahlil tahmid tahniah tahnik tahsil tahu tahun taib taifun taiga taiko taipan tais taiso taja tajak tajali tajam tajarud tajau tajdid tajen taji tajin tajnis tajribah taju tajuk tajung tajur tajusalatin tajwid tak takabur takaful takah takak takal takang-takik takar takarir takarub takat takbir takbiratulihram takdim takdir takdis takeh takel takeyari takhayul takhlik takhsis takhta taki takigrafi takik takimeter takir takisme takjil takjub taklid taklif taklik taklikat taklim taklimat takluk takma takmurni takoah takol takometer takraw takrif takrim takrir taksa taksasi taksem taksi taksidermi taksimeter taksin taksir taksologi takson taksonomi taktik taktil taktis takuh takuk takung takur takut takwa takwil takwim takwin takyin takziah takzim takzir tal tala talabiah talah talak talam talang talar talas talasemia talasofit talbiah talek talempong talen talenan talenta tali talib talibun talium talk talkin talon talu talun talupuh talut tam tamadun tamah tamak tamam taman tamar tamarinda tamasya tamat tamatulkalam tambah tambak tambakan tambal tamban tambang tambar tambat tamber tambera tambi tambo tamborin tambuh tambul tambun tambung tambur tambus tameng tamimah tampah tampak tampal tampan tampang tampar tampas tampel tampi tampik tampil tampin tamping tampon tamponade tampuk tampung tampus tamsil tamtam tamtama tamu tamuk tamyiz tan tanah tanai tanak tanam tanang tanau tanazul tanbiat tanbihat tancang tancap tanda tandak tandan tandang tandas tandem tandik tandikat tandil tanding tandon tandu tanduk tandun tandur tandus tanfiziah tang tangan tangap tangar tangas tangeh tangen tangga tanggah tanggal tanggam tanggang tanggap tanggar tanggetong tangguh tangguk tanggul tanggulang tanggung tangis tangkah tangkai tangkaian tangkal tangkap tangkar tangkas tangki tangkil tangkis tangkue tangkuk tangkul tangkup tangkur tangkut tanglung tango tangsa tangsel tangsi tani tania tanin tanjak tanji tanjidor tanju tanjul tanjung tanjur tank tanker tanpa tansi tantang tante tanti tantiem tantrisme tanur tanwin tanwir tanwujud tanya tanzih tanzil taocang taoci taoco taoge taoisme taoke taosi tap tapa tapai tapak tapal tapang tapestri tapi tapih tapin tapioka tapir tapis taplak taprofit taptibau taptu tapui tapuk tapung tapus tar tara taraf tarah tarak taraksasin taram tarang tarantisme tarantula tarap taraqi tarasul tarawangsa tarawih tarbiah tarbil tarbus tarcis tarekat target tarhim tari tarif tarik tarikat tarikh taring taris tarjih tarkas tarling tarmak tarpaulin tarsus tartar tartil tartir tartrat taruh taruk taruko tarum tarung tarup tarzan tas tasa tasai tasak tasalsul tasamuh tasaruf tasawuf tasbeh tasbih tasdik tasel tashih tasik taslim tasmik tasrif tasrih taswir tasyahud tasyakur tasyaum tasyayuh tasybih tasydid tasyhid tasyrih tasyrik tata tataganing tatah tatai tatak tatal tatami tatanan tatang tatap tatar tatih tating tatkala tato tau taubat taucang taufah taufik tauhid tauhidiah tauke taul tauliah taun taung taur taurat tauret taurus taut tautofoni tautologi tautomerisme tautonimi tautonomi tawa tawadu tawaduk tawaf tawajuh tawak tawakal tawan tawang tawar tawarik tawaruk tawas tawasul tawes tawon tawur tayamum tayang tayib tayibah tayub tayum tazkirah tean teater teatris tebah tebak tebal teban tebang tebar tebas tebat tebeng teberau tebing tebok tebon tebu tebuhar tebuk tebung tebus tedak tedarus tedas tedeng tedong teduh tedung tefrit tega tegah tegak tegal tegang tegap tegar tegari tegarun tegas tegel tegil tegmen teguh teguk tegun tegur teh teisme teja teji teka tekaan tekad tekah tekak tekalak tekam tekan tekang tekap tekar tekat tekek tekel teken teker teki tekidanto tekik tekis teklek teklok teknifon teknik teknikus teknis teknisi teknokrasi teknokrat teknokratik teknokratisme teknologi teknonim teknonimi teko tekoan tekoh tekokak tekong tekor tekoran tekpi teks tekstil tekstur tekstural tekte tektek tektit tektogenesa tektonik tektonis tektum tekua tekuk tekukur tekun tekung tekur tel tela telaah telabang telabat telacak teladan teladas telaga telah telajak telak telakup telampung telan telancang telang telangkai telangkup telanjang telanjur telantar telap telapak telas telat telatah telatap telaten telau tele telearsika teledek teledor teledrama telefon telefoni telefoto telegenik telegraf telegrafi telegrafis telegram telegrap telekan telekap telekinesis telekomedi telekomunikasi teleks teleku telekung telelensa telemeter telemetri telempap telempong teleng telenovela telentang teleologi teleost telepati telepok telepon teleprinter telepromter telepuk teler telerang teles teleskop telestesia televisi telgram telik telikung telimpuh telinak telinga telingkah telingkung teliti telmotofit telop telor telotak telpon teluh teluk teluki telungkup telunjuk telur telurat telurit telus telusuk telusur telut telutuh telutur telutut tem tema temaah temabur temaha temahak temak temalang temali teman temangau temangga temanten temara temaram temas tematik tematis tematisasi temayun tembadau tembaga tembak tembakang tembakau tembakul tembam tembang tembarau tembatar tembatu tembek tembekar tembel tembelang tembelian tembeliung tembelok tembem tembera temberam temberang temberas temberek tembereng temberih temberos tembesu tembiang tembikai tembikar tembilang tembilar tembiring tembis tembok tembolok tembong tembosa tembra tembu tembuk tembuku tembung tembuni tembus tembusu temegun temeh temengalan temenggung temenung temesar temetu temiang temilang temin temokus temoleh tempa tempah tempala tempan tempang tempap tempat tempaus tempawak tempawan tempayak tempayan tempayung tempe tempek tempel tempelak tempeleng temperamen temperamental temperas temperatur temperau tempiar tempias tempik tempilai tempinah tempinis templek templok tempo tempoh tempolong temponek tempong temporal temporer tempoyak tempoyan tempua tempuh tempui tempuling tempunai tempunik tempur tempurung tempus tempuyung temu temucut temukut temungkul temuras temurat temut-temut tenaga tenahak tenak tenam tenang tenar tenat tenda tendang tendas tendensi tendensius tender tendinitis tendo tendon tener teng tengadah tengah tengak tengalan tengar tengara tengas tenggadai tenggak tenggala tenggalung tenggan tenggang tenggara tenggarang tenggat tenggayun tenggayung tenggehem tenggek tenggelam tengger tenggiling tenggiri tenggiring tenggok tenggorok tengguli tengik tengil tengkalak tengkalang tengkaluk tengkam tengkang tengkar tengkarah tengkarap tengkaras tengkawang tengkek tengkel tengkelek tengker tengkerong tengkes tengking tengkoh tengkolok tengkorak tengku tengkujuh tengkuk tengkulak tengkuluk tengkurap tengkuyung tengok tengteng tengu tenis tenjet tenok tenong tenor tensi tentakel tentamen tentang tentara tentatif tentawan tenteng tenteram tentir tentu tenuk tenun tenung teodolit teokrasi teokratis teolog teologi teologis teoretikus teoretis teori teorisasi teosofi teosofis tepa tepak tepam tepas tepat tepeh tepek tepekong teperam tepes tepet tepi tepik tepis teplok tepo tepok tepos teptibau tepu tepuk tepung tepurang tepus ter tera teracak terada terajam teraju terak terakota terakup teral terala terali teraling teramisin terampil teran teranas terang terap terapang terapeutik terapi terarium teras terasi teraso terasul teratai teratak teratap teratologi teratu terau terawang teraweh terban terbang terbis terbit terbium terbul terbut terein terem terenang terendak terenen terentang terenyuh teres teret teretet teri teriak teriba terigu terik terika terikit teriko terima terin terindil tering teripang terista teritih teritik teritip teritis teritorial teritorium teriujung terjal terjang terjemah terjun terka terkadang terkam terkap terkul terkup terlak terlalu terlut term termaestesia termal termin terminal terminasi terminografi terminologi termion termionika termistor termodinamika termodinamis termoelektris termoelektrisitas termofili termofilik termofosforesens termograf termogram termohigrograf termokimia termoklin termolabil termolisis termolistrik termoluminesens termometer termonuklir termoplastik termos termosfer termostat terna ternak terobos terok teroka terombol teromol terompah terompet terondol terong terongko teropong teror teroris terorisme terowongan terpa terpal terpana terpedo terpentin tersier tertawa tertib terubuk terubus terucuk terucukan teruk terum terumba terumbu terumbuk teruna terung terungku teruntum terup terus terusi terwelu terzina tes tesaurus tesis tesmak testa testamen tester testes testikel testimonium testing testis testosteron teta tetak tetal tetampan tetamu tetangga tetanus tetap tetapi tetar tetas teteguk teteh tetek tetelan tetelo teter teterapan tetes tetibar tetibau tetikus tetirah tetiron tetoron tetra tetrahidrokanabinol tetrahidron tetraklorida tetraploid tetris tetua tetuang tewas teyan tezi theta tiada tiaga tiam tian tiang tiangui tiap tiara tiarap tib tiba tiban tidak tidur tifa tifus tiga tigari tigas tihul tijaniah tik tika tikai tikam tikar tikas tike tiket tikim tikpi tikung tikus tilam tilan tilang tilap tilas tilawah tilde tilik tim timah timang timarah timba timbal timbang timbau timbel timbil timbo timbre timbrung timbuk timbul timbun timburu timbus timi timol timpa timpal timpang timpani timpanitis timpanum timpas timpuh timpuk timpus timu-timu timun timur timus tin tindak tindan tindas tindawan tindih tindik tindis tiner ting tinggal tinggam tinggi tinggung tingi tingkah tingkal tingkalak tingkap tingkar tingkarang tingkarung tingkas tingkat tingkeb tingkis tingkrang tingkuh tingting tingtong tingtur tinja tinjak tinjau tinju tinta tinting tintir tinulat tip tipar tipe tipi tipikal tipis tipograf tipografi tipologi tipologis tipu tir tirah tirai tirakat tiram tiran tirani tiras tiraton tirau tiri tiris tirkah tiroid tiroiditis tiroksin tirta tiru tirus tis tisik tisotropi tisu titah titanium titar titel titer titi titik titilasi titimangsa titinada titip titir titis titisara titit titrasi titrimetri tituler tiung tiup tiwah tiwikrama tiwul tmesis toapekong toas tobak tobang tobat toblos toboh tobong tobralko todak todong toga togan toge togel togok toh tohok tohor toilet tok tokak tokcer toke tokek toko tokoh tokok tokong toksemia toksikogenik toksikolog toksikologi toksin toktok tol tolak tolan tolap toleh toleran toleransi tolerir tolok tolol tolong toluena tom toman tomang tomat tombak tomboi tombok tombol tombola tombong tombru tomong tompel ton tona tonase tonem tonetika tong tonggak tonggara tonggek tonggeret tonggok tonggong tonggos tongkah tongkang tongkat tongkeng tongkol tongkor tongkrong tongol tongong tongpes tongsan tongseng tongsit tongtong tonik tonikum tonil tonis tonisitas tonit tonjok tonjol tonometer tonsil tonton tonus top topan topang topas topdal topek topeng topes tophit topi topiari topik topikalisasi topikalitas topo topografi topografis topong toponimi torak toraks torani toreh torek tores torida torium tornado torne toro torpedo torpedor torsi torso tortor torus tos tosan toserba total totalisator totalitas totaliter totaliterisme totau totem totemisme totemproparte totok totol towel toya toyor tra trabekula tradisi tradisional tradisionalisme trafo tragedi tragikomedi tragis tragus trailer trakeid trakom traksi traktasi traktat traktir traktor traktus trama trampolin trans transaksi transduksi transek transeksual transenden transendental transfer transfigurasi transformasi transformasionalis transformatif transformator transfusi transgenik transisi transistor transit transitif transkrip transkripsi translasi transliterasi translokasi translusens transmigran transmigrasi transmisi transmiter transmogrifikasi transmutasi transnasional transonik transparan transparansi transpirasi transplantasi transpor transportasi transposisi transversal transvetisme trap trapesium trapezoid tras trauler trauma traumatis travesti trawler trayek trek trekbal trem trema trematoda trembesi tremer tremor tren trendi trengginas trenyuh tres tresna tri trias triatlon tribokelistrikan tribologi tribrata tribunal tribune tributa trica tridarma tridentat trienial triennale trifoliat triftong trigatra trigemius trigliserida trigonometri trigraf trihidrik trik triko trikotomi trikuspid tril trilateral trilingga trilipat triliun trilogi trilomba trim trimatra trimurti trinil trinitas trinitrotoluena trio triode trip tripartit tripleks triplet triplik tripod triprasetia trips tripsin tripsinogen triptofan triptotos trisep trisula tritunggal triturasi triumvirat trivalen trivialitas triwangsa triwindu triwulan trofi trofoblas troi troika trokanter trokea troli trombin trombon trombosis trombosit trombus tromol trompet trompong tropik tropika tropis tropisme tropopause troposfer tropus tros trotoar trubadur truf truk truntum trusa tsar tsunami tsuru tua tuah tuai tuak tual tuala tualang tuam tuan tuang tuangku tuanku tuap tuar tuarang tuas tuba tubagus tuban tube tubektomi tuberkulosis tubi tubin tubir tubruk tubuh tuding tuduh tudung tufa tufah tugal tugar tugas tugi tugu tugur tuhan tuhfah tuhfahlulajnas tuhfahtulajnas tuhfat tuhmah tuhu tuidi tuil tuit tujah tuji tuju tujuh tujul tuk tukai tukak tukal tukam tukang tukar tukas tukik tukil tukmis tuksedo tuku tukuk tukul tukun tukung tukup tula tulah tulak tulang tular tulat tule tulen tuli tulis tulium tulu tulup tulus tum tuma tuman tumang tumbakan tumbal tumbang tumbas tumben tumbu tumbuh tumbuk tumbung tumenggung tumika tumis tumit tumor tumpah tumpak tumpal tumpang tumpang-tindih tumpas tumpat tumpeng tumper tumpil tumplak tumplek tumpu tumpuk tumpul tumpur tumtam tumu tumungkul tumus tun tuna tunaaksara tunabusana tunadaksa tunaganda tunagizi tunagrahita tunai tunak tunakarya tunalaras tunam tunan tunanetra tunang tunapolitik tunarungu tunas tunasosial tunasusila tunatenaga tunawicara tunawisma tunda tundan tundang tundra tunduk tundun tundung tung tungau tunggak tunggal tungganai tunggang tunggik tungging tunggu tunggul tungkahan tungkai tungkak tungkap tungku tungkul tungkup tungkus tungro tungsten tungu tunik tunjal tunjam tunjang tunjuk tunjung tuntas tuntun tuntung tuntut tunu tupai tur tura turang turangga turap turas turba turbiditas turbin turbogenerator turbojet turbulen turbulensi turfat turgor turi turiang turinisasi turis turisme turistik turkuois turmalin turnamen turne turnoi tursi turun turus turut tus tusam tusir tuslah tustel tusuk tuter tutor tutorial tuts tutu tutuh tutuk tutul tutung tutup tutur tutut tuwuhan tuwung tuyuk tuyul uai uak uan uanda uang uap uar uba ubah uban ubang ubar ubat ubek ubel uber ubet ubi ubikuitas ubin ubit ubrak-abrik ubub ubudiah ubun-ubun ubung ubur-ubur ubyang-ubyung ucap ucek uci-uci ucis ucok ucu ucus uda udak udam udang udani udap udar udara ude udek udel udeng udet udi udik udim udo udu uduh uduk udut uek ufti ufuk ugahari ugal-ugalan ugem uger uget-uget ugut uhu uih uik uir-uir uis uit ujana ujang ujar uji uju ujub ujud ujuk ujul ujung ukas ukhrawi ukhuwah ukik ukir uktab ukulele ukup ukur ula-ula ulah ulak ulam ulama ulan ulang ulang-alik ulang-aling ulap-ulap ular ulas ulat ulayah ulayat ulek ulem ulen ules ulet uli ulik ulin uling ulir ulit ulna ulos ultima ultimatum ultimo ultimogenitur ultra ultrafilter ultramarin ultramikroskopik ultramikroskopiks ultramodern ultrasonik ultrasonika ultrasonografi ultraungu ultraviolet ulu uluk ulun ulung ulup ulur uma umak uman umang-umang umara umat umbai umbalan umban umbang umbang-ambing umbar umbara umbi umbilikus umbin umbisi umbo umbra umbu umbuk umbul umbur-umbur umbut umi umlaut umpak umpama umpan umpat umpet umpil umpuk umpun umput umrah umu umuk umum umun umur unam uncang uncang-uncit uncit uncu uncue uncui unda undagi undak undan undang undi unduh unduk-unduk undung-undung undur unek-unek ungah-angih ungam ungar unggah unggal unggang-anggit unggas unggat-unggit unggis unggit unggul unggun unggut ungka ungkah ungkai ungkak ungkal ungkap ungkat ungkau ungkil ungkir ungkit ungkul ungkur ungsi ungti ungu unguis uni uniat unifikasi uniform uniformitas unik unilateral unilineal unilinear union uniseks uniseluler unit unitaris unitarisme univalen universal universalia universalisme universalitas universiade universitas universiter universitet universum unjuk unjun unjung unjur unjut unsur unsuri unta untai untal untang-anting unti until unting untir untuk untun untung untut unun unyai upa upaboga upacara upaduta upah upajiwa upak upakara upakarti upam upan upanishad upar upau upawasa upaya upet upeti upih upik upil upsilon ura-ura uraemia urah urai urak urakus uran-uran uranisme uranium uranologi uranus urap uras urat urban urbanisasi urbanisme urdu urea uremia ureter uretra uretritis urgen urgensi uri urian uribang urik urinalisis urine uring urinoar urinometer urip uris urit urita uritan urna urolog urologi uroskopi uruk urun urung urup urus urut usada usah usaha usai usak usali usam usang usap usar usat user-user usia usik usil usir uskup usrek ustad ustaz ustazah usuk usul usuluddin usung usur usus usut uswah utak-atik utama utang utar-utar utara utarid utas uterus utih utik utilitas utopia utopis utopisme utrikel utrolokal utuh utus uvula uvular uwar uwungan uwur uyuh uzlah uzur vagina vak vakansi vakasi vakatur vakbon vaksin vaksinasi vakum vakuol vakuola valas valensi valentin valentine valeria valid validitas valis valium valorisasi valuta vampir vanadium vandal vandalisme vandalistis vandel vanili varia variabel variabilitas varian variansi variasi variatif varietas variola varises vas vasal vasektomi vaselin vaskular vaskularisasi vaskuler vaskulum vasodilasi vasodilator vasomotor vatikan vaucer vedda veddoid veem vegetarian vegetarir vegetaris vegetarisme vegetasi vektor velamentum velar velarisasi velodrom velositas velum vena venal venalitas vendeta vendor ventilasi ventilator ventrikel ventrikulus venus verba verbal verbalisan verbalisasi verbalisme verbalistis verbatim verbena verdigris verifikasi verifikatur veritisme verkoper vermilium vermiliun vermiseli vermisida vernis veronal verset versi verso verstek versus vertebra vertebrata vertikal verzet vespa veste vestibul vestibula vestibulum vet veter veteran veterinarian veteriner vetiver veto vetsin via viabel viabilitas viaduk vibran vibrasi vibrator vibrio vide video videofon videoklip videoteks vigia vigili vignet vikariat vikaris vila vinil vinyet viol viola violces violet violin violinis violis virga virginia virgo virilis virilisme virilitas virilokal virologi virtual virtuoso virulen virulensi virus visa visera visi visibel visibilitas visioner visitasi visitator visiun viskometer viskose viskositas vista visual visualisasi visum visus vitakultur vital vitalitas vitamin vitelin vitiligo vitreositas vitrifikasi vitriol vivarium vivifikasi vivipar vla vlek voal vodka vokabularium vokabuler vokal vokalia vokalis vokasional vokatif vokoid volatil volatilitas voli volt voltameter voltase volume volumeter volumetri volunter vonis vopo vorteks voting votum vrah vrahoto vulgar vulgata vulger vulkan vulkanis vulkanisasi vulkanolog vulkanologi vulkavit vulpen vulva vulvektomi vuring waad waadat wabah wabakdu wabarakatuh wacana wadah wadak wadal wadam wadat wadi wadon waduh waduk wadung wafa wafak wafat wage wagon wagu wah wahah wahai waham wahana wahdah wahdaniah wahdiah wahib wahid wahyu wai waid waima waisak waisya waitankung wajah wajan wajar wajib wajik wak wakaf wakil waktu wakun wakwak walabi walad walafiat walah walak walakhir walakin walang walangkopo walango walat walau walaupun waledan waleh walet walhal walhasil wali walikukun walimah walimana waliullah wallahi wallahualam wals waluh waluku wambrau wan wana wanara wanawisata wanda wandu wang wangi wangkang wangsa wangsit wani wanita wanodya wantah wantek wanti-wanti wantilan wara warak warakawuri waralaba warangan waranggana warangka waras warasah warawiri wardi warga wari waria warid waringin waris warita warkat warna warna-warni warok warsa warta waru waruga waruna warung warwar wasahlan wasak wasal wasalam wasangka wasi wasiat wasilah wasir wasit wasitah waskita waskom waslah waslap waspada wastafel waswas watak watan watang watas watase watermantel watermark waterpas waterpruf watt watu wau wawa wawancara wawanmuka wawanrembuk wawas wawu wayang wayuh wazari wazir weda wedam wedana wedang wedani wedar wede wedel weduk wegah weh weharima wejang wekel weker welahar welas weling welirang welit welter welut wenang wenter werak werangka werda werdatama were werek wereng werit werst wese wesel weselbor wesi wesket westernis westernisasi wet wetan weton wewarah wewaton wewe wibawa wicaksana wicara widi widiaiswara widiwasa widodari widoro widuri widyaiswara widyawisata wig wigata wihara wijaya wijayakusuma wijayamala wijayamulia wijdaniah wijen wiji wijik wikalat wiku wiladah wilah wilangon wilayah wilis wilwatikta wimana winaya windu wing wingit winglet winter wira wirabank wiracarita wiraga wirakarya wirang wiraniaga wirasuara wiraswasta wirid wiru wisa wisal wisata wisaya wisesa wisik wiski wisma wisnu wisuda witir wiwaha wiweka wiyaga wiyata wizurai wodka wol wolanda wolfram wombat won wong wora-wari wortel wosi wotogal-agil wrang wreda wredatama wregu wrisaba wudani wudu wuduk wujud wuker wukerar wuku wukuf wulan wulang wulu wulung wungon wungu wuwungan xantat xantena xantofil xenia xenofili xenofobia xenoglosia xenograf xenokrasi xenolit xenomania xenon xerofil xerofit xeroftalmia xerografi xerosis xifoid xilem xilena xilofon xilograf xilografi xiloid xiloidina xilol xilologi xilonit xilosa yad yahud yahudi yahudiah yahwe yais yaitu yakin yakis yakitori yakjuj yakni yaksa yakun yakut yamtuan yang yantra yard yargon yasan yasmin yasti yatim yaum yaumudin yaumulakhir yaumulaza yaumuljamak yaumuljaza yaumulkiamah yaumulmahsyar yayasan yayi yayu yehova yel yen yeyunum yodium yoga yoghurt yogi yogia yohimbina yojana yokal yolk yos yosong yoyo yubileum yuda yudikatif yudisial yudisium yudo yudoka yuk yunani yunda yunior yunta yupa yupiter yura yuran yuridis yuris yurisdiksi yurisprudensi yustisi yute yuvenil yuwana yuwaraja yuyitsu yuyu zabah zabaniah zabarjad zabib zabur zadah zahid zai zaim zair zaitun zakar zakat zakelek zakiah zakum zal zalim zalir zaman zamin zamindar zamrud zamzam zan zanggi zantara zarafah zarah zaratit zariah zariat zat zatua zawal zawiat zebra zebu zelot zen zend-avesta zendeling zending zeni zenit zeolit zeoponik zero zet zeta ziadah ziarah zib zig-zag zigomorf zigot zikir zilullah zimase zimi zimogen zimolisis zimosis zimotik zimurgi zina zindik zink zinkografi zion zionis zionisme zirafah zirah zirbad zirkonia zirkonium zirnikh ziter zodiak zoetrop zohal zohrah zohrat zona zonasi zonder zone zoning zoofit zoofobia zoogani zoogeografi zoologi zoonosis zoosemiotika zuama zuhud zuhur zulfikar zulhijah zulkaidah zulmat zulu zurafah zuriah zus `
\ No newline at end of file
+var data = `aba abad abadi abadiah abah abai abaimana abaka abaktinal abakus abal-abal aban abang abangan abangga abar abatoar abau abdas abdi abdikasi abdomen abdominal abdu abduksi abduktor abece aben aberasi abet abian abid abidin abilah abing abiogenesis abiosfer abiotik abis abisal abiseka abiturien abjad abjadiah ablasi ablaut ablepsia abnormal abnormalitas abnus aboi abolisi abon abonemen abong-abong aborsi abortif abortiva abortus abrak abrakadabra abrar abras abrasi abreaksi abrek abreviasi abrikos abrit-abrit abrosfer absah absen absensi absensia absente absenteisme abses absis absolusi absolut absolutisme absonan absorb absorben absorbir absorpsi absorpsiometer absorptif abstain abstinensi abstrak abstraksi absurd absurdisme abtar abu abuan abuh abuk abulhayat abulia abun-abun abur abus abyad acah acak acala acan acang acap acar acara acaram acat acau acawi acerang aci acik aco acu acuh acum acung ada adab adad adagio adagium adakala adakalanya adakan adaks adaktil adalah adalat adam adan adang adap adaptabel adaptabilitas adaptasi adaptif adaptometer adaptor adapun adar adas adat adati adegan adeh adekuat adem adempauze adendum adenoid adenoma adenosis adhesi adhesif adi adiabatik adiabatis adiaktinik adib adibangkit adibintang adiboga adibusana adicita adidaya adigang adiguna adigung adik adika adikara adikarya adikodrati adikong adiksi adiktif adikuasa adil adiluhung adimarga adinda ading adipati adipenghantar adiposa adipositas adipura adiraja adiratna adisi adisional adisiswa aditif aditokoh adiwangsa adiwarna adiwidia adjektif adjektiva adjektival adjuvan administrasi administratif administrator admiral admisi admitans adnan adolesens adon adopsi adoptif adpertensi adrenal adrenalin adrenergik adres adsorben adsorpi adsorpsi adstringen adu aduh aduhai aduk aduksi aduktor adun adveksi adven adventisia adventisius adverbia adverbial advertensi advis advokad advokasi advokat aerasi aerator aerob aerobatik aerobik aerobika aerodinamik aerodinamika aerofisika aerofisiologi aerofon aerogram aerolit aerologi aerometer aeromovel aeronautika aeroplangton aeroplankton aeroskop aerosol aerostat aerostatika aestetika afair afal afasia afdal afdeling afdruk afek afeksi afektif aferesis afiat afidavit afiks afiksasi afiliasi afinitas afirmasi afirmatif afonia aforisme afotik afrasia afrikat afrit afrodisiak afsun afwah aga agah agak agak-agih agal agalaksia agam agama agamais agamawi agamen agamet agami agamogenesis agan agape agar agas agen agenda agens agentif agih agil agio agiria agitasi agitatif agitator aglikon aglomerasi aglomerat aglutinasi aglutinat aglutinatif aglutinin agnosia agnostik agnostisisme agogo agometer agon agonia agonis agonistik agorafobia agradasi agrafia agraria agraris agregasi agregat agregatif agresi agresif agresivitas agresor agriologi agripnia agrisilvikultur agrobis agrobisnis agroekonomi agroekosistem agrogeologi agroikos agroindustri agrokimia agronom agronomi agrostologi agrowisata aguk agul agun agung agus agustus agut ahad ahadiat ahimsa ahistoris ahkam ahlan ahli ahlulbait ahlulkitab ahlulkubur ahlunujum ahlusuluk ahlusunah ahmak ahmar aho ahsan ahwal aib ain ainulbanat ainulyakin ainunjariah air aja ajab ajaib ajaibkhanah ajak ajal ajang ajar ajek ajektifa ajektiva ajengan aji ajigineng ajimumpung ajir ajisaka ajnabi ajnas ajojing ajre aju ajudan ajufan ajujah ajuk ajun ajung ajur ajuster akad akademi akademik akademikus akademis akademisi akaid akak akal akan akang akapela akar akas akasia akatalepsia akbar akene akeo akh akhbar akhir akhirat akhirulkalam akhlak akhlaki akhwan aki akibat akidah akik akikah akil aklamasi aklasia akli akliah aklimasi aklimatisasi akmal akolade akomodasi akomodatif akor akordeon akrab akrawati akreditasi akriflavina akrilat akrilik akrobat akrobatik akrofobia akromegali akrometer akromion akronim aksa aksara akseleran akselerasi akselerator akselerometer aksen aksentologi aksentuasi aksep akseptabel akseptabilitas akseptasi akseptor akses aksesibilitas aksesori aksi aksidental aksila aksiologi aksioma aksiomatis aksis akson aksostil akta aktentas aktif akting aktinida aktinik aktinisme aktinium aktinokimia aktinolit aktinometer aktiva aktivis aktivisme aktivitas aktor aktris aktual aktualisasi aktuaria aktuaris aku akua akuades akuaduk akuakultur akualung akuamarin akuan akuarel akuaris akuarium akuarius akuatik akuifer akuisisi akuk akulturasi akumulasi akumulatif akumulator akun akuntabel akuntabilitas akuntan akuntansi akupungtur akupungturis akupunktur akur akurasi akurat akusatif akustik akustika akut akwal ala alabangka alabio alaf alai alai-belai alaihisalam alaika alaikum alak alalia alam alamah alamak alamanda alamas alamat alamatulhayat alami alamiah alamin alan-alan alang alangkah alantois alap alarm alas alat alau alawar alazon albanat albas albatros albedo albedometer albinisme albino albinoid albit albuginea album albumen albumin albuminoit albuminometer albuminuria aldehida alegori alegoris aleksandrit aleksia aleksin alel alem alergen alergi alergis alf alfa alfabet alfabetis alfaktorius alfanumerik alferes alga algilik algoid algojo algologi algometer algoritme algrafi alhamdulillah alhasil ali aliansi alias aliase alibi alienasi alif alifatik alifbata alih alihragam alik alikuot alim alimbubu alimentasi alimenter alimiah alimiat alimun alin alinea aling alip alir alis alisiklik alit aliterasi alizarin aljabar aljalil alkabir alkadim alkadir alkah alkali alkalinitas alkalis alkaloid alkalometri alkamar alkana alkari alkasyaf alkausar alkena alkil alkimia alkisah alkitab alkohol alkoholis alkoholisasi alkoholisme alkoholometer alku alkuna alkus allah allahu allahuma almaktub almalik almalun almamater almanak almandin almandina almandit almarhum almarhumah almasih almuazam almukhlis alobar alofon alograf aloi alokasi alokron aloleks alomerisme alomorf alon alonim alopati alopesia alot alotropi alpa alpaka alperes alpukah alquran altar alter alteratif alternasi alternatif alternator altimeter alto altokumulus altostratus altruis altruisme altruistis alu alufiru alum alumina aluminium alumni alumnus alun alung alup alur alusi aluvial aluvium alveolar alveolum alveolus alwah alwasi alwasia ama amabakdu amah amai-amai amal amalgam amalgamasi amalgamator amaliah aman amanah amanat amandel amandemen amang amanitin amar amarilis amat amatir amatirisme amatol ambah ambah-ambah ambai ambai-ambai ambak ambal ambalang ambalela ambang ambar ambarau ambasade ambasador ambau ambeien ambek amben amberal ambergris amberit ambet ambi ambigu ambiguitas ambil ambilingual ambin ambing ambisi ambisius ambivalen ambivalensi amblas amblek ambles ambliobia amboi ambreng-ambrengan ambring ambrol ambruk ambu-ambu ambuh ambul ambulakral ambulans ambulatori ambung ambur amburadul ambyar ameba amebiasis ameboid amebosit ameliorasi amelioratif amen amendemen amenorea amensalisme amenta amerisium amerospora amerta ametabola ametis ametobola amfetamin amfiartrosis amfibi amfibol amfibolisme amfiston amfiteater amfoterik ami amigdalin amikal amil amilase amilopektin amin amina aminisasi amino amir amirulbahar amirulhaj amirulmukminin amis amit amitosir amko ammeter amnesia amnesti amnion amniotik amoi amonia amonifikasi amonit amonium amor amoral amorf amortisasi ampai ampang ampas ampat ampe ampean ampek ampel ampela ampelam ampelas ampere amperemeter amperometri ampisilin amplas amplifikasi amplitudo amplop ampo amprok amprung ampu ampuh ampuk ampul ampula ampun amput amputasi amra amril amsal amtenar amuba amuh amuk amulet amung amunisi ana anabasis anabiosis anabolisme anadrom anaerob anaerobik anafilaksis anafora anaforis anafrodisiak anaglif anai-anai anak anakoluton anakronisme anakronistis anakrus anal analekta analeptik analgesik analis analisis analitis analog analogi analseks analsit anamel anamnesis anamorfosis ananda anang anani anapes anaptiksis anarki anarkis anarkisme anarkistis anasional anasir anastomosis anatase anatomi anatomis anbia anca ancai ancak ancala ancam ancang ancar-ancar ancoa ancol ancuk anda andai andak andaka andal andalas andalusit andam andan andang andapita andar andarah andeng-andeng anderak andesit andewi andika andiko andil andilau andok andong andragogi andrawina androgen androgini androgogi androlog andrologi anduh andur aneh aneka anekdot aneksasi anemer anemia anemofili anemograf anemogram anemometer aneroid anestesi anestesia anestesiolog anestetis aneuploid aneurisme anfas angah angan angel angga anggai anggak anggal anggan anggap anggar anggara anggau anggerka anggit angglap anggorokasih anggota anggrek angguh angguk anggul anggun anggung anggung-anggip anggur anggut anghun angin angina angiogenesis angiologi angiosperma angit angka angkak angkal-angkal angkara angkasa angkat angkel angker angket angkin angkit angklung angkong angkring angku angkuh angkuk angkul-angkul angkup angkur angkus angkusa angkut angler anglo anglong anglung angon angop angot angpau angsa angsana angsoka angsu angsur angus angut anhidrosis ani aniaya anil anilina animasi animis animisme animo aning-aning anion aniridia anis anisogamet anisogami anisokoria anisotropis anja anjak anjal anjang anjangkarya anjangsana anjar anjiman anjing anjir anjlok anju anjung anjur ankilosis anoa anode anodin anofeles anoksemia anoksik anom anomali anomi anonim anonimitas anoreksia anorganik anorgastik anortopia anosmia anotasi ansa ansambel ansar ansari anta antagonis antagonisme antagonistis antah antah-berantah antakesuma antalas antalkali antamir antan antap antar antara antarbangsa antarbenua antardaerah antari antariksa antarkelompok antarlingkungan antarmaster antarmolekul antarmuka antarnegara antarplanet antarpribadi antarpulau antarras antarruang antarsel antarsuku antartika antarwilayah antasid antasida antawacana ante antedilivium antediluvium antefiks antek anteken antelas anteliks antelmintik antelop antem antemeridiem antena antenatal anteng antenul antep anter antera anteridium anterior antero anteseden anti antianemia antiartritik antibarion antibeku antibiosis antibiotik antibodi antidepresan antidioksida antidiuretik antidot antienzim antiflogistik antigen antigravitasi antih antihistamin antijasad antik antikarat antikatalis antiklimaks antiklin antiklinal antiklor antikonsepsi antikristus antikuari antikuariat antimateri antimetari antimonium antimuntah anting antinomi antinovel antipartikel antipati antipenawar antipiretik antipode antiproton antipruritik antisemitisme antisepsis antiseptik antisiklogenesis antisiklon antisiklonal antisiklonis antisimpul antisipasi antisipatif antitank antitesis antitoksin antitragus antiwirawan antizarah antoi antologi antonim antonimi antop antosian antraknosa antraks antrasena antrasian antrasit antre antri antromometer antromorfis antropobiologi antropoda antropofagi antropogeografi antropoid antropolog antropologi antropometer antropomorfisme antroponimi antroposentris antroposentrisme antroposofi antuk antul antun antung-antung antup anturium antusias antusiasme anu anual anugerah anuitas anulir anulus anumerta anunasika anuresis anuria anus anuswara anut anyak anyam anyang anyar anyel anyelir anyep anyes anyik anyir aorta apa apabila apak apakah apakala apalagi apam apanase apar aparat aparatur aparatus apartemen apartemenisasi apartheid apartotel apas apati apatis apatride ape apek apel apelativa apendektomi apendiks apendisitis apepsi apersepsi apes aphelion apheliotropisme api apik apikal apikultur apilan aping apion apit apium apkir aplasi aplaus aplikasi aplikatif aplus apnea apo apodal apoenzim apogami apoge apograf apok apokalips apokaliptik apokope apokrifa apokrin apokromatik apolitis apologetika apologetis apologi apologia apomiksis apomorfin apopleksi aposematik aposiopesis aposisi aposisional apositif apostasi apostel aposteriori apostolik apostrof apotek apoteker apotik apraksi apraksia apresiasi apresiatif apresorium april apriori aprit apron apsara apsari aptasi aptiklus apu apuh apung apuran ara arab arababu arabahu arabes arabesk arabika aragonit arah arai arak araknitis araknoid aral aram aramba arang aransemen ararut aras arasy arau arbaa arbab arbei arbiter arbitrase arbitrer arboreal arboretum arborikultur arca arcas ardi are area areal arek arem-arem aren arena areografi areola areometer arerut ares arestasi areta argari argentit argentum argirodit argol argometer argon argot argumen argumentasi argumentatif ari aria aries arif arifin arih arik aril aring ariningsun arip aris arisan aristokrasi aristokrat aristokratis aristotipe arit aritenoid aritmetika arja arkade arkais arkaisme arkan arkati arkegonium arkeolog arkeologi arkeologis arkeozoikum arketipe arkian arkifonem arkileksem arkitraf arktika arku arloji armada arnal arogan arogansi aroma aromatik arombai aron arpus arsenal arsenik arsenikum arsip arsipelago arsir arsis arsitek arsitektur arta artefak arteri arteriografi arteriola arteriosklerosis artesis artetis arti articok artifak artifisial artik artikel artikulasi artikulator artileri artiodaktil artis artisan artistik artisyok artona artotek artrobrankium artropoda aru aruan aruda aruk arumba arun arung arus arwah arwana arya aryaduta arzak asa asabat asabiah asabiyah asad asah asai asak asal asali asam asan asana asap asar asas asasi asbak asbes asbut aseksual asemble asembling asepsis aseptik aseran asese aset asetabulum asetat asetilena asetimeter aseton asfal asfar asfiksia asi asibilan asibilasi asid asidimeter asidosis asih asiklik asil asilabis asimetris asimilasi asimilatif asimtot asimus asin asindeton asing asinyor asiri asisten asistensi askar askariasis askarid askese asket asketisisme askon askriptif asli asma asmara asmaradanta asmaragama asmaraloka asmarandana asnad aso asoi asonansi asong asor asortimen asosial asosiasi asosiatif aspal asparaga asparagus aspartame aspek asperses aspiran aspirasi aspirasional aspirat aspiratif aspirator aspirin asprak asrama asrar asri assalamualaikum asta astadikpala astaga astagfirullah astaka astakona astana astasia astatik astatin astenia astenik astenopia asteositoma aster asteroid astigmatis astra astral astringen astrofisika astrolab astrolog astrologi astronaut astronautika astronom astronomi astronomis astrosit asu asuh asumsi asumtif asung asurador asuransi asusila aswa aswad aswasada asyera asyik asytoret asyura atak ataksia atap atar atas atase atau atavisme atebrin ateis ateisme ateistis atelir atensi atenuasi ateret atfal ati atlas atlet atletik atma atman atmolisis atmologi atmometer atmosfer atmosferis ato atok atol atom atomisasi atomistis atop atos atowa atraksi atraktan atraktif atresia atret atribut atributif atrisi atrium atrofi atropin atung atur atus aubade audiensi audio audiofil audiofon audiograf audiogram audiolingual audiologi audiometer audiovisual audit auditor auditorium augmentatif auk auksanometer auksi auksin aula aulia aum aung aur aura aural aurat aurikularia aurora aurum aus auskultasi autad autarki autarkis autentik autentikasi autentisitas autisme autistik auto autobiograf autobiografi autodidak autodidaktik autodin autofon autogami autogen autograf autografi autogram autoklaf autoklastik autokrasi autokrat autokton autoktonos autolisis autolitograf autologi automaton automobil automotif autopsi autoskop autosom autotoksin autotrof autotrofik autotrop autotropik auzubillah avalans aven aversi avertebrata avesta avgas aviari aviasi aviator avifauna avikultur avirulen avitaminosis avokad avontur avonturir avtur avunkulokal awa awaair awaarang awabau awabeku awabulu awabusa awadara awah awahama awai awak awal awalengas awam awamineral awan awanama awang awar awaracun awas awasenjata awat awawarna awet awewe awi awik-awik awin awur awut ayah ayahan ayahanda ayak ayal ayam ayan ayanda ayang-ayang ayap ayar ayat ayatullah ayem ayeng ayid ayit ayo ayom ayu ayuk ayum ayun ayunda ayut azab azal azali azam azan azeotrop azimat azimut aziz azmat azoikum azospermi azurit bab baba babad babah babak babakan babal baban babang babar babaring babas babat babatan babe babesiasis babet babi babil babit bablas babon babu babun babur babut baca bacah bacak bacang bacar bacek bacem bacik bacin baco bacok bacot bacul bacut bad bada badai badak badal badam badan badang badani badar badari badasi badau bade badi badik badminton badong badui baduk badung badur badut baduyut bafta bagai bagaimana bagak bagal bagan bagang bagar bagas bagasi bagat bagau bagea bagi baginda bagong bagor baguk bagul bagur bagus bah bahadur bahaduri bahagia bahak bahala bahalan baham bahan bahana bahang bahar bahara bahari baharu bahas bahasa bahaya bahenol baheula bahimiah bahkan bahri bahrulhayat bahtera bahu bahuku bahwa bahwasanya baiat baid baiduri baik bain bainah bais bait baitulharam baitullah baitulmakdis baitulmakmur baitulmal baitulmukadas baitulmukadis baja bajaj bajak bajan bajang bajar bajau bajetah baji bajigur bajik bajing baju bajul bak baka bakak bakal bakalaureat bakam bakap bakar bakarat bakas bakat bakau bakda bakdahu bakdu bakdul bakelit bakero bakh bakhil baki bakiak bakik bakir bakmi bako bakpao bakpia baksi baksis bakso baktau bakteremia bakteri bakterin bakteriofag bakteriolisis bakteriolog bakteriologi bakteriostatik bakterisida bakti baku bakul bakung bakup bakut bakwan bal bala balabad balad balada balah balai balairung balak balalaika balam balan balang balangkep balans balap balar balas balau balela balen balerina balerong balet balgam balian balig baliho balik baling balistik balistika baliu balkas balkon balneologi balneoterapi balok balon balong balot balsam balseros balu baluarti baluh balui baluk balun balung balur balut bam bambam bambang bambu bambung ban bana banal banang banar banat bancak bancang bancar bancet banci bancik bancuh bancut banda bandan bandang bandar bandara bandarsah bandasrayan bandea bandel bandela bandeng bandering banderol banding bandit banditisme bando bandongan bandos bandot bandrek bandu bandul bandung bandusa bandut bang bangai bangan bangang bangar bangas bangat bangau bangbung banget bangga banggan bangir bangka bangkah bangkai bangkal bangkang bangkar bangkas bangkasan bangkeh bangket bangking bangkir bangkis bangkit bangkong bangkot bangkrut bangku bangkut banglas bangle banglo bango bangor bangpak bangsa bangsai bangsal bangsat bangsi bangun bangus bani banian baning banir banjang banjar banjaran banji banjir banjur bank bankir bantah bantai bantal bantam bantar bantaran bantat bantau banteng banter banting bantu bantun bantut banua banyak banyo banyol banyu banyun banzai bao bap bapa bapak bapakisme bapanda bapang bapet baplang baptis bar bara baraat baragajul barah barai barak barakat barakatuh baran barang barangan barangkali barap baras barat barau-barau barbar barbarisme barbel barber barbital barbiton barbiturat barbur bardi bare-bare barel bareng barep baret barga bari barid barier barik barikade baring baringan barion baris barisfer barit barita bariton barium barjad barkas barkometer barli barograf barogram barok barologi barometer barometri baron barong barongan barongsai baroskop barotermograf barso barter baru barua baruh baruje baruna barung-barung barusan barut barzakh barzanji bas basa basa-basi basah basal basalioma basanit basat basau basi basil basilari basilika basilus basin basir basirah basis basit baskara baskat basket baskom basmi bastar basuh basung basut bata batagor batai batak batako batal batalion batalyon batang batara batari batas batekeng batel batela baterai bati batih batik batil batila batimetri batin batiplankton batir-batir batis batisfer batok batolit baton batu batuk batun batung bau baud bauk bauksit baun baung baur baureksa bausastra bausuku baut bawa bawab bawah bawak bawal bawang bawasir bawat bawel bawon baya bayak bayam bayan bayang bayangkara bayangkari bayar bayas bayat bayata bayati bayem bayi bayonet bayong bayu bayuh bayun bayung bayur bazar bazoka bea beasiswa beatifikasi bebal beban bebandos bebang bebar bebaru bebas bebat bebe bebek bebekel bebekisme bebel bebenah beber beberapa beberas bebesaran bebi bebodoran bebotoh bebrek bebuyutan becak becek beceng becokok becuk becus beda bedah bedak bedal bedama bedan bedar bedaru bedawi bedaya bedebah bedegap bedegong bedel bedeng bedil bedinde bedo bedol bedudak beduk bedukang bedung beeng bega begadang begah begal begana begana-begini begandering begandring begap begar begawan begini begitu bego begonia begroting begu beguk begundal beha behandel behena bejana bejat bek beka bekah bekakak bekakas bekal bekam bekantan bekap bekas bekat bekatul bekel beken beker bekerma bekicot bekil beking bekisar bekleding bekles beklit beksan beku bekuk bekuku bekukung bel bela belabas belacak belacan belacu belada beladau beladu belah belahak belahong belai belak belaka belakang belako belalah belalai belalak belalang belam belambang belan belanak belanda belandang belandar belandong belandung belang belanga belangah belangir belangkas belangkin belangkon belanja belantai belantan belantara belantik belantika belantuk belar belarak belas belasah belasting belasungkawa belasut belat belat-belit belata belater belati belatik belatuk belatung belau belawan beldu belebas belebat belecak beleda beledang beledi beledu beleid belek belekek belekok belel belelang belembang belencong belendong belendung beleng belenggu belengket belengkok belengkong belengset belenting belentung belepas belera belerang belerong beleter beli belia beliak belian beliau belibas belibat belibis belida beligat beligo belik belikat beliku belimbing belincong beling belingkang belingsat belingut belinjo belintang belis belit belitung beliung beliut beloan belobor belodok beloh belok belokok belolang belolok belolong belon belonggok belongkang belongkeng belongkot belongkotan belongsong belontang beloon belot belotong belu belu-belai beluam beluas belubu belubur beludak beludar beludru beluhan beluk belukap belukar beluku belukut belulang beluluk belulung belum belumpai belungkang belungkur belungsing belunjur beluntas beluru belus belusuk belut beluwek bembam bemban bembar bembarap bembet bemo bemper bena benah benak benalu benam benang benar benara benatu bencah bencana bencat benci bencol bencong benda bendahara bendahari bendala bendalu bendang bendar bendara bendari bendawat bendawi bende bendel bendela bendera benderang benderung bendesa bendi bendir bendo bendoro bendu benduan bendul bendung benefaktif bengah bengal bengang bengang-bengut bengap bengawan bengek bengep benggal benggala benggang benggil benggol bengis bengkah bengkak bengkal bengkal-bengkil bengkalai bengkang-bengkok bengkang-bengkong bengkang-bengkung bengkar bengkarak bengkarap bengkaras bengkarung bengkatak bengkawan bengkawang bengkayang bengkel bengkelai bengkeng bengker bengkerap bengkil bengkok bengkol bengkong bengkos bengku bengkuang bengkudu bengkunang bengkung bengoh bengok bengong bengot bengu benguk bengul bengung beni benian benih bening benitan benjol benjut benkap bensin benta bentak bentala bentan bentang bentangkan bentangur bentar bentara bentaus benteh benteng bentes bentet bentik bentoh bentok bentol bentong bentonit bentos bentrok bentuk bentul bentulu bentur benturung bentus benua benuang benuaron benulung benum benur benyai benyek benyoh benyot benzedrin benzena benzil benzoat benzoil benzoin benzol beo beol bera berabe beragan berahi berai beraja berak berakah beraksa beram berambang beramin beranang beranda berandal berandang berang berangai berangan berangas berangasan berangga berangkal berangkat berangsang berangta berangus berani beranta berantak berantas berapa beras berat bercak berdikari berdus berebes beredel berek-berek beremban berembang berendeng bereng-bereng berengau berenggil berengos berengsek berengut berentang bereo bererot beres beresok beret berewok bergajul bergas berguk berhala beri beriang beriani berida berik beril berilium berinda bering-bering beringas beringin beringsang berisik berita berkah berkas berkat berkelium berkik berkil berko berkung berlau berlian berma bermat bermi bernas bernga beroci beroga berok berokat beron beronang berondok berondong berong berongkos berongsang berongsong beronjong beronok beronsang berontak berosot beroti beroya bersat bersih bersil bersin bersit bersut bertam bertih beru beruang beruas berubuh berudu berui beruju berujul beruk berumbun berumbung berunai berunang berungut beruntus beruri berus berwari berzanji besalen besan besar besek besel besengek beser besero beset besi besikal besing besit beskal beskap beskat beslah beslit besok besot bestari bestek bestel bestialitas bestik bestir bestral besuk besusu besut besuta bet beta betah betahak betak-betak betang betapa betara betari betas betatas betatron betau betet beti betik betina beting betinga betis betok beton betonisasi betot betul betung betutu bewok bhayangkara biadab biadat biadi biah biak biang bianglala biaperi biar biara biarpet biarpun bias biasa biat biau biawak biawan biawas biaya bibel bibi bibinda bibir bibit biblio bibliografi bibliomania bibliotek bicana bicara bicokok bicu bida bidadari bidah bidai bidak bidal bidan bidang bidar bidara bidari bidas bidet bidik bido biduan biduanda biduanita biduk bidur biduri bienial biennale bifasial bifida bigair bigami bigamis bihi bihun bijak bijaksana bijan bijana biji bijih bik bikameral bikang bikarbonat bikin bikini bikir bikonkaf bikonveks bikromat biksah biksu biksuni biku bila bilabial bilah bilai bilakmata bilal bilamana bilamasa bilang bilas bilateral bilateralisme bilga bilhak biliar bilik bilineal bilingual bilingualisme bilis biliun billahi bilokal bilur bilyet bimasakti bimbang bimbing bimbit bimetal bin bina binal binar binara binaraga binasa binatak binatang binatu binawah binayah bincacak bincang bincang-bincut bincu bincul bincut bindam bindeng binder bindu bineka binen biner bingas bingit bingka bingkah bingkai bingkas bingkatak bingkis bingung bini binjai binjat binokular binomial bintak bintal bintalak bintan bintang bintara binti bintik bintil bintit bintul bintulbahar bintur binturung biodata biodin biofera biofilm biofilter biofisik biofisika biogas biogenesis biogenik biogeografi biografi biokimia bioklimatologi biola biolinguistik biolit biolog biologi biologis bioluminesensi biomassa biomekani biometeorologi biometri biometrika bionomika biopendar bioplasma biopsi bioritme bioritmik biosekuen biosfer biosida bioskop biostatika biota biotek bioteknologi biotik biotin biotoksin biotron bipatride bipolar bipolaritas bipolisentrisme biprisma bir birah birai biram birama birang biras birat biri-biri biring birit biro birofaks birokrasi birokrat birokratis birokratisasi birokratisme birsam biru bis bisa bisai bisan bisawab bisbol biseksual bisektris biseps bisik bising bisinosis biskuit bismillah bismut bisnis bison bissu bistik bisu bisul bit biti biting bitisik bitumen biuku biumbai bius biut bivak biverbal biyuh-biyuh bizurai blabar blabitisme blakblakan blangko blantik blantika blaster blastostil blazer blek blekek blekok blenda blender blepot blewah blirik blog bloger blok blokade blokir bloknot blong blower bludrek blues blus blustru bobato bobok bobol bobos bobot bobotok bobrok bocah bocok bocong bocor bodhi bodhisatwa bodi bodoh bodok bodong bodor boga bogam bogel bogem bogi bogol bogor bogot bohemian bohlam bohong bohorok bohsia boi boikot bois bokar bokca bokek boko bokoh bokong bokop bokor boks boksen bokser bokset boksu boku bol bola bolak bolak-balik bolang-baling boleh bolero bolide boling bolometer bolong bolos bolot bolotu bolpoin bolsak bolu bom bombai bombardemen bombardir bombas bombastis bomber bomoh bomseks bon bonafide bonafiditas bonang bonanza bonar bonbon boncel bonceng boncol boncong bondol bondong bondot boneka bonet bong bongak bonggol bongkah bongkak bongkar bongkar-bangkir bongkin bongko bongkol bongkong bongkor bongkot bongkrek bonglai bongmeh bongo bongok bongsang bongsor bonjol bonjor bonsai bontak bonto bontok bontot bonus bonyok bopeng boplang bopok bopong bor bora borak boraks borang borat borci border bordes bordil bordir bordu boreal boreh borek borgol borhan borjuasi borjuis borkol boro-boro borok boron borong boros bortel bos bosan boseta bosman boson bosor bosun bot bota botak botang botani botanikus botanis botelir botlir boto botoh botok botol botor botridium botulisme bowo boya boyak boyas boyo-boyo boyong boyongan bozah bradikardi brahma brahmana brahmani brahmi brahmin braille brakiasi brakilogi brakisefalik brakistokron brakiurus braktea bramacorah brambang brana brander brankar brankas branwir braseri brata bratawali bredel breksi breksia brem bren brendi brengsek bretel brevet brifing brigade brigadir brigidig briket brilian briofita briologi briozoa brisan broiler brokade brokat broker brokoli brom bromat bromida bromin bromisme brompit brongkos bronkioli bronkitis bronkodilator bronkotomi bronkus brontosaurus bros brosur browser bruder bruk brunai brutal brutalisme bruto bua buah buai buak bual buana buang buani buar buari buas buat buaya bubar bubo bubu bubuh bubuhan bubuk bubul bubun bubung bubur bubus bubut bucu budak budanco budaya buddha buddhis buddhisme bude budek budi budian budiman budu buduk budur bueng bufer bufet bugar bugenfil bugenvil bugi-bugi bugil buhuk buhul bui buih buil bujal bujam bujang bujangga bujet bujeter bujuk bujur bujut buk buka bukan bukankah bukantah bukat bukau buket bukit buklet bukti buku bukung bukur bukut bulai bulak bulan bulang bulang-baling bulangan bular bulat bulbul buldan buldog buldoser bule buleng buletin bulevar bulgur buli-buli bulian bulimia bulir bulsak bulu bulug buluh buluk bulur bulus bum bumantara bumban bumbu bumbun bumbung bumel bumerang bumi bumiah bumiputra bumper bumpet bumping bun buna bunbunan buncah buncak buncang buncis buncit bunda bundak bundar bundas bundel bunduk bundung bung bunga bungalo bungar bungkal bungkam bungkang bungkas bungker bungkil bungking bungkuk bungkul bungkus bunglai bunglon bungsil bungsu bungur buni bunian bunjai buntak buntal buntang buntar buntat buntek buntel buntet buntil bunting buntu buntung buntut bunuh bunut bunyi bupala bupati bupet bur bura burai burak burakah buraksa buram buras burat burayak burdah bureng buret burgundi burhan burik burit burjusumbulat burkak burnout buron bursa buru buruh buruj buruk burun burung burut bus busa busai busana busar buset bushido busi busik bustan buster busuk busung busur busut buta butadiena butala butana butang butarepan butbut butek butik butir butirat butongpai butuh butul butun butut buwuh buya buyar buyung buyur buyut byarpet caba cabai cabak cabang cabar cabau cabe cabik cabir cabo cabuh cabuk cabul cabur cabut caca cacah cacak cacap cacar cacat cacau caci cacibar cacil cacing cadai cadang cadar cadas cadel cadik cadok caduk cadung caem cagak cagar cagil cagu caguh cagun cagut cah cahang cahar cahari cahaya cahi cailah caima caing cair cais caisim cak cakah cakak cakalang cakalele cakap cakar cakawari cakela cakep caki cakiak cakil cakmar cako cakra cakrabirawa cakrabuana cakram cakrawala cakruk cakup cakur cakus cal calabikang caladi calak calang calar calecer calempong caling calir calit calo calon calui caluk calung calus cam camar camat camau cambahan cambang cambuk cambul cambung camca camil campa campah campak campang campin camping camplungan campuh campung campur camuk camur canai canak canang cancan cancang cancut canda candai candak candala candang candat candi candik candit candra candradimuka candramawa candrasa candrasengkala candu candung cang cangah cangak cangam cangap cangar cangcang cangga canggaan canggah canggai canggal cangget canggih canggu canggung cangkang cangkat cangkel cangkih cangking cangkir cangklong cangkok cangkol cangkrang cangkrim cangkriman cangkring cangkuk cangkul cangkulan cangkum cangkung cangkup canguk cangut cantas cante cantel canteng cantik canting cantol cantrik cantum caos cap capa capah capai capak capal capang capar capcai cape capek capelin capgome capik capil caping capit capjiki caplak caplok capuk capung cara carah carak caraka caram caran carang caren cari carik caring carter caruk carut cas casciscus cat catat catek catu catuk catur caturjalma caturlarik caturtunggal caturwangsa caturwarga caturwarna caturwulan catut caul caung cawai cawak cawan cawangan cawat cawe-cawe cawi cawis cebak ceban cebik cebikas cebil cebir cebis cebok cebol cebong cebur cecah cecak cecap cecar cecawi cece ceceh ceceng cecengklok cecer cecere cecok cecongor cecunguk cedal cedayam cedera ceding cedok cedong ceduk cegah cegak cegar cegat ceguk cek cekah cekak cekakak cekakan cekal cekam cekang cekarau cekat cekau cekcekcek cekcok cekdam cekdel cekek cekel ceker cekeram ceki cekibar cekih cekik cekikik ceking cekit ceklek cekluk cekok cekrem cekres ceku cekuh cekuk cekung cekup cekur cekut cela celaga celah celak celaka celampak celana celang celangak celangak-celinguk celangap celapak celar celari celas celas-celus celat celatuk cele celebuk celedang-celedok celek celekeh celemek celemotan celempong celempung celeng celengan celengkak-celengkok celengkang celentang celep celepik celepuk celetuk celi celih celik celinguk celingus celis celok celomes celomok celonok celopar celorot celoteh celsius celuk celum celung celup celupak celur celuring celurit celurut celus celutak celutuk cema cemani cemar cemara cemas cemat cembeng cemberut cembul cembung cemburu cemeeh cemeh cemek cemekian cemengkian cemer cemerlang cemeti cemetuk cemomot cemong cemooh cempa cempaka cempal cempala cempana cempe cempedak cempek cempelik cempelung cempeng cempera cemperai cemperling cempiang cempil cempin cemping cemplang cemplung cempor cempreng cempres cempuling cempung cempurit cemuas cemuk cena cenak cenal-cenil cenangau cenangga cenangkas cenayang cencala cencaluk cencang cencaru cencawan cencawi cendala cendana cendang cendawan cendekia cendera cenderai cenderasa cenderawasih cenderung cendok cendol cenduai cenela ceng cengal cengam cengang cengap cengar-cengir cengbeng cengeh cengek cengeng cengengesan cenggek cengger cenggeret cengi cengir-cengir cengis cengkal cengkam cengkar cengkaruk cengkau cengkedi cengkeh cengkek cengkelong cengkeram cengkerama cengkerawak cengkerik cengkering cengkerung cengki cengkiak cengkih cengking cengkir cengkiwing cengkok cengkol cengkong cengkung cengkurai cengli cengung cengut centang centangan centeng centet centil centong centung cenung cepak cepal cepat cepek cepeng cepengan ceper cepiau ceplas-ceplos ceples ceplok ceplos cepo cepol cepu cepuk cerabah cerabih cerabut ceracak ceracam ceracap ceracau cerah cerai cerai-berai cerak ceraka cerakin ceramah cerana cerancang cerang ceranggah cerangka cerap cerat ceratai ceratuk cerau cerawat cerbak cerca cercah cercak cercap cerdas cerdik cere cerecek cerek ceremai cerempung cerepu ceret cerewet cergas ceri ceria cericap cericau cericip ceriga cerih cerita ceriwis cerkam cerkau cerlang cerlih cerling cermai cermat cermin cerna ceroboh cerobong cerocok cerocos cerompong ceronggah ceropong ceroteh cerowok cerpelai cerpen cerpenis cerpu cerucuh cerucup ceruh ceruk cerun cerup cerut cerutu cespleng cetai cetak cetar cetek ceteng ceter cetera ceteri ceteria ceti cetok cetus ceuki cewang cewek ciak cialat ciap ciar cibir cibit ciblon cibuk cicik cicil cicinda cicip cicit cidomo ciduk cigak cih cihui cik cika cikadas cikal cikar cikok cikrak ciku cikun cikut cilap cilawagi cili cilik ciling cilok ciluk cilukba cimplong cina cincang cincau cincin cincong cincu cinda cindai cindaku cinde cindil cindur cing cingak cingam cingangah cingge cingkat cingkeh cingkrang cingur cinta cintamani cinteng cintrong cip cipai cipan ciplak cipok ciprat cipta cir circir ciri cirit ciriwangi cis cit cita citra citraleka ciu cium ciut coak coang coba coban cobar-cabir cobek coblos cocok cocol cocor codak codet codot cogah cogan cogok cok cokar cokek cokelat coket coklat cokmar coko cokok cokol cokor cokorda cola-cala colak colak-caling colang-caling colek coleng colet coli colok colong colot comat-comot comberan comblang combong comek comel comor comot compang-camping compeng compes compoh compreng comro concong condong conet congak congeh congek conggah-canggih congget conggok congkah-cangkih congkah-mangkih congkak congkel congki congklak congklang congkok congkong congo congok congol congor congsam contek conteng contoh contong cop copak-capik copet coplok copot cor corak corat-coret corek coreng coret coro corob corong corot cotet cotok cowok cowokan crat-crit criping cua cuaca cuai cuak cual cuang cuar cuat cubit cublik cubung cuca cucakrawa cuci cucu cucuh cucuk cucun cucunda cucung cucup cucur cucurut cucut cudang cuek cugat cuh cuik cuil cuit cuk cuka cukai cuki cukil cukimai cukin cukit cukong cuku cukup cukur cula culak culan culas culi culiah culik culika culim culun cuma cuman cumbu cumengkling cumepak cumi-cumi cumil cuming cun cunam cundang cundrik cunduk cung cungap cungkil cungkup cungo cungul cungur cunia cup cupai cupak cupang cupar cupet cuping cuplik cupu cupul cur cura curah curai curam curang curat cureng curi curiah curiga curik curna curu cus cut cutak cutbrai cutel cuti cuwil daayah dab daba dabak dabal dabat dabih dabik dabing dabir dabit dablek dabol dabung dabus dacin dad dada dadah dadaisme dadak dadal dadali dadap dadar dadek dadi dadih dading dadu daduh daduk dadung daeng daerah daerahisme dafnah daftar daga dagang dage dagel dagi daging dagu dah dahaga dahagi dahak daham dahan dahanam dahar dahi dahiat dahina dahlia dahriah dahsyat dahulu dai daidan daidanco daif daim daiman daing daitia dajal daka dakah dakaik dakar dakhil daki dakik dakocan dakon dakron daksa daksina daktil daktilitis daktilologi daktiloskopi daku dakwa dakwah dal dalal dalalah dalalat dalam dalang daldaru dalem dalfin dali-dali dalih dalil daltonisme dalu daluang dalung dam damah damai damak damal daman damar damaru damas damat damba dambin dambir dame damen dami damik damotin dampak dampal dampan dampar dampeng dampil damping dampit damprat dampung dan dana danau danawa danda dandan dandang dandanggula dandapati dandi dang dangai dangar dangau dangdut dange danghyang dangir dangka dangkal dangkap dangkar dangkung danguk dansa dansanak danta danuh danur danyang dap dapa dapar dapat dapra dapur dar dara darab darah daras darat darau dargah dari daripada darji darma darmabakti darmakelana darmasiswa darmatirta darmawisata daro darpana daru-daru darulaitam darulakhirat darulbaka darulfana daruljalal darun darunu darurat darusalam darwis das dasa dasalomba dasar dasarian dasasila dasatitah dasawarsa dasbor dasi dasin daster dasun data datang datar datatamak dati datif datu datuk datum datung dauk daulat daun daur dawai dawan dawat dawet daya dayah dayang dayu dayuh dayuk dayung dayus dealat deaneksasi debah debak debam debap debar debarkasi debas debat debet debik debil debing debirokratisasi debit debitase debitor debitur debris debu debug debuk debum debun debung debup debur debus debut decak decap deceh decing decit decup decur decut dedah dedai dedak dedal dedalu dedap dedar dedara dedare dedas dedau dedek dedel dedemit dedengkot deder dederuk dedes dedikasi dedikatif deduksi deduktif dedulang deeskalasi defaitisme defekasi defender defensi defensif deferens defile definisi definit definitif defisien defisit deflagrasi deflagrator deflasi defleksi deflorasi defoliasi defolisasi defonologisasi deforestasi deformasi deformatif deg degam degan degap degar degen degenerasi degeneratif degil deging degradasi degresi deguk degum degung degup deh deham dehem dehidrasi dehidrat dehidrogenasi dehumanisasi deideologisasi deifikasi deiksis deiktis deislamisasi deisme dek dekade dekaden dekadensi dekagram dekah dekak dekaliter dekam dekameter dekan dekanal dekantasi dekap dekapoda dekar dekare dekat dekik dekil deking deklamasi deklamator deklarasi deklaratif deklasifikasi deklerer deklinasi deklinometer dekode dekoder dekolonisasi dekomposer dekomposisi dekompresi dekongestan dekonsentrasi dekontekstualisasi dekor dekorasi dekoratif dekorator dekosistem dekremeter dekreolisasi dekret dekriminalisasi deksa dekstrin dekstrosa deksura deku dekunci dekung dekus dekut delabialisasi delah delamak delan delap delapan delas delat delegasi delegat delegitimasi delepak deler delik delikan delikat delikates delima delineasi delinkuen delinkuensi delirium delman delong delongop delta deltoid delu delusi delusif demabrasi demagog demagogi demagogis demah demam demang demap demarkasi dembai dembam dembun demek demen demes demi demik demikian demiliterisasi demineralisasi demisioner demo demobilisan demobilisasi demograf demografi demografis demokrasi demokrat demokratis demokratisasi demon demoniak demonopolisasi demonstran demonstrasi demonstratif demonstrativa demoralisasi demosi dempak dempam dempang demper dempet dempir demplon dempok dempuk dempul dempung demung den dena denah denai denak denasalisasi denasionalisasi denawa dencang dencing denda dendam dendang dendeng dendi dendrokronologi dendrologi denervasi dengak dengan dengap dengar dengih denging dengkang dengkel dengki dengkik dengking dengkol dengkul dengkung dengkur dengkus dengu dengue denguk dengung dengus dengut denim denok denominal denominasi denotasi denotatif densimeter densitas densitometer densitometri densometer dental dentam dentang dentat dentin denting dentum dentung dentur denudasi denuklirisasi denyar denyit denyut deodoran deoknumisasi deontologi depa depak depalatalisasi depan depang depap deparpolisasi departemen departemental departementalisasi dependen dependensi depersonalisasi depersonifikasi depigmentasi depilasi deplesi depo depolarisasi depolitisasi deponir depopulasi deportasi deposan deposit deposito depot depresi depresiasi depresor deprok deprotonasi depun depus deputasi deputi dera deragem derai derajah derajang derajat derak deram deran derana derang derap deras derau derawa derebar deregulasi derek derel derep deres deresi deret dergama derik dering deringo deris derit derita deritaan derivasi derivat derivatif derji derma dermaga derman dermatitis dermatofitosis dermatolog dermatologi dermatom dermis dermoid dersana dersik deru deruji deruk derum derun derung derup derus derut desa desah desain desainer desak desakralisasi desalinasi desaneksasi desar desas-desus desau desegregasi deselerasi desember desensitisasi desentralisasi deserebrasi desersi desertir desibel desidua desigram desih desik desikan desikator desil desiliter desiliun desimal desimeter desinens desinfeksi desinfektan desing desintegrasi desir desis desit deskripsi deskriptif deskuamasi desmonem desmoplasia desmosom desorientasi desorpsi despot despotik despotisme destabilisasi destar destinasi destroyer destruksi destruktif destruktor desuk desulfurisasi desup desur desus desut detail detak detap detar detas detasemen detasering detasir deteksi detektif detektofon detektor detenidos detensi detente detergen deteriorasi determinan determinasi determinatif determinator determinis determinisme detik deting detoksifikasi detonasi detonator detritus detrusor detup detus deuterium deuterokanonika deuteron deutranomalopia deutranopia devaluasi developer deverbal deviasi devisa devosi dewa dewadaru dewala dewan dewana dewanagari dewangga dewasa dewata dewe dewi dia diabetes diad diadem diafon diaforetik diafragma diagenesis diagnosis diagnostik diagometer diagonal diagram diaken diakon diakones diakonia diakritik diakronis dialek dialektal dialektik dialektika dialektis dialektologi dialinguistik dialisis dialog dialogis diam diamagnetisme diameter diametral diamorf dian diang diaper diapositif diar diare dias diasistem diaspora diastase diastole diat diaterman diatermi diatermik diatesis diatipe diatom diatomit diatonik diatopik diayah dibasa didaktik didaktikus didaktis didih didik didis didong dielektrik diensefalon dies diesel diet dietetika difabel diferensial diferensiasi difluens difluensi difraksi difteri diftong difusi digdaya digenesis digestif digit digital digitalin digitalis digitalisasi diglosia digraf digresi digul dihedral dihidroksil dik dikara dikau dikit diklorida dikotil dikotomi dikroisme dikromat dikromatik diksa diksi diktat diktator diktatorial diktatoris dikte diktum dil dila dilak dilam dilasi dilatasi dilatometer dilema dilematik diler diletan diluvium dim dimensi dimer diminutif dimorfik dimorfisme din dina dinamik dinamika dinamis dinamisator dinamisme dinamit dinamo dinamometer dinar dinas dinasti dinding dingin dingkis dingkit dingklang dingklik dingo dini diniah diniyah dinosaurus dinul-islam diode dioesis dioksida dioksin diopsida dioptase dioptri diorama diorit dioses dipan diplo diploid diploma diplomasi diplomat diplomatik diplomatis dipsomania diptera diptotos dirah diraja direk direksi direktorat direktorium direktris direktur dirgahayu dirgantara dirham diri dirigen diris dirus disagio disain disainer disakarida disastria disbursemen disdrometer disekuilibrium disel disensus disentri disertasi disfonia disfungsi disharmoni disiden disilabik disimilasi disinfektan disinformasi disinsentif disintegrasi disiplin disjoki disjungsi disjungtif diska disket diskiasis disklimaks disko diskoid diskon diskontinu diskontinuitas diskonto diskordans diskorobik diskotek diskredit diskrepansi diskresi diskriminasi diskriminatif diskriminator diskualifikasi diskulpasi diskursif diskus diskusi dislalia disleksia dislokasi dismembrasio dismenorea dismutasi disolventia disonansi disoperasi disorder disorganisasi disorientasi disosiasi dispareunia disparitas dispensasi dispenser dispepsia dispersal dispersi disposisi disposotio disprosium disrupsi distabilitas distal distansi distikiasis distikon distilasi distilator distingsi distingtif distoma distorsi distosia distribusi distributor distrik disuasi disuria dito ditransitif diuresis diuretik diurnal divergen divergensi diversifikasi diversitas divestasi dividen divisi diwala doa doang dobel dobi doblangan doble dobol dobolo dobrak dodekagon dodekahedron dodet dodok dodol dodong dodor dodos dodot doeloe dog dogel dogeng doger dogma dogmatik dogmatis dogmatisme dogol dohok dohyo doi dok dokar doko dokoh dokok-dokok doksologi dokter doktor doktoranda doktorandus doktrin doku dokumen dokumentasi dokumenter dol dolak-dalik dolan dolar doldrum dolfin dolikosepalik dolmen dolok dolomit dom domain domba domblong domein domestik domestikasi dominan dominansi dominasi domine dominggo dominion domino domisili domot dompak dompet domplang dompleng dompol don donasi donat donatur doncang dondang donder dondon dong dongak dongan dongbret dongeng dongkak dongkel dongkok dongkol dongkrak dongkrok dongok dongpan dongsok doni donor donto dop doping dopis dor dorang dorbi dorbia dorman dormansi dorna dorong dorsal dorslah dorsopalatal dorsovelar dorsum dortrap dos dosa dosen dosin dosir dosis dot dowel dower doyak doyan doyang doyo doyong draf dragon drai drainase drakula dram drama dramatik dramatikus dramatis dramatisasi dramaturg dramaturgi draperi drastis drat drel dresoar dresur dribel drif dril drip drop droping dropsi drum drumben drumer druwe dua duafa duai duaja dualis dualisme dualistis duane duang duangsom dub dubalang dubelir dubes dubila dubing dubius duble dublir dubuk dubur duda duduk dudur dudus duel duet duga dugal dugang dugas dugdeng dugder duh duha duhai duhe duhu duilah duit duk duka dukacarita dukacita dukan dukana dukat dukaten duktulus duktus duku dukuh dukun dukung dula dulag dulang dulang-dulang duli dulur dum dumdum dumi dumping dumung dunah dunak dung dungas dungkelan dungkul dungu dungun dunia duniawi duodenum duodesimal duodrama duopoli dup dupa dupak dupleks duplik duplikasi duplikat duplikator duplisitas duplo dur dura duralumin duramater durasi durat duratif duren dureng durhaka duri durian durias duriat durja durjana durjasa durkarsa durma durna durno durnois durnoisme durometer dursila duru duruwiksa dus dusin dusta dustur dusun duta duwegan duwet duyun duyung dwiarti dwibahasa dwidarma dwidasawarsa dwifungsi dwiganda dwiguna dwilingga dwimatra dwiminggu dwimuka dwiperan dwipurwa dwisegi dwitarung dwitunggal dwiwarna dzal ebam eban ebek ebi eboni ebonit ebro eburina ecek eceng ecer eco edafik edafit edafologi edafon edan edar edema edentat edisi edit editor editorial edukasi edukatif efedrina efek efektif efektivitas efektor efelis efendi efisien efisiensi efloresensi eforus efusi egah egalisasi egalitarian egalitarianisme egalitarisme egaliter egat ego egois egoisme egoistis egol egomania egos egosentris egosentrisitas egosentrisme egrang egresif ehe eidetik eigendom eikosan einsteinium eja ejakulasi ejan ejawantah ejek ejektif ejektor ekabahasa ekad ekajati ekakarsa ekamatra ekang ekaristi ekatantri ekbalium ekdemik ekderon ekdisis ekeh ekimosis ekiofit eklektik eklektikus eklektis eklektisisme eklektisme eklips ekliptika ekliptis eklosi ekofisiologi ekofraksia ekofrasia ekografi ekogrup ekoklimat ekoklimatologi ekokronologi ekolabel ekolalia ekologi ekologis ekon ekonom ekonometri ekonomi ekonomis ekopolitik ekopraksia ekor ekornia ekosfer ekosistem ekosistematika ekospesies ekostratigrafi ekotipe ekotipifikasi ekoturisme ekozona ekrin ekrinologi eks eksak eksakta eksaltasi eksamen eksaminasi eksaminator eksantem eksantropus eksarasi eksegesis ekseget eksekusi eksekutif eksekutor eksem eksemplar eksenterasi eksentrik eksepsi ekseptor ekses eksesif eksfoliasi ekshalasi ekshibisi ekshibisionis ekshibisionisme ekshibitum eksikator eksin eksipien eksisi eksistensi eksistensialis eksistensialisme eksit eksitasi eksitus ekskavasi ekskavator eksklave eksklusif eksklusivisme ekskomunikasi ekskresi ekskreta ekskursi ekskursif eksobiologi eksobiotik eksodermis eksodos eksodus eksoenzim eksoergik eksofasia eksofora eksoftalmia eksoftalmos eksoftalmus eksogam eksogami eksogen eksogin eksoisogami eksordium eksorsis eksosfer eksospora eksostosis eksoterik eksotermik eksotik eksotis eksotisme ekspansi ekspansif ekspansionis ekspansionisme ekspansionistis ekspatriasi ekspatriat ekspedisi ekspeditor ekspektoran eksper eksperimen eksperimental ekspirasi eksplan eksplikasi eksplisit eksploit eksploitasi eksploitir eksplorasi eksploratif eksplorator eksplosi eksplosif ekspo eksponen eksponensial ekspor eksportir ekspos ekspose eksposisi ekspres ekspresi ekspresif ekspresionisme ekspresionistik ekspresivitas ekstase ekstasi ekstensi ekstensif ekstensifikasi ekstensor eksterior eksteriorisasi eksteritorialitas ekstern eksternal ekstin ekstra ekstradisi ekstrak ekstrakardial ekstraksi ekstraktif ekstrakurikuler ekstralinguistis ekstramarital ekstranei ekstraparlementer ekstrapolasi ekstraseluler ekstraterestrial ekstrateritorialitas ekstrauterin ekstraversi ekstrem ekstremis ekstremitas ekstrinsik ekstrospeksi ekstrover ekstrusi eksudasi eksudat ektoblas ektoderm ektohormon ektoparasit ektoplasma ektoterm ektotermik ekualitas ekuatif ekuator ekuilibrium ekuinoks ekuitas ekuiti ekuivalen ekuivalensi ekuivokasi ekumene ekumenis ekumenisme ela elaborasi elak elan elang elastik elastin elastis elastisitas elastomer elatif elefantiasis elegan elegansi elegi elektif elektorat elektret elektrifikasi elektrik elektris elektro elektrode elektrodinamika elektroensefalogram elektroforesis elektrokardiogram elektrokimia elektrokoagulasi elektrokusi elektrolisi elektrolisis elektrolit elektromagnet elektromagnetik elektromagnetisme elektrometalurgi elektromiografi elektromotif elektron elektronegatif elektronik elektronika elektronis elektropatologi elektropositif elektroskop elektrostatika elektroteknik elektroterapeutika elektroterapi elektrotipe elektrum elemen elementer elemi eleng elevasi elevator eliksir eliminasi eliminir eling elips elipsis elipsoid elipsometer elipsometri eliptis elite elitis elitron elo elok elokuensi elon elongasi elpiji eltor elu eluat eluen elung elus elusi elusian elusif elutriasi eluvial eluviasi eluvium email emanasi emang emansipasi emas emaskulasi emat embacang embah embak embal embalase embalau emban embar embara embarau embargo embarkasi embaru embat embek embel ember embih embik emblem embok embol emboli embolisme embolus embosur embrat embrio embriogenesis embriologi embrionik embuai embuh embun embung embus embut emendasi emeraldin emeritus emetik emetina emfisema emigran emigrasi eminen eminensi emir emirat emis emisi emisivitas emitans emiten emoh emol emolumen emong emosi emosional emosionalisme emotif empal empang empap empar empas empat empati empedal empedu empek empela empelas empenak empeng emper empet empiema empik emping empiri empiris empirisme emplasemen emplek employe empo empoh empok emporium empos empot emprak empu empuan empuk empul empulur empunya emrat emulasi emulator emulsi emulsifikasi emut enak enam enamel enap enartrosis enas enau encal encang enceh encek encel encer encik encim encit encok encot endak endal endang endap endas endasan endemi endemis endilau endoderm endoderma endodermis endofit endogami endogen endokardia endokrin endokrinologi endolimfa endometriosis endometrium endomiksis endomiokarditis endomisium endon endong endoparasit endoplasma endorfin endosemen endosentris endoskeleton endoskop endoskopi endosmosis endosperma endotel endoterm endotermal endotermis endotoksin endrin enduk endul enduro endus endut enek eneng energetik energi energik enes enfitotik engah engap-engap engas enggak enggan enggang engget enggil enggok engkah engkak engkang engkau engket-engket engkoh engkol engkong engku engkuk engsel enigma enjak enjal enjambemen enjelai enjin enjut enkapsulasi enklave enklitik enkode enkripsi enkulturasi enologi enom ensambel ensefalitis ensefalitogen ensefalograf ensefalografi ensefalogram ensefalomielitis ensefalon ensiform ensiklik ensiklopedia ensiklopedis ensopor entah entak entalpi entar entas ente enten enteng entente enteritis enterograf enterologi enteron enteropati enterosel enterostomi enterotoksin enterovirus entit entitas entoderm entogenus entok entomofili entomolog entomologi entong entot entozoa entozoik entre entrepot entri entropi enukleasi enumerasi enuresis envoi enyah enyak enzim enzimolisis enzimologi enzootik eolit eon eosen eosin eozoikum epak epek epentesis epibentos epidemi epidemiologi epidermis epidiaskop epifaring epifil epifiotik epifisis epifit epifiton epifora epigastrium epigenesis epiglotis epigon epigraf epigrafi epigram epik epikotil epikuris epilepsi epileptik epilog epimisium epinasti epinefrina epinurim episentrum episiklik episiotomi episkopal episkopat episode episodik epispora epistaksis epistel epistemologi epistola epitaf epitaksi epitel epitelioma epitermal epitet epizoik epizootik epok epoksi epolet eponim epos epsilon era eradikasi eradiksi erak eram erang erat erata erbis erbium ercis ereh erek-erek ereksi erektor ereng erepsin eret erg ergasiofit ergonomi ergonomika ergonomis ergosterol ergot ergoterapi erik ering erisipelas eritema eritroblas eritrosit erong eror erosentrisme erosi erot erotik erotika erotis erotisisme erotisme erpah erpak erti eru erupsi es esa esai esais esak esek eselon esembling esens esensi esensial esensialitas eskader eskadron eskalasi eskalator eskapisme eskas eskatologi eskatologis esofagus esok esot esoteris estafet ester estesia estetik estetika estetikus estetis estimasi estriol estrogen estron estrus estuari estuarin eta etalase etana etanol etape etatisme etek eter eteris eternit etik etika etiket etil etilena etimologi etimologis etimon etiolin etiologi etis etmoid etnik etnis etnobotani etnograf etnografi etnografis etnolinguistik etnolog etnologi etnologis etnomusikolog etnomusikologi etnopolitik etnosentrisme etologi etos etsa eudaemonisme eufemisme eufemistis eufoni eufonium euforia euforian eugenetika eugenika eugenol eukaliptol eukaliptus eukarion eukariota eulogi euploid eurasia eurihalin europium eurosentris euseksual eusinantropus eutanasia eutektik eutenika eutrofikasi evakuasi evaluasi evaluatif evangeli evangelis evaporasi evaporator evaporimeter evapotranspirasi eversi eviden eviserasi evokasi evokatif evolusi evolusioner evolusionisme ewa eyang eyel faal faali fabel fabula faden fadihat fadil fadilat faedah fafa fagosit fagositosis fagot fahombe fahrenheit fahsya fail fajar fakih fakir faks faksi faksimile fakta faktif faktitius faktor faktual faktur fakultas fakultatif falah falaj falak falakiah falsafah falsafi fam famili familia familier familiisme familisme fana fanatik fanatisme fanfare fani fantasi fantastis fantom farad faraid faraj farak fardu farik faring faringal faringalisasi faringitis farisi farji farmakodinamika farmakokinetika farmakolog farmakologi farmakologis farmakope farmakoseutika farmasi farsakh fasad fasakh fase faset fasia fasid fasih fasihat fasik fasilitas fasilitator fasis fasisme fastabikhulkhairat fatah fatal fatala fatalis fatalisme fatalitas fatamorgana fatanah fatihah fatimah fatir fatom fatometer fatri fatsun fatur fatwa fauna faunistik favorit favoritisme febrin februari federal federalis federalisme federalistis federasi feko fekundasi fekunditas felon felspar feminin feminisme fenakit fengsui fenit fenol fenologi fenomena fenomenal fenomenalisme fenomenologi fenosis fenotipe feodal feodalisme feodalistis feral feri feritin fermen fermentasi fermion fermium feromagnetik feromagnetisme feromon feronikel fertil fertilasi fertilisasi fertilitas fertilizin ferum feses festival fetis fetor fetus fiasko fiat fiber fibrasi fibriblas fibril fibrilasi fibrin fibrinogen fibrokistik fidah fider fidiah fidusia fidyah figur figuran figuratif fiil fikih fikli fikologi fikrah fiksasi fiksi fiktif fikus filamen filantrop filantropi filantropis filaria filariasis filateli filatelik filatelis filharmoni filial filibuster film filmis filo filodendron filogenesis filogeni filolog filologi filologis filopur filosof filosofi filosofis filsafat filsuf filter filtrasi filtrat filum fimbria final finansial finir finis fiolaks firajullah firasat firauniah firdaus firdausi firjatullah firkah firma firman firn fisi fisibel fisibilitas fisik fisika fisiognomi fisiognomis fisiologi fisiologis fisioterapi fisis fiskal fit fiting fitnah fitofag fitofogus fitogeni fitogeografi fitokimia fitologi fitometer fiton fitopatologi fitosanitasi fitososiologi fitosterol fitostrot fitotoksin fitotoksoid fitotopografi fitotron fitrah fitri flakon flamboyan flamingo flanel flat flegma flegmatis fleksi fleksibel fleksibilitas fleksor flensa flip-plop flis floem flop flora floret flotasi flotet flu fluensi fluida fluks fluktuasi fluktuatif fluor fluoresen fluoresens fluorin fluorit fobia fokimeter fokstrot fokus folder foli folikel folio folklor folksong fon fonasi fondamen fondasi fonds fonem fonemik fonemis fonetik fonetis fonik fonis fonograf fonografi fonologi fonologis fonon fonotaktik fonotipi foramen foraminifera forensik forklif forma formal formalin formalistis formalitas forman formasi format formatif formatir formatur formika formula formulasi formulator formulir fornifikasi fornikasi forsep forsir forsit forte fortifikasi fortin forum fosfat fosfina fosfit fosfor fosforesens fosforilase fosforus fosgen fosil foto fotodiode fotoelektron fotoemisi fotogenik fotograf fotografer fotografi fotografis fotograver fotogravur fotokimia fotokonduksi fotokonduktivitas fotokonduktor fotokopi fotokromi fotokromik fotolisis fotolitografi fotometer fotometri fotomikrografi fotomodel foton fotoperiodisme fotosel fotosfer fotosintesis fotostat fototaksis fototropis fototustel fovea foya fragmen fragmentaris fragmentasi fraksi fraksinasi fraktur fraktus frambusia fransium frasa frase fraseologi frater fraternitas freatofit fregat frekuen frekuensi frekuentatif frenologi freon frib frigid frigorigraf frigorimeter frikatif friksi fron front frontal fruktosa frustrasi fuad fugasitas fujur fukaha fukara fuksina fulgurit fuli fulminat fulus fumarol fumigan fumigasi fumigator fundamen fundamental fundamentalis fundamentalisme fundamentalistis fungi fungibel fungisida fungistatik fungoid fungsi fungsional fungsionalisasi fungsionalisme fungsionalitas fungsionaris fungus furfural furkan furnitur furqan furuk fusi fusta fusuk futual futur futurisme futuristik futuristis futurolog futurologi futurologis fyord gaba-gaba gabah gabai gabak gabardin gabas gabir gableg gablek gabor gabro gabruk gabuk gabung gabus gaco gacok gacong gada gadai gadamala gadang gading gadis gado gadolinit gadolinium gadon gaduh gaduk gadung gadungan gaek gaet gafar gaflah gaflat gaftar gafur gaga gagah gagai gagak gagal gagang gagap gagas gagau gagu gaguk gah gaham gahar gahara gahari gaharu gai gaib gail gain gaing gairah gait gajah gajak gaji gajih gajul gajus gakang gakari gala galaba galaganjur galagasi galah galai galak galaksi galaktometer galaktorea galaktosa galaktosemia galaktosuria galan galang galanggasi galar galas galat galau galbani galeng galeri galgal gali galias galib galibut galih galiot galir galium galiung galon galu-galu galuh galung galungan galur galvanis galvanisasi galvanometer galvanometri galvanoskop galyas gam gama gamak gamal gamalisasi gamam gaman gamang gamat gambang gambar gambas gambir gamblang gambling gambuh gambus gambut gambyong gamelan gamet gametangium gametofit gametogenesis gametosit gamik gamis gamit gamma gamopetal gamosepal gampang gampar gamparan gamping gamuh gana gana-gini ganal ganang ganar ganas gancang gancar ganco gancu ganda gandal gandapura gandar gandaria gandarukem gandarusa gandarwa gandasturi gandasuli gandek gandem ganden gandeng gandes gandewa gandi gandik gandin ganding gandok gandola gandos gandringan gandrung gandu ganduh gandul gandum gandung gang ganggam ganggang ganggu ganggut ganglion gangsa gangsal gangsar gangsi gangsir gangster gani ganih ganimah ganja ganjak ganjal ganjar ganjat ganjen ganjil ganjing ganjling ganjur ganoid gantal gantang gantar gantel ganteng ganti gantih gantilan ganting gantol gantole gantung ganyah ganyang ganyar ganyong ganyut gaok gap gapah gapah-gopoh gapai gapil gapit gaple gaplek gaplok gapuk gapura gar gara-gara garah garai garam garan garang garangan garansi garap garasi garau garba garbarata garbis garda gardan gardu garebek gari garib garindin garing garis garit garizah garmen garnet garnis garnisun garong garpu garu garuda garuk garung garut garwa gas gasab gasak gasal gasang gasatrin gasifikasi gasing gasket gasolin gasometer gaster gastrektomi gastrin gastritis gastroenteritis gastroenterolog gastroenterologi gastrointestinal gastronomi gastrula gastrulasi gatal gatot gatra gatrik gatuk gauk gaukang gaul gaun gaung gaut gawai gawal gawan gawang gawar gawat gawir gaya gayal gayam gayang gayat gayau gayem gayeng gayuh gayuk gayun gayung gayut gaz gazal gebah gebang gebar gebeng geber geblak geblek geblok gebogan gebok gebos gebot gebrak gebu gebuk gebung gebyah-uyah gebyar gebyur gecar gecek gecer gecul gedabah gedana-gedini gedang gede gedebak-gedebuk gedebar-gedebur gedebeg gedebok gedebuk gedebung gedek gedembai gedembal gedempol gedeng gedi gedik gedok gedombak gedombrongan gedong gedor gedubang gedung geduyut gegabah gegadan gegai gegak gegala gegaman gegana gegaokan gegap gegar gegares gegas gegat gegau gegep geger gegetar gegetun gegisik gegua geiger geiser gejah gejala gejolak gejos gejuju gel gela gelabah gelabir gelabur geladak geladeri geladi geladir geladrah gelagah gelagap gelagar gelagat gelak gelakak gelalar gelam gelama gelamai gelambir gelandang gelandot gelang gelanggang gelangsar gelantang gelanting gelantung gelap gelapung gelar gelas gelasak gelasir gelatak gelatik gelatin gelatuk gelayangan gelayar gelayut gelebah gelebap gelebar geleber gelebuk geleca gelecik geledah geledang geledek geleding geledur gelegah gelegak gelegar gelegata gelek gelekak gelekek gelema gelemat gelemberan gelembong gelembung gelembur gelempang gelemprang gelenang gelendo gelendong gelendot geleng gelenggang gelentang gelenting gelenyar gelepai gelepar gelepek gelepot gelepung gelepur geler gelesek geleser gelesot geleta geletak geletar geletek geletik geleting geletis geletuk geli geliang geliat gelibir gelicik geliga geligi geligin geligis geligit gelignit gelimang gelimantang gelimbir gelimir gelimpang gelimun gelincir gelincuh gelinding gelinggam gelinggaman gelinggang gelingsir gelinjang gelintang gelintar gelintin gelinting gelintir gelipar gelisah gelita gelitar gelitik gelo gelobak gelobok gelocak gelodar gelodok gelogok gelohok gelojoh gelomang gelombang gelompar gelondong geloneng gelonggong gelongsor gelontor gelopak gelora gelosang geloso gelosok gelotak geluduk geluga gelugu gelugur gelugut geluh geluk gelulur gelumang gelumat geluncur gelundung gelung gelup gelupur gelut gema gemah gemak gemal geman gemang gemap gemar gemas gemaung gemawan gembak gembala gembar-gembor gembel gembeng gembil gembili gembira gemblak gembleng gemblong gemblung gembok gembol gembolo gembong gembor gembos gembreng gembrot gembul gembung gembur gembus gembut gemebyar gemelentam gemeletak gemeletap gemeletek gemeletuk gemelugut gementam gementar gemercak gemercik gemerencang gemerencik gemerencing gemerencung gemeresak gemeresik gemeretak gemeretuk gemeretup gemerlap gemertak gemertuk gemerusuk gemetar gemi gemik gemilang gemilap geming gemini gemintang geminte gemirang gempa gempal gempar gempil gempita gempol gempor gempul-gempul gempur gemrobyos gemuk gemul gemulai gemulung gemuntur gemuruh gen gena genah genahar genang genap gencar gencat gencel gencer gencet gencir gendak gendala gendam gendang gendar gendarmeri gendeng gender genderang genderuwo gendewa gending gendis gendon gendong genduk gendut genealogi genealogis genegin geneng generalis generalisasi generalisimo generasi generatif generator generik genesis genetika genetis geng genggam genggang genggong gengsah gengsi gengsot genial genialitas genikulum genis genit genital genitalia genitif genius genjah genjang genjang-genjot genjer genjik genjot genjrang genjring genjur genom genosida genotipe genre genta gentala gentar gentas gentat gentayang gentel genteng gentian genting gentong gentrifikasi gentur gentus genus genyot geobotani geodesi geofisika geofisis geofon geognosi geografi geografis geohidrologi geokimia geokronologi geolog geologi geologis geomansi geometri geometris geomorfologi geonomi geopolitik geosentris geosinkronis geostasioner geostatika geoteknik geoteknologi geotermal geotermi geotermometer gepeng gepit geplak gepok geprak gepuk gera gerabah gerabak gerabang geradi geragai geragap geragas geragau geragih geragot geraguk gerah geraham gerai gerak geram geraman geramang geramsut geramus gerang gerangan geranggang geranium gerantak gerantang geranyam gerapai gerapu gerat geratak geratih gerau gerawan gerawat gerayah gerayang gerbak gerbang gerbas-gerbus gerbera gerbong gerbus gerda gerdam gerdan gerdum gerebek gerecak gerecok gereget gereh gereja gerejani gerejawi gerek geremet gerempang gerencang gerendel gerendeng gerenek gereneng gereng-gereng gerengseng gerenik gerenjeng gerenjet gerentang gerenyau gerenyeng gerenyet gerenying gerenyit gerenyot gerepe gerepek gerepes geresek geret geretak geretang gergaji gergajul gergasi gergeran gerha gerhana geriak geriak-geriuk geriap geriatrik geribik gericau geridip geridit geriditpidit gerigi gerigik gerigis gerih gerik gerilya gerim gerimis gerincing gerinda gerindin gerinding gering geringging geringgingan geringsing gerinjal gerinjam gerinting gerinyau gerip geripir geripis gerisik gerising gerit geriuk gerlap gerlip germang germanium germinal germisida germo germut gero geroak gerobak gerobok gerobyak gerocok gerodak gerogol gerogot gerohok gerohong gerojok gerombol gerombong gerompok gerong geronggang geronium gerontokrasi gerontol gerontologi geronyot geropes geropyok geros gerosak gerot-gerot gerowong gerowot gerpol gersak gersang gersik gertak gertik gertuk geru gerugut geruh geruit gerumit gerumpung gerumuk gerumut gerun gerundang gerundel gerung gerunggung gerunyam gerup gerupis gerupuk gerus gerut gerutu gerutup gerutus gesa gesek gesel geser gesit gesper gestikulasi geta getah getak-getuk getang getap getar getas getek getem-getem geti-geti getik getil getir getis getok getol getu getuk getun geulis gewang gial giam giat gibah gibang gibas giblet gibtah gidik gigahertz gigantisme gigi gigih gigil gigir gigis gigit gigolo gila gilang gilap gilas gilbet gili gilik giling gilir gim gimbal gimnasium gimnastik gimpal gin ginang gincu ginding ginekolog ginekologi ginekomasti ginesium gingivitis ginglimus gingsir gingsul ginjal ginjean ginkang ginogenesis ginseng gips gipsi gir girah giral girang girap-girap giras girasol giri girik girikan giring giris giro giroskop girostat girostatika gisar gisik gisil gita gitapati gitar gitaris gites gitik gitok giuk giur giwang gizi glabela gladiator gladiol glamor glandula glans glasial glasir glasnos glaukoma glegek glenik glenoid gletser glidik glikogen glikogenesis glikogenolisis glikol glikolisis glikosid glikosida glikosidasa glikosidase glikosuria gliserida gliserol global globalisasi globalisme globe globulin globus glokidium glomerulus glomus glosarium glosem glosematik glositas glositis glotal glotalisasi glotis glukagon glukosa glukosan glukosida gluten gnomon goak gob goba gobak gobang gobar gobek gobet goblok gocap gocek gocoh goda godak godam godek godok godong godot goel gogoh gogok gogos gogrok gohok gohong gojek gojlok gokar gol golak golak-galik golbi golek goleng goler golf golok golong golput gom gombak gombal gombang gombeng gombrang gombroh gombyok gompal gompiok gonad gondang gondas-gandes gondok gondol gondola gondong gondorukem gondrong gong gonggo gonggok gonggong gongli gongseng gongyo goni gonidium goniometri gonjak gonjang gonjang-ganjing gonjing gonjlang gonjlang-ganjling gonjok gonjong gonokokus gonore gonrang gontaganti gontai gontok gonyak gonyeh gonyel gonyoh gopek gopoh gorap gorden gorek goreng gores gori gorila gorilya goroh gorok gorong-gorong gosan gosip gosok gosong gospel got gotes gotik gotong gotri gotrok gotun gowok goyah goyak goyang grabadan grad gradasi gradien gradual gradualisme graf grafem grafemik grafemis grafetik grafik grafika grafis grafit grafitasi grafolog grafologi graha grahita gram gramatika gramatikal grambyang gramofon granat granit granolitik granula granulasi granulosit grapyak grasi gratak gratifikasi gratis gravel graver gravimeter gravitas gravitasi grecok gregarius grehon grempel gres gresek-gresek gria grip griya grogi gronjong gros grosir grup gruwung gua gual guam guanidina guanin guanina guano guar gubah gubal gubang gubel gubernemen gubernur gubit gubris gubuk guci gudam gudang gudangan gude gudeg guderi gudi gudik gudu-gudu gue gugah gugat gugu guguh guguk gugup gugur gugus guit gujirak gujirat gukakas gul gula gulah gulai gulali gulam gulambai gulana gulang-gulang gulat guli guliga guling gulir gulita gulma gulud gulung gulut gum guma gumal gumam gumba gumbaan gumbang gumbar gumboro gumbuk gumebruk gumelaran gumpal gumpil gumuk gumul gumun gun guna gunawan guncang gunci gundah gundal gundala gundang gundar gundi gundik gundu gunduk gundul gung gunggung gunjai gunjing gunolugu gunrit gunseikan guntai guntak guntang guntil gunting guntung guntur gunung gunyam gup gurab gurah guram gurami gurat gurau gurdan gurdi guri gurih gurik gurindam guring gurit gurita gurnadur guru gurub guruh guruk gurun gurung gurur gus gusah gusar gusel gusi gusrek gusti gusul gusur gutasi gutik gutuk guyon guyub guyur habenula habib habibi habibulah habibullah habis habitat habituasi habitus habluk hablun hablur habsyi habuan habuk habung had hadanah hadap hadas hadat hadiah hadir hadirat hadirin hadis hadron hafal hafiz hafnium hagiografi hahnium hai haid haik haiking haiku hail hailai haj hajah hajar hajat haji hajib hajim hajis hak hakaik hakam hakikat hakiki hakim hakimah hakul hakulah hakulyakin hal hala halai-balai halaik halakah halal halalbihalal halaman halang halau halazon halba haleluya halia halilintar halim halimbubu halimun halimunan halipan halitosis halkah halma halo halobion halofili halofit halofita halofob halogen halogenasi halotan halsduk halte halter haluan halus halusinasi halusinogen halwa ham hama hamal hamatum hamba hambali hambar hambat hambur hamburger hamdalah hamdu hamik hamil haminte hampa hampang hampar hampir hamud hamulus hamun hamzah hana hanacaraka hanafi hancing hancur handai handam handaruan handasah handelar handuk hang hangar hangat hanger hanggar hangit hangus hanif hanjuang hansop hantai hantam hantap hantar hantir hantu hanya hanyut hap hapetan haplografi haploid haplologi hapus hara harak harakah harakat harakiri haram harap harawan harbi hardik harem harendong harfiah harga hari haribaan harimau haring harini haris harisah harit harkat harmoni harmonik harmonika harmonis harmonisasi harmonium harnet harpa harpis harpun hart harta hartal haru haruan harum harungguan harus has hasab hasad hasai hasan hasar hasib hasid hasil hasrat hasta hasta-wara hasud hasut hasyiah hasyis hati hatif hatta hatur haud haudah haukalah haul haur hauri haus haustorium haver hawa hawar hawari hawiah hayat hayati hayo heban hebat heboh hebras hebring hedonis hedonisme hegelianisme hegemoni hegemonik hegemonisme hegemonnisme heiho heksadesimal heksagon heksahedron heksaklorida heksameter heksana heksapoda hektar hektare hektograf hektogram hektoliter hektometer hela helah helai helat helicak helikopter heling heliofit heliofobi heliograf heliogram heliometer heliosentrik helioskop heliotaksis helioterapi heliotrop heliotropisme helipad helium helm helmintologi hem hemat hematit hematite hematofobia hematologi hematom hematometra hematuri hembak hembalang hembus hemeralopi hemikordat hemiplegia hemisfer hemodialisis hemofilia hemoglobin hemolisis hemopoiesis hemopteran hemoragi hemoroid hemosit hemositometer hemostasis hemostatik hempap hempas hendak hendam hendel heng hengit hengkang hening henoteisme henry hentar henti henyak hepar hepatitis heptagon heptahedron heptameter heptana heraldik heran herba herbarium herbisida herbivor herbivora herder hereditas herediter heregistrasi heresi hering hermafrodit hermafroditisme hermetis hernia hero heroik heroin heroisme herpes herpetolog herpetologi hertz hesperidin heterodin heterodoks heterofemi heterofil heterofit heterogamet heterogami heterogen heterogenitas heterograf heterografi heteroklitus heteronim heteronimi heteronomi heteroseksual heteroseksualitas heterosfer heterosiklis heterosis heterospora heterostili heterotrof heterozigot heuristis hewan hewani hia hialin hialit hias hiatus hibah hibal hibat hibernasi hibiskus hibob hibrida hibridis hibridisasi hibuk hibur hidang hidatod hidayah hidayat hidrasi hidrat hidraulik hidraulika hidraulis hidrida hidrodinamika hidrofili hidrofit hidrofobia hidrofoil hidrofon hidrogen hidrogenasi hidrogeologi hidrograf hidrografi hidrogram hidrokarbon hidroklorida hidrokori hidroksida hidroksil hidrolika hidrolisis hidrologi hidrometeorologi hidrometer hidrometri hidromini hidronan hidropati hidroperoksida hidroponik hidropsoma hidrosfer hidrosiklon hidroskop hidrostatika hidrostatis hidroterapi hidrotermal hidu hidung hidup hiena hierarki hierarkis hieroglif hifa higiene higienis higrograf higrogram higrometer higrometri higroskop higroskopis higrotermograf higrotermogram hijab hijaiah hijau hijrah hijriah hikayat hikmah hikmat hilal hilang hilap hilau hilir hilofagus himanga himar himen himenium himne himpun hina hinap hinayana hindar hindi hindu hinduisme hingga hinggap hinggut hio hiosiamina hiosin hip hipantium hiperaktif hiperamnesi hiperbarik hiperbol hiperbolis hiperemia hiperestesia hipergami hiperkelas hiperkinesis hiperklas hiperkorek hiperkritis hiperlipemia hipermetropia hiperon hiperopia hiperparasit hiperplasia hiperseks hiperseksual hipersensitif hipersonik hipersonika hipertensi hipertonik hipertradisional hipertrikosis hipertrofi hipervitaminosis hipnosis hipnoterapi hipnotis hipnotisme hipoblas hipodermis hipodermoklisis hipodrom hipofisis hipofremia hipogen hipoglikemia hipokondria hipokotil hipokrisi hipokrit hipokritis hipolimnion hipomastia hipomnesia hiponim hipopituitarisme hipoplankton hipoplasia hipopotamus hiposentrum hipotaksis hipotek hipotensi hipotenusa hipotermia hipotesis hipotetis hipotiroid hipotiroidisme hipotonik hipovitaminosis hipsometer hipui hirap hirau hirsutisme hiru-biru hiru-hara hirudin hiruk hirup his hisab histamina histerektomi histeria histeris histerisis histidina histogeni histokimia histologi histon histopatologi histori historikus historiografi historis historisisme historisitas hit hitam hitung hiu hiyayat hobat hobi hobo hodadoda hodah hodometer hoi hokah hoki hol holi holisme holistis holmium holobentos holoenzim holofit holofitik holofrasis hologamet hologami holograf holografis hologram holokrim holokrin holoplankton holosen holozoik homeostasis homili hominid hominoid homo homofon homofoni homogami homogen homogeni homogenitas homograf homografi homogram homoiotermal homolog homologi homonim homonimi homorgan homoseks homoseksual homoseksualisme homoseksualitas homosfer homospora homoterm homozigot honae honcoe honji honor honorarium honorer honorifik hop hopagen hopbiro hopkantor hopyes horak horas hore horizon horizontal hormat hormon hornblenda horor horoskop hortikultura hortikulturis hoskut hospital hostel hostes hosti hot hotel howitzer huakiau hubar hubaya-hubaya hububan hubulwatan hubung huda hudai hudhud hudud hufaz huh hujah hujaj hujan hujat huji hujin hujung hukah hukama hukum hula-hula hulam huler hulu hulubalang hulul hulur huma human humaniora humanis humanisasi humanisme humanistis humanitas humaniter humas humbalang humektan humerus humidifikasi humiditas humifikasi humin humor humoris humoristis humorolog humus hun huncue huni hunjam hunjuk hunkue hunus hura-hura hurah huria huriah hurikan huru-hara huruf hus husnulkhatimah hut hutan hutang huyung ialah iambus iatrogenik iba ibadah ibadat ibadurahman iban ibar-ibar ibarat ibayuh ibid ibidem ibing iblis ibni ibnu ibra ibrit ibtida ibtidaiah ibu ibul ibun ibunda ibung ibus icak-icak ida idafi idah idam idap idarah idas ide ideal idealis idealisasi idealisme idealistis idem identifikasi identik identitas ideofon ideograf ideografi ideografis ideogram ideologi ideologis ideosinkretik idep idiil idiolek idiom idiomatis idiomatologi idiosi idiosinkrasi idiosinkretik idiot idola idrak idu iduladha idulfitri ifah ifrit iftar iftitah iga igal igau iglo ihdad ihram ihsan ihsanat ihsas ihtifal ihtikar ihtilam ihtimal ihwal ijab ijabat ijajil ijarah ijas ijazah ijbar ijeman ijil ijmak ijmal ijon ijtihad ijtimaiah ijtimak ijuk ikab ikal ikamah ikan ikat ikebana ikhbar ikhlas ikhtiar ikhtiari ikhtilaf ikhtiogeografi ikhtiosarkotoksisme ikhtiotoksisme ikhtisar ikhwan iklan iklim ikon ikonis ikonograf ikonografi ikonoklasme ikonometer ikrab ikram ikrar iktibar iktidal iktikad iktikaf iktiografi iktiolit iktiologi iktiologis iktirad iktiraf iktisab ikuh ikut ilafi ilah ilahi ilahiah ilahiat ilai ilak ilam-ilam ilanun ilar ilas ilat ilegal iler iles ileum ilham ili ilian iling ilmiah ilmu ilu iluminasi ilusi ilusif ilusionis ilustrasi ilustratif ilustrator imago imaji imajinasi imajinatif imajiner imak imam imamah imamologi iman imanen imanensi imani imbak imbal imbang imbas imbau imbesil imbesilitas imbibisi imbit imbuh imigran imigrasi iming-iming imitasi imitatif imitator imkan imla imlek imobilisasi impak impas impase impedans impedansi impek imperatif imperfek imperfeksi imperial imperialis imperialisme imperium impersonal impersonalia impersonalitas impi impit implan implantasi implemen implementasi implikasi implisit implosif implosit impor importasi importir impoten impotensi impregnasi impresariat impresario impresi impresif impresionis impresionisme impresionistik imprimatur improvisasi impuls impulsif imsak imsakiah imtihan imun imunisasi imunitas imunokimia imunokompromi imunologi imunologis imunosupresi imunoterapi ina inadaptabilitas inai inang inangda inap inartikulat inas inaugurasi inayat inca incang-incut incar inci incit incling incrit incu incut indah indang indap indarus indayang indebitum indehoi indekos indeks inden independen inderawasih indeterminisme indigenos indigo indik indikan indikasi indikatif indikator inding indisipliner indium individu individual individualis individualisasi individualisme individualistis individualitas individuasi indoktrinasi indolen indolensi indologi indonesia indonesianisasi indra indraloka indranila indria indriawi indu induk induksi induktans induktansi induktif induktor indung indusemen industri inefisiensi inersia infak infanteri infantil infantilisasi infantilisme infarktus infeksi inferensi inferensial inferior inferioritas inferno infertil infertilitas infiks infiltrasi infiltrometer infinitif infiradi inflamasi inflasi inflatoar infleksi infleksibel infleksif infloresen infloresens influensa influenza info informal informan informasi informatif informatika inframerah infrasonik infrastruktur infus inga ingar ingat ingau inggang-inggung inggris inggu inggung ingin ingkah ingkar ingkir ingresif ingsar ingsun ingsut ingus inheren inhibisi inhibitor ini inisial inisiasi inisiatif inisiator injak injap injeksi injil injin inkarnasi inkarserasi inkarsunah inkaso inklaring inklinasi inklinometer inklusif inkognito inkompabilitas inkompatibilitas inkompeten inkomplet inkonfeso inkonsisten inkonsistensi inkonstitusional inkonvensional inkorporasi inkremental inkubasi inkubator inkulturasi inlander inohong inokulasi inovasi inovatif inovator insaf insan insanan insang insani insanulkamil insar insek insekta insektari insektisida insektivor insektivora insektologi inseminasi insentif insersi inses inset insiden insidental insinerator insinuasi insinuatif insinye insinyur inskripsi inslan insolven insomnia inspeksi inspektorat inspektur inspirasi instabilitas instalasi instalatur instan instansi insting instingtif institusi institusional institut instruksi instruksional instruktif instruktur instrumen instrumental instrumentalia instrumentalis instrumentasi insubordinasi insulator insuler insulin insulinde insya intai intan integral integralistik integrasi integrasionis integritas integumen intel intelek intelektual intelektualisasi intelektualisme inteligen inteligensi inteligensia intelijen intendans intens intensi intensif intensifikasi intensional intensitas interaksi interaksionistik interaktif interdepartemental interdependen interdiksi interdisipliner interegnum interelasi interen interes interesan interetnik interferens interferensi interferometer interferon interglasial interim interinsuler interior interjeksi interkom interkoneksi interkonsonantal interkontinental interlokal interlokutor interlud intermeso intermezo intermolekuler intern internal internalisasi internasional internasionalisasi internat internir internis internuntius interogasi interogatif interogator interpelan interpelasi interpelator interpiu interpolasi interpretasi interpretatif interpretator interpreter intersepsi intertestial intertidal interupsi interval intervensi intervensionisme interviu interzona inti intifadah intiha intikad intim intima intimasi intimidasi intip intipati intisari intoksikasi intoleran intonasi intradermal intrakalimat intrakurikuler intralinguistis intramembran intramolekul intramuskuler intransitif intraseluler intrauniversiter intravaskuler intravena intrik intrinsik intro introduksi introjeksi introspeksi introver intrusi intuisi intuitif intumesensi invaginasi invalid invasi invensi inventaris inventarisasi inventif inventor inventori inventoriminat inversi invertebrata investasi investigasi investigatif investor invitasi invois involusi inyik inzar inziaj iodin ion ionisasi ionosfer iota ipar ipis ipon iprit ipuh ipuk ipung iqamat iqra ira iradat iradiasi irafah irah-irahan iram irama iras irasional irasionalitas iri iridium irigasi irigator irik iring iris irit iritabilitas iritasi iritatif ironi ironis irsyad irung irus isa isak isalohipse isap isbat iseng isentropik isi isim isis isit iskemia islah islam islami islamiah islamis islamisasi islamisme islamologi isoaglutinin isobar isobarik isobat isobron isodin isodinamik isofase isofen isofet isoflor isofon isogamet isogami isoglos isogon isogram isohalin isohel isohiet isohips isokal isokalori isokemi isokeraunik isokor isokorik isokronisme isolasi isolasionisme isolatif isolator isoleks isolemen isolir isomer isometrik isomorf isomorfis isomorfisme isonefel isonomi isopal isopiestik isoplet isoriza isosilabisme isotah isoterm isotermal isotop isotrop isotropik isovolumik isra israf istal istan istana istanggi istaz istazah istiadat istianah istiazah istibdad istibra istidlal istidraj istifham istigasah istigfar istihadah istihsan istikamah istikharah istikhlaf istiklal istikmal istilah istima istimaiah istimewa istimna istimtak istinggar istinja istiqlal istirahat istislah istislam istisna istitaah istiwa istri isu isya isyarat isytiak isyu italik item iterasi iterbium itibak itibar itidal itifak itihad itik itikad itikaf itil itisal itlak itrium itu iudisasi iur iwad iya izah izin jaat jab jabal jabang jabar jabariah jabat jabir jables jabrik jabung jadah jadam jadayat jadi jaduk jadwal jaga jagabaya jagabela jagal jagang jagapati jagaraga jagat jagawana jago jagra jagrak jaguar jagung jagur jah jaha jahan jahanam jahar jaharu jahat jahe jahil jahiliah jahiriah jahit jahul jail jainisme jaipong jais jaiz jaja jajah jajak jajal jajan jajar jaka jakal jakas jaket jaksa jaksi jakun jala jalabria jalad jalak jalal jalan jalang jalangkote jalangkung jalar jali jalibut jalil jalin jalma jalu jalur jam jamaah jamadat jamah jamak jamal jaman jamang jambak jambal jamban jambang jambar jambat jambe jambiah jambian jamblang jambon jambore jambret jambu jambul jambulan jambur jamhur jamiah jamiatul jamik jamil jamin jamis jamiyah jamiyatul jampal jampen jampi jampuk jamrah jamrud jamu jamung jamur janabah janabijana janah janat janda jangak jangan jangar jangat janggal janggelan janggi janggolan janggung janggut jangka jangkah jangkang jangkap jangkar jangkat jangkau jangki jangkih jangkih-mangkih jangking jangkir jangkit jangkrik jangkung jangla jangol jani janik janin janjang janji jantan jantang jantina jantuk jantung jantur janturan januari janubi janur jap japan japin japu japuk jara jarab jarah jarak jaram jaran jaranan jarang jaras jarem jargon jari jariah jariji jarimah jaring jarit jarjau jaro jarotan jarum jarwa jas jasa jasad jasadi jasmani jasmaniah jasus jaswadi jatah jatayu jati jatilan jatmika jatuh jatukrama jauh jauhar jauhari jauza jawab jawang jawanisasi jawara jawat jawawut jawer jawi jawil jaya jayacihna jayapatra jayasong jayastamba jayeng jaz jazam jazirah jazirat jebab jebah jebai jebak jebang jebar jebat jeblok jeblos jebluk jebol jebor jebrol jebuh jebung jebur jeda jeding jedot jegal jegang jegil jegogan jegung jejabah jejak jejaka jejal jejap jejas jejengkok jejer jejunum jejuri jeket jeksi jel jela jelabak jelabir jeladan jeladren jeladri jelaga jelagra jelah jelai jelajah jelak jelalat jelamprang jelanak jelang jelangak jelangkung jelantah jelapak jelapang jelar jelarang jelas jelata jelatang jelau jelawat jelejeh jelek jelempah jelengar jelentik jelepak jelepok jeli jelih jelijih jelimet jelimpat jeling jelir jelit jelita jelma jelu jeluak jeluang jelujur jeluk jelum jelungkap jeluntung jelunut jelur-jelir jelus jelut jelutung jem jemaah jemaat jemah jemala jemang jemari jemaring jemawa jemba jembak jembalang jembar jembatan jembel jember jembiah jembrana jembut jemeki jemerlang jempalik jempalit jempana jemparing jempol jempul jemput jemu jemuas jemuju jemur jenahar jenak jenaka jenama jenang jenangau jenat jenawi jenayah jenazah jendal jendala jendel jendela jendera jenderal jendol jendul jenela jeneng jenewer jeng jengah jengang jengat jengek jenggala jenggar jengger jengget jengglong jenggot jengguk jenggul jenggut jengit jengkal jengkang jengkek jengkel jengkelit jengkeng jengker jengket jengki jengking jengkit jengkol jengkolet jengkot jenglong jenguh jenguk jengul jenis jenius jenjam jenjang jenjeng jentaka jentang jentayu jentelmen jentera jentik jentur jenu jenuh jepa jepet jepit jeprat jepret jepun jeput jera jerabai jeradik jeragih jerah jerahak jerahap jerait jeram jeramah jerambah jerambai jerambang jerambung jerami jeran jerang jerangan jerangau jerangkah jerangkak jerangkang jerangkong jerap jerapah jerat jerau jeraus jerawat jerba jerbak jereket jeremak jeremba jerembap jerembat jerembet jerembun jerempak jereng jerepet jeri jeriau jerigen jerih jeriji jeriken jering jeringing jerit jerjak jerkah jerkat jermal jermang jernang jernih jero jeroan jerohok jerojol jerongkang jerongkes jerongkok jerongkong jerubung jeruji jeruju jeruk jerukun jerukup jerum jerumat jerumbai jerumun jerumus jerun jerung jerungkau jerungkis jerungkung jerunuk jerupih jesben jet jetis jetsam jetset jewer jiawang jib jibaku jibilah jibrail jibril jibti jibun jicap jicapgo jicing jidal jidar jidat jidur jigong jigrah jih jihad jihat jijik jijit jijitsu jika jikalau jil jila jilah jilam jilat jilbab jilid jim jimahir jimak jimakir jimat jimawal jimbit jimpit jin jinak jinayah jindra jinem jineman jineng jingap jingau jingga jinggring jingkat jingkik jingkrak jingo jingoisme jingu jinjang jinjing jinjit jinsom jintan jip jipang jiplak jipro jir jirak jiran jirat jirian jirus jisim jitah jitak jitok jitu jiwa jiwat jiwatman jiwit jizyah jlegur joang jobak jobong jodang jodoh jodong jogan jogar joget joging joglo johan johar johari jojing jojol jok joki jolak jolek joli jolok jolong jolor jombang jomlo jompak jompo jongang jonget jongga jonggol jonggolan jongjorang jongkang jongkar-jangkir jongkat-jangkit jongki jongko jongkok jongkong jongos jonjot jontoh jontor jontrot joran joreng jori jorjoran jorok jorong josna jota jotang jotos joule jrambah jreng jua juadah juak jual juan juandang juang juar juara jubah jubel jubin jublag jublek judek judes judi judo judogi judoka judul juek juga juhi juhut juih juita juja jujah jujai jujitsu juju jujuh jujur jujuran jujut jukstaposisi jukut julab julai julang julat juli juling julir julo juluk julung julur jumadilakhir jumadilawal jumantan jumantara jumat jumbai jumbil jumbo jumbuh jumbul jumeneng jumhur jumjumah jumlah jumpa jumpalit jumpang jumpelang jumpul jumput jumrah jumud jun junam jundai jung jungat junggang jungkal jungkang jungkar jungkat jungkir jungkit jungkol jungur jungut juni junior junjung junta juntai juntrung junub junun jupang jura juragan jurai jurang juri jurik juring juris jurit jurnal jurnalis jurnalisme jurnalistik juru juruh jurung jurus jus justifikasi justru juta jute juvenil juz kaabah kaba kabah kabak kabang-kabang kabar kabaret kabat kabau kabel kabihat kabil kabilah kabin kabinet kabir kabisat kabit kaboi kabriolet kabruk kabu-kabu kabuki kabul kabumbu kabung kabupaten kabur kabus kabut kaca kacak kacam kacamata kacang kacapiring kacapuri kacar kacau kacau-balau kacauan kacek kacer kaci kacici kacip kacir kaco kacrek kacu kacuk kacukan kacung kad kada kadahajat kadal kadam kadang kadar kadariah kadas kadaster kadasteral kadastral kadaver kade kademat kadensa kader kadera kaderisasi kades kadet kadi kadim kadipaten kadir kadiriah kadmium kado kadofor kadok kadru kadung kadut kaedah kaf kafaah kafah kafan kafarat kafe kafeina kafetaria kafi kafil kafilah kafir kafiri kaftan kagak kaget kagok kagum kah kahaf kahak kahan kahang kahar kahat kahin kahrab kahwa kahwaji kahyangan kaidah kaifiah kaifiat kail kailalo kaimat kain kaing kainit kainofobia kais kaisar kait kajai kajang kajangan kajen kaji kak kakaban kakafoni kakagau kakah kakak kakaktua kakanda kakang kakao kakap kakas kakawin kakbah kakek kakerlak kaki kakodil kakofoni kakografi kakok kakologi kakostokrasi kakrupukan kaksa kaktus kaku kakuminal kakus kala kalah kalai kalajengking kalaka kalakanji kalakati kalakeran kalakian kalam kalamba kalamdan kalamin kalamisani kalamkari kalandar kalander kalang kalap kalar kalas kalat kalau kalaupun kalawija kalaza kalbi kalbu kaldera kaldron kaldu kalebas kaleidoskop kaleidoskopis kalem kalempagi kalender kaleng kali kalian kaliber kalibit kalibrasi kalibut kalicau kalifornium kaligraf kaligrafi kaligrafis kalih kalikausar kaliki kalimah kalimantang kalimat kalimatullah kalimatusyahadat kalimayah kalimpanang kalingan kalio kaliper kalipso kaliptra kalis kalistenik kalium kalk kalkalah kalkarium kalkasar kalkausar kalkopirit kalkosium kalkulasi kalkulator kalkulus kalkun kalo kalomel kalong kalongwese kalongwewe kalor kalori kalorimeter kalorimetri kalorisitas kalowatan kalpataru kalsedon kalsiferol kalsifikasi kalsinasi kalsit kalsium kalui kalumet kalung kalus kalut kam kama kamajaya kamal kamalir kamantuhu kamar kamarban kamariah kamas kamat kamba kamban kambang kambar kambeh kambeli kamber kambi kambing kambium kambrik kambrium kambuh kambus kambut kamelia kamera kamerad kamfana kamfer kamfor kamhar kami kamikaze kamil kamilmukamil kamir kamis kamisa kamisol kamisosolen kamit kamitua kamka kamkama kamomil kamp kampa kampai kampalogi kampanologi kampanye kampas kampemen kamper kampil kamping kampiun kampos kampret kampuh kampul kampung kampus kamrad kamsen kamsia kamu kamuflase kamus kan kana kanaah kanaat kanabis kanak-kanak kanal kanalisasi kanan kanang kancah kancap kanceh kancera kancil kancing kancung kancut kanda kandang kandar kandas kandel kandela kandi kandidat kandidiasis kandil kandis kandul kandung kandut kane kang kangar kangen kangka kangkang kangkung kangmas kangsa kangsar kangtau kanguru kanibal kanibalisasi kanibalisme kanigara kanilem kanina kanisah kanjal kanjang kanjar kanjeng kanji kanker kano kanoman kanon kanonir kanonis kanopi kans kansel kanselari kanselir kanser kanstof kanta kantan kantang kantar kantata kanti kantih kantil kantilever kantin kanto kantong kantor kantuk kantung kanun kanvas kanya kanyon kaok kaolin kaon kaos kaotis kap kapa kapabel kapah kapai kapak kapal kapan kapang kapar kaparinyo kapas kapasitans kapasitas kapasitor kapat kapel kapela kaper kapi kapilaritas kapiler kapiran kapis kapisa kapit kapita kapital kapitalis kapitalisme kapitalistis kapitan kapitol kapitulasi kapitulum kaplares kaplars kapling kaplok kapok kapon kaporit kappa kaprah kapri kaprikornus kapsalon kapsel kapstan kapster kapstok kapsul kapten kapu kapuk kapung kapur kapurancang kara karabin karaeng karaf karagen karah karahah karakter karakterisasi karakteristik karakterologi karam karamba karambol karamel karang karangkitri karangwulu karantina karaoke karap karapaks karapan karar karas karat karate karategi karateka karau karavan karawitan karbiah karbid karbida karbohidrase karbohidrat karboksil karbol karbolat karbolik karbon karbonado karbonan karbonat karbonil karbonisasi karborundum karburasi karburator karcis kardamunggu kardan kardia kardiak kardigan kardil kardinal kardiograf kardiografi kardiogram kardiolog kardiologi kardiovaskular karditis kardus karel karembong karena karengga kareseh-peseh karet kargo kari karib karibu karier karies karih karikatur karikatural karikaturis karil karim karimah karina karinasi karisma karismatik karismatis karitas karitatif karkas karkata karkum karkun karma karmina karminatif karnaval karnivor karosel karoseri karotena karotenoid karotis karpai karpel karper karpet karpopodil karsa karsinogen karsinogenik karsinologi karsinoma karst karteker kartel karti kartika kartilago kartografi kartogram karton kartonase kartotek kartu kartun kartunis karu karuan karuhun karun karung karunia karunkel karusi karut karya karyah karyasiswa karyat karyawisata kas kasa kasab kasabandiah kasad kasah kasai kasak-kusuk kasam kasang kasap kasar kasasi kasatmata kasau kasdu kasein kasemat kasemek kasep kaserin kaserol kaset kasi kasid kasidah kasih kasihan kasiku kasim kasima kasino kasintu kasip kasir kasiterit kaskade kaskaya kasmaran kasmir kasmutik kaspe kasrah kasregister kassia kasta kastal kastanyet kastel kasti kastrasi kastroli kasturi kasual kasualisme kasualitas kasuari kasuarina kasui kasuis kasuistik kasur kasus kasut kaswah kata katabalik katabatik katabolisme katadrom katafalk katafora katah katai katak kataka katakana kataklisme katakomba katalase katalepsi katalina katalis katalisasi katalisator katalisis katalisit katalog katalogisasi katalogus katamaran katang-katang katapel katar katarak katarsis katartik katastrofe katatoni katatonia katawi kate katebelece katedral kategori kategorial kategoris kategorisasi katek katekese katekis katekisasi katekismus katekumen katel katelum kater katering kates kateter katetometer kati katib katibin katifah katifan katik katil katimaha katimumul kation katir katirah katiti katode katok katolik katrol katuk katul katun katung katup katut katvanga katwal kau kaukab kaukasoid kaukus kaul kaula kauli kaum kaung kaupui kaus kausa kausal kausalitas kausatif kaustik kaustiksoda kaut kavaleri kaveling kaver kaviar kawa-kawa kawah kawak kawal kawan kawang kawanua kawasan kawat kawi kawih kawijayan kawin kawista kawruh kawuk kawula kawung kaya kayai kayak kayambang kayan kayang kayangan kayau kayu kayuh kayun kebab kebabal kebah kebaji kebal kebam kebas kebat kebaya kebayan kebel kebelet kebembem kebin kebiri keblangsak keblinger kebo kebon kebuk kebul kebuli kebun kebur keburu kebut kebyar kecai kecak kecalingan kecam kecambah kecamuk kecandan kecantol kecap kecapi kecar kece kecebong kecek kecele keceng kecepek kecer kecewa keci keciak kecibak kecibeling kecik kecil kecimik kecimpring kecimpung kecimus kecipak kecipir kecipuk kecit keciut kecoak kecoh kecombrang kecong kecrek kecu kecuali kecubung kecuh-kecah kecumik kecundang kecup kecut kedabu kedadak kedah kedai kedak kedal kedaluwarsa kedam kedang kedangkai kedangkan kedangsa kedap kedasih kedau kedaung kedayan kedebong kedek kedekai kedeki kedekik kedekut kedelai kedele kedemat kedemplung kedempung kedengkang kedengkik keder kedera kederang kedewaga kedi kedidi kedik kedikit kedip kedodoran kedok kedondong kedongdong kedongkok kedot keduduk keduk kedul kedumung kedung kedut keferdom kehel keibodan kejai kejam kejamas kejan kejang kejap kejar kejat kejawen kejen kejer keji kejibeling kejip kejolak kejora keju kejuju kejur kejut kek kekah kekal kekam kekandi kekang kekapas kekar kekara kekas kekat kekau kekawin kekeba kekebik kekeh kekek kekel kekemben kekep keker keki kekitir kekok kekol kekrupukan kelab kelabak kelabang kelabat kelabau kelabu keladak keladan keladau keladi kelah kelahi kelai kelak kelak-kelik kelak-keluk kelakah kelakanji kelakar kelalang kelam kelamai kelamarin kelambai kelambir kelambit kelambu kelambur kelamin kelamkari kelana kelandera kelang kelang-kelok kelangkan kelangkang kelanjar kelantang kelap kelapa kelar kelara kelarah kelarai kelaras kelari kelas kelasa kelasah kelasak kelasi kelat kelati kelawan kelayan kelayang kelayu kelder kelebat kelebek kelebet kelebu kelebuk kelebut keledai keledang keledar keledek kelejat kelek kelekap kelekatu kelelap kelelawar kelelesa kelelot kelemak-kelemek kelemantang kelemayar kelemayuh kelembahang kelembai kelembak kelemban kelembuai kelempai kelemping kelemton kelemumur kelemur kelencer kelendara keleneng kelengar kelenggara kelengkeng kelengkiak kelening kelenjar kelentang kelenteng kelentik kelenting kelentit kelentong kelentung kelenung kelenyit kelep kelepai kelepak kelepat kelepek kelepet kelepik kelepir kelepit kelepuk kelepur keler kelereng kelesa kelesah keleseh kelesek kelesot keletah keletak keletang keletar keleti keletik keletuk keletung kelewang keli kelian keliar kelibang kelibat kelicap kelici kelicik kelih kelijak kelik kelika kelikah kelikat keliki kelikih kelikik kelikir keliling kelilip kelim kelimat kelimpanan kelimpungan kelimun kelimut kelinci kelincir kelindan keling kelingking kelingsir kelining kelinjat kelintang kelintar kelinting kelip kelipat kelir keliru kelis kelisera kelisere kelit keliti kelitik keliwon kelobot kelobotisme kelocak keloceh kelodan keloelektrovolt keloid kelojot kelok kelokak kelola kelolong kelom kelombeng kelompang kelompen kelompok kelon keloneng kelonet kelong kelongkong kelongsong kelontang kelontang-kelantung kelontong kelonyo kelop kelopak kelor kelorak kelos kelosok kelotok keloyak keloyang keloyor kelp kelu kelua keluai keluak keluan keluang keluangsa keluar keluarga kelubak kelubi keluburan keluh kelui keluih keluk kelukup kelukur keluli kelulu kelulus kelulut kelumit kelumpang kelumun kelun keluna kelunak kelung kelupas kelupur keluron keluruk kelurut kelus kelusuh-kelasah kelut kelutum keluwung keluyuk keluyur kemah kemal kemala kemam kemamang keman kemandang kemandoran kemang kemangi kemarau kemari kemarin kemaruk kemas kemat kematu kematus kemayu kembal kembali kemban kembang kembar kembara kembatu kembayat kembeng kembera kembili kemboja kembol kembu kembuk kembung kembur kembut kemeja kemejan kemekmek kemelut kemenakan kemendalam kemendang kemendur kementam kemenyan kemerakan kemesu kemi kemih kemik kemilap kemiluminesens kemiri kemit kemlaka kemlandingan kemloko kemoceng kemokinesis kemon kemopsikiatri kemoterapi kempa kempal kempang kempas kempek kempes kempetai kempis kempit kemplang kempot kempu kempuh kempul kempunan kempung kemput kempyang kemu kemucing kemudi kemudian kemudu kemukus kemul kemumu kemuncak kemuncup kemundir kemung kemungkus kemuning kemunting kemurgi kemut kemutul ken kena kenaf kenal kenan kenang kenanga kenap kenapa kenapang kenari kenas kencan kencana kencang kencar kenceng kencing kencit kencong kencrang-kencring kencreng kencung kencur kendaga kendal kendala kendali kendana kendang kendara kendati kendayakan kendeka kenderi kendi kendil kendit kendo kendong kenduduk kendung kendungan kendur kenduri kenek keneker kenem kenematik kenes keng kengkang kengkeng kenidai kenikir kening kenohong kenong kenop kensel kental kentang kentar kentara kenteng kentrung kentung kentut kenur kenya kenyal kenyam kenyang kenyat kenyat-kenyit kenyet-kenyut kenyi kenyih kenyir kenyit kenyut keok keong kep kepada kepah kepai kepak kepal kepala kepalang kepam kepang kepar keparat kepayang kepecong kepek kepel kepencong kepeng keper keperancak kepet kepetang kepialu kepiat kepik kepil kepincut kepinding keping kepingin kepinis kepinjal kepiri kepis kepit kepiting keplak kepleset keplok kepodang kepoh kepol kepompong keponakan kepot keprak keprek kepret kepris kepruk kepuh kepuk kepul kepulaga kepundan kepundung kepung kepurun keputren kepuyuk kera kerabang kerabat kerabik kerabu keracak keracap keraeng kerah kerahi kerai kerajang kerajat kerak kerakah kerakal kerakap kerakeling keram kerama keraman keramas keramat keramba kerambil kerambit keramboja keramik keramikus kerampagi kerampang keramunting keran kerancang keranda kerang kerang-keroh kerangas kerangka kerangkai kerangkeng kerani keranjang keranjat keranji keranjingan keranta kerantong kerap kerapu keras kerasan kerat keratabasa keratin keratitis keratoelastin keraton kerau kerawai kerawak kerawang kerawat kerawit kerbang kerbat kerbau kerbuk kercap-kercip kercap-kercup kercing kercit kercup kercut kerdak kerdam kerdil kerdom kerdut kere kerebok kereceng kerecik keredak keredep keredok keredong kerek kereket kerekot kerekut keremi keremot kerempagi kerempeng kerempung keremus keren kerencang kerencung kerendang kereneng kereng kerengga kerenggamunggu kerengkam kerengkiang kerentam kerentang kerenting kerenyam kerenyot kerepas kerepek kerepes kerepot kerepyak kerese kerese-pese keresek kereseng keresot kereta keretan keretek keretot keretut kereweng keri keriang-keriut keriap kerias keriau kerical kericau keridas keridik kerih kerik kerikal kerikam kerikil kerikit kerimut kerinan kerincing kerinding kering keringat keriningan kerinjal kerinjang kerinjing kerintil kerinting kerip keripik keriput keris kerisi kerisik kerising kerisut kerit keritik keriting keriuk keriut kerja kerjang kerjantara kerjap kerkah kerkak kerkap kerkau kerkop kerkup kerlap kerling kerlip kermak kermanici kermi kernai kerneli kernet kernu kernyat-kernyut kernyau kernyih kernying kernyit kernyut kero kerobak kerobat kerobek keroco kerocok kerogen keroh kerok kerokot keromong keron keroncang keroncong keroncor kerong kerongkongan kerongsang kerontang kerop keropak keropas-keropis keropeng keropok keropong keropos kerosak kerosek kerosi kerosin kerosok kerosong kerot kerotak kerotot keroyok kerpai kerpak kerpas kerpubesi kerpuk kerpus kers kersai kersak kersang kersani kersen kersik kersuk kertaaji kertah kertak kertang kertap kertas kertau kertuk kertus keruan kerubim kerubin kerubung kerubut kerucil kerucut kerudung keruh keruing keruit keruk kerukut kerul keruma kerumit kerumuk kerumun kerumus kerun kerung kerunkel keruntang-pungkang kerunting keruntung kerunyut kerup kerupuk kerut kerutak kerutup keruyuk kes kesah kesak kesal kesam kesambet kesambi kesan kesana kesandung kesang kesangsang kesap-kesip kesasar kesat kesatria kesek kesel keselak keseleo kesemek kesengsem keseran keseser keset kesi kesiap kesik kesima kesimbukan kesini kesip kesitu kesiur keskul kesmaran kesohor kesomplok kesongo kesot kesrakat kesting kesturi kesu-kesi kesuh-kesih kesuk-kesik kesuma kesumat kesumba kesup kesusu kesut keta ketaban ketai ketak ketakar ketakong ketal ketam ketambak ketampi ketan ketang ketap ketapak ketapang ketapek ketar ketarap ketat ketaton ketawa ketaya ketayap ketegar ketek ketel ketela ketemu keten ketena keteng ketepel ketepeng keter ketes keteter ketgat keti ketiak ketial ketiap ketiau ketiban ketiding ketik ketika ketil ketilang ketimbang ketimbis ketimbul ketimbung keting ketinjau ketinting ketip ketiplak ketipung ketirah ketis ketitir ketlingsut ketogenesis ketok ketola ketombe keton ketonemia ketonggeng ketonuria ketopong ketoprak ketosa ketrek ketu ketua ketuat ketuban ketuir ketuk ketul ketumbar ketumbi ketumbit ketumbu ketumpang ketun ketungging ketup ketupa ketupat ketupuk ketur ketus kev kewalahan keweni kewer kewes kewuh kha khabis khadam khadim khafi khair khairat khalas khalayak khali khalifah khalifatulah khalifatullah khalik khalikah khalikul khalil khalilullah khalis khalwat khamar khamir khamsin khamzab khanjar kharab khas khasi khasiat khat khatam khatib khatifah khatimah khatulistiwa khauf khaul khawas khawasulkhawas khawatir khayal khayali khazanah khi khianat khiar khidaah khidmah khidmat khilaf khilafiah khinzir khisit khitah khitan khitbah khizanatulkitab khoja khojah khotbah khuduk khulafa khuldi khuluk khunsa khurafat khusuf khusus khusyuk kia kiah kiai kiak kial kiam kiamat kiambang kian kiang-kiut kiani kiap kiar kiara kias kiasi kiasmus kiat kiaupau kibang kibar kibas kibernetika kibir kiblat kiblik kibriah kibul kicang-kecoh kicang-kicu kicau kici kicik kicu kicuh kicut kida-kida kidal kidam kidang kidar kidul kidung kifayah kifoskaliose kifoskaliosis kihanat kijai kijang kijil kijing kikih kikik kikil kikir kikis kikitir kikuk kikus kila kilah kilai kilan kilang kilap kilar kilas kilat kilau kili kilik kilir kiln kilo kilogram kilohertz kilokalori kiloliter kilometer kiloton kilovolt kilowatt kilowattjam kilus kim kima kimah kimantu kimar kimbah kimbang kimbul kimia kimiawi kimkha kimlo kimo kimograf kimono kimpal kimpul kimpus kimput kimus kina kinang kinantan kinanti kinasa kinasih kinca kincah kincak kincang kincau kincir kincit kincung kincup kindap kinematika kinematograf kinesika kinesimeter kineskop kinestesia kinestesiometer kinestesis kinetik kinetika kinetokardiografi kingking kingkip kingkit kingkong kini kinine kinja kinjat kinjeng kinred kintaka kintal kinte kintil kinyang kio kios kipa kipai kipang kipas kiper kiprah kiprat kipsiau kipu kir kira kiraah kiraat kirab kirai kiramat kiran kirana kirap kiras kirau kirbat kiri kirik kirim kirinyu kirip kiris kirita kirmizi kiru kiruh kirung kisa kisah kisai kisar kisas kisat kisi kisik kismat kismis kisruh kista kisut kiswah kit kita kitab kitabulah kitang kitar kitik kitin kiting kitir kitorang kitri kits kiu kiuk kiwari kiwi kizib klaim klakklik klakson klamidospora klan klandestin klangenan klante klarifikasi klarinet klasemen klasifikasi klasik klasikal klasis klasisisme klausa klaustrofobia klaustrum klausul klaver klavikor klavikula klaviola kleder kleidotomi kleistogami klem klemensi klen klenengan klengkeng klenik klenteng klep klepon klepsidra kleptofobi kleptoman kleptomania kleptomaniak klerek klerikal klerikus klerus klien klik kliker klimaks klimakterium klimaktorium klimatografi klimatolog klimatologi klimis klimograf klimosekuen klimoskop klin klinik klining klinis klinisi klinometer klip kliping klir kliring klise klistron klitelum klitik klitoris kliwon kliyengan kloaka klon klona kloning klonograf klonus klop klor kloral kloramina klorat klorida kloridimeter klorin klorinasi klorit klorobenzena klorofil kloroform kloroformat klorokuin klorolignin kloroplas kloroprena klorosis kloset klub kluntang-kluntung klusium knalpot knop knot koa koagel koagregasi koagulan koagulasi koak koaksi koaksial koala koalisi koana koar kobah kobak kobalamin kobalt kobar kober koboi koboisme kobok kobol kobongan kobra kocah-kacih kocak kocar-kacir kocek koci kocilembik kocoh kocok kocolan kocong kocor koda kodak kode kodein kodeks kodi kodifikasi kodok kodominan kodrat kodrati koe koedukasi koefisien koeksistensi koenzim koersi koersif kofaktor kofein kofermen kognat kognatif kognisi kognitif koh kohabitasi koheren koherensi kohesi kohesif kohir kohlea kohol kohong kohor koil koin koinseden koinsiden koinsidensi koipuk koit koitus koja kojah kojang kojoh kojol kojor kok koka kokah kokaina kokainisasi kokainisme kokang kokarde kokas koki kokila koklea kokoa kokoh kokok kokokbeluk kokol kokon kokosan kokot kokpit koksa koktail kokurikuler kokus kol kola kolaborasi kolaborator kolagen kolak kolam kolang-kaling kolaps kolaret kolase kolateral kolator kolega kolegial kolegialitas koleh-koleh kolek koleksi kolekte kolektif kolektivis kolektivisasi kolektivisme kolektivitas kolektor kolembeng kolemia koleng koleoptil kolera kolese kolesom kolesterin kolesterol koli kolibri koligasi kolik kolimasi kolina kolintang koliseng kolitis kolkhoz kolodion kolofon kologen koloid koloidal kolok kolokasi kolokium kolom kolomben kolon kolone kolonel kolong koloni kolonial kolonialis kolonialisme kolonis kolonisasi kolonoskop kolonye kolor kolorimeter kolorimetri kolosal kolosom kolostomi kolostrum kolot kolportir kolt kolum kolumela kolumnis kolumnus kolusi koluvium kom koma komaliwan koman komandan komandemen komanditer komando komaran komat-kamit kombat kombinasi kombo kombor kombusio komedi komedian komendur komeng komensal komensalisme komentar komentator komersial komersialisasi komet komfortabel komidi komik komikal komikus kominusi komis komisar komisariat komisaris komisi komisioner komisura komit komite komitmen komkoma komoditas komodo komodor kompak kompanyon komparasi komparatif komparator kompartemen kompas kompatibel kompatibilitas kompatriot kompendium kompeni kompensasi kompes kompeten kompetensi kompetisi kompetitif kompetitor kompi kompilasi kompilator komplain kompleks kompleksitas komplemen komplementer komplet komplikasi komplikatif komplimen komplot kompon komponen kompong komponis kompor kompos komposer komposisi komposit kompositum komprador komprang komprehensif kompres kompresi kompresor kompromi kompromistis kompulsi kompulsif komputer komputerisasi komtabilitas komunal komunalisme komunalistik komune komuni komunikabilitas komunikan komunikasi komunikatif komunikator komunike komunis komunisme komunistis komunistofobi komunistofobia komunitas komutator komuter konan konco koncoisme kondang kondangan konde kondektur kondensasi kondensat kondensator kondensor kondilus kondisi kondom kondominium kondomisasi kondor kondrin kondroblas konduite konduksi konduktans konduktimeter konduktivitas konduktor kondusif koneksi koneksitas konektor konfederasi konfeksi konferensi konfesi konfigurasi konfiks konfirmasi konflik konform konformitas konfrontasi konfrontatif kongenital kongesti kongkalikong kongko kongkoan kongkong kongkret konglomerasi konglomerat kongregasi kongres kongresis kongsi konifera konis konjugan konjugasi konjungsi konjungter konjungtif konjungtiva konjungtivitis konjungtor konjungtur konkaf konklaf konklusi konklusif konkologi konkomitan konkordansi konkordat konkresi konkret konkretisasi konkuisnador konkuren konkurensi konkurs konoid konon konosemen konotasi konotatif konperensi konsekrasi konsekuen konsekuensi konsekutif konseli konseling konselor konsensus konsentrasi konsentrat konsentrik konsentris konsep konsepsi konsepsional konseptor konseptual konseptualisasi konser konsertina konserto konservasi konservasionis konservatif konservatisme konservator konservatori konservatorium konsesi konsesif konsesional konsiderans konsiderasi konsili konsiliasi konsinyasi konsinyatir konsisten konsistensi konsistori konsol konsolasi konsolidasi konsonan konsonansi konsonantal konsorsium konspirasi konspiratif konspirator konstabel konstan konstanta konstantagravitasi konstatasi konstatatif konstatir konstelasi konstipasi konstituante konstituen konstitusi konstitusional konstitusionalisme konstriksi konstriktor konstruksi konstruktif konstruktivisme konsul konsulat konsulen konsuler konsultan konsultasi konsumen konsumer konsumerisme konsumsi konsumtif kontak kontal-kantil kontaminasi kontan kontang-kanting konte konteks kontekstual kontekstualisme kontemplasi kontemplatif kontemporer konten konter kontes kontestan kontet kontiguitas kontinen kontinental kontingen kontinu kontinuitas kontinum kontoid kontol kontra kontrabande kontrabas kontradiksi kontradiktif kontraindikasi kontrak kontraksi kontraktor kontraktual kontraproduktif kontras kontrasepsi kontraseptif kontravensi kontribusi kontributor kontrol kontrolir kontroversi kontroversial kontur konus konveks konveksi konvektif konvensi konvensional konvergen konvergensi konversasi konversi konveyor konvoi konvolusi konvulsan konvulsi konyak konyal konyan konyol kooperasi kooperatif kooperativisme kooperator kooptasi koordinasi koordinat koordinatif koordinator kop kopah kopaiba kopak kopal kopat-kapit kopbal kopek kopel kopelrim koper koperasi kopet kopi kopiah kopilot kopling koplo kopok kopolimer kopong kopra koprafagia koprak kopral koprok koprol koprolit kopula kopulasi kopulatif kopyok kopyor kor koral koralit koran korano korban korden kordial kordit kordon korduroi kored koreferensialitas korek koreke koreksi korektif korektor korelasi korelatif korenah koreng koreograf koreografer koreografi koreografis kores koresponden korespondensi koret koridor korion kornea kornel korner kornet koroh koroid korok korologi korona koronal koroner korong korosi korosif korporasi korporat korporatif korporatisme korps korpulensi korpus korsase korsel korselet korset korsleting korteks kortikulus korting kortison korugator korundum korup korupsi koruptif koruptor korve korvet kosak-kasik kosakata kosar kosbas kosek kosekan kosel kosen koset kosinus kosmetik kosmetilogi kosmetolog kosmetologi kosmetologis kosmis kosmogoni kosmografi kosmologi kosmologis kosmonaut kosmopolit kosmopolitan kosmopolitanisme kosmos kosmotron kosokbali kosong kostum kota kotah kotai kotak kotak-katik kotaklema kotek koteka koteks koteng koterek kotes kotiledon kotipa kotok kotong kotor kotrek kovalensi kover kowan kowek koyak koyam koyan koyok krai krakal kram krama kranapaksa krangeyan kraniologi kraniometri kraniotomi kranium krans krasis krayon kreasi kreatif kreativitas kreator krebo krecek kredibilitas kredit kreditabel kreditor kredo krem kremasi krematori krematorium kreol kreolin kreolisasi kreosol kresendo kresol kretin kribo kricak krida krifoli krim kriminal kriminalis kriminalisasi kriminalitas kriminolog kriminologi kriminologis kring krio kriofil kriofit kriogen kriogenika krioterapi kripta kriptogam kriptografi kriptogram kriptol kripton krisan krisantemum krisis krisma krisoberil krisofil krisolit krisopras krista kristal kristalisasi kristalografi kristaloid kristalosa kristen kristiani kristus kriteria kriterium kritik kritikus kritis kritisi kriya krobongan kroco kroket krol krom kromat kromatid kromatika kromatin kromatis kromatofor kromatografi kromit kromium kromo kromofil kromofob kromogen kromong kromosfer kromosom kromotropi krompyang kronem kroni kronik kroniometri kronis kronisme kronobiologi kronogram kronologi kronologis kronometer kronosekuen kronoskop krosboi kroto kru kruistik kruk krukat krusial krustasea ksatria ksi kuaci kuadran kuadrat kuadratika kuadratur kuadratus kuadrenium kuadriliun kuadripartit kuadrisep kuadrupel kuadrupleks kuadruplet kuah kuai kuak kuala kualat kuali kualifikasi kualitas kualitatif kualon kuang kuangkiut kuangwung kuantifikasi kuantitas kuantitatif kuantum kuap kuar kuari kuarik kuark kuarsa kuarsit kuart kuartal kuarter kuarterner kuartet kuartil kuarto kuas kuasa kuasar kuasi kuat kuatren kuau kuaya kuayah kuayan kubah kubak kubang kubik kubil kubin kubis kubisme kubistik kubit kuboid kubra kubti kubu kubul kubung kubur kubus kucai kucak kucam kucandan kucar-kacir kucek kucel kucica kucil kucindan kucing kucir kucup kucur kuda kudai kudang kudap kudeta kudi kudian kudis kudu kuduk kudung kudup kudus kue kueni kuesioner kuetiau kufu kufur kui kuih kuil kuilu kuin kuing kuini kuinina kuintal kuintesens kuintet kuintil kuintiliun kuintuplet kuir kuis kuit kuitansi kujang kujarat kujung kujur kujut kuk kukabura kukai kukang kukila kuku kukuh kukuk kukul kukup kukur kukuruyuk kukus kulah kulai kulak kulakasar kulan kulansing kulasentana kulat kulawangsa kuli kuliah kulik-kulik kulikat kulim kulimat kuliner kulintang kulir kulit kulkas kulminasi kulon kulot kult kultivar kultivasi kultur kultural kulturisasi kultus kulub kuluk kulum kulup kulur kulut kulzum kuma-kuma kumai kumal kuman kumandang kumanga kumat kumba kumbah kumbang kumbar kumbik kumbu kumbuh kumena kumico kuminter kumis kumkuma kumpai kumpal kumpar kumpi kumpul kumuh kumulasi kumulatif kumulonimbus kumulus kumur kumus kumut kunang-kunang kunani kunar-kunar kunarpa kunca kuncah kuncen kunci kuncir kuncit kuncung kuncup kundai kundang kundi kundur kunduran kunfayakun kung kungfu kungkang kungki kungkum kungkung kuning kuningan kunjung kuno kunta kuntau kuntilanak kuntit kuntuan kuntul kuntum kuntung kunut kunyah kunyam kunyit kunyuk kuorum kuosien kuota kup kupa kupahan kupak kupang kupas kupat kupat-kapit kupe kupel kupi kupil kuping kupir kuplet kupluk kupnat kupon kuproprotein kuprum kupu-kupu kupui kupur kur kura kurai kurambit kurang kurap kuras kurasani kurasao kuratif kurator kuratorium kurau kurawal kurban kurcaci kuren kuret kuretase kuria kuricak kurigram kurik kurikuler kurikulum kuring kuriositas kuripan kurir kuririk kurium kurkatovium kurkuma kurltase kurma kurs kursemangat kursi kursif kursor kursus kurtase kurun kurung kurus kuruyuk kurva kurvalinier kurvatur kus kusa kusal kusam kusanin kusat-mesat kusau kusen kusik kusir kuskus kuspis kusruk kusta kusu kusuf kusuk kusuma kusut kuta kutaha kutak kutang kutat kutat-kutet kuteks kuteri kuti kutik kutikula kutil kutin kuting kutip kutu kutub kutubaru kutubusitah kutuk kutung kutut kuud kuwu kuwuk kuwung-kuwung kuwur kuya kuyang kuyu kuyuh kuyup kwartet kwartir kwasiorkor kweni kwetiau kwosien laal lab laba labah-labah labak labang labas label labelum laberang labi-labi labial labialisasi labil labiodental labiovelar labirin labium laboran laboratoris laboratorium labrak labrakan labrang labres labrum labu labuda labuh labun labur labut lacak laci lacur lacut lada ladah ladam ladan ladang laden ladi lading ladu ladung lafal laga lagak lagam lagan lagang lagau lagi lagiah lago lagonder lagu laguh-lagah laguna lagwu lah lahab lahad lahak lahan lahang lahap lahar lahir lahiriah lai laici laif laik lailah lailatulkadar lain lais laja lajak lajang lajat lajnah laju lajur lak lakab lakak lakar lakara laken lakeri laki laklak laklakan lakmus laknat laknatullah lakon lakonik lakonisme lakrimator laksa laksamana laksana laksatif laksmi laktase laktasi laktat laktogen laktoglobulin laktometer lakton laktosa laku lakum lakuna lakur lakustrin lala lalah lalai lalak lalandak lalang lalap lalat lalau laler lali lalim lalu lalu-lalang lam lama lamalif laman lamang lamar lamat-lamat lambai lambak lamban lambang lambar lambat lambda lambe lambert lambit lambo lambu lambuk lambung lambur lamdukpai lamela lamender lamin lamina laminah laminasi laminating lampai lampam lampan lampang lampar lampas lampau lampeni lampes lampias lampik lampin lamping lampion lampir lampit lampok lampor lampu lampung lampus lamtoro lamtoronisasi lamun lamur lamusir lana lanang lanar lanau lanbau lanca lancang lancap lancar lancia lancing lancip lancit lancong lancung lancur lancut landa landahur landai landak landang landap landas landau landors landrad landuk landung landur lang langah langak-languk langau langen langendrian langenswara langgah langgai langgam langgan langgang langgar langgas langgayan langgeng langguk langgung langi langir langis langit langka langkah langkai langkan langkang langkap langkara langkas langkat langkau langking langkisan langkitang langkong langkup langlai langlang langsai langsam langsang langsar langsat langse langseng langsep langsi langsing langsir langsuir langsung langu langut lanhir lanja lanjai lanjak lanjam lanjang lanjar lanjau lanji lanjuk lanjung lanjur lanjut lanolin lanset lansia lansir lanskap lantah lantai lantak lantam lantan lantang lantanum lantar lantas lantera lantesari lantik lantin lanting lantip lantun lantung lantur lanugo lanun lanus lanyah lanyak lanyau laocu laos laoteng lap lapah lapak lapang lapar laparoskop laparoskopi lapat-lapat lapektomi lapel lapih lapik lapili lapir lapis lapislazuli lapo lapor laptop lapuk lapun lapur lara larah larai larak laram larang larap laras larat larau largisimo largo lari larih larik laring laringal laringitis laringoskop laris larnaks laron lars laru larung larut larva larvarium las lasa lasah lasak lasana lasat laser lasi lasinia laskar laso lasparaginase lasuh lat lata latah latak latam latar latas lateks laten latensi lateral laterit latif latifundium latih lating latis latma latosol latuh latuk latung latur lauh lauk laun laung laur laut lauya lava lavase lavendel lavender lawa lawah lawak lawalata lawamah lawan lawang lawar lawas lawat lawazim lawe lawean lawi lawina lawon lawrensium layah layak layam layan layang layap layar layas layat layer layon layu layuh layuk layung layur layut laza lazim lazuardi leak lebah lebai lebak lebam leban lebang lebap lebar lebaran lebas lebat leber lebih lebuh lebuk lebum lebun lebung lebur lecah lecak lecap lecat leceh lecek lecer lecet leci lecit leco lecok lecuh lecun lecup lecur lecut ledak ledang ledek ledeng ledes leding ledos ledre leduk ledung lefa lega legak-legok legal legalisasi legalitas legam legap legar legasi legasteni legat legataris legato legator lege legek legen legenda legendaris leger leges leghorn legi legih legio legislasi legislatif legislator legisme legit legitimaris legitimas legitimasi legitimitas legiun lego legok legong legu legum legunder legundi legung legup-legup leha-leha lehar leher lei leja lejang lejar lejas lejit lejok leka lekah lekak-lekuk lekam lekang lekap lekap-lekup lekar lekas lekat lekemia lekir lekit lekok lekosit leksem leksikal leksikograf leksikografi leksikografis leksikolog leksikologi leksikon leksikostatistik leksis lekton lektor lektur leku lekuh-lekih lekuk lekum lekun lekung lekup-lekap lela lelabah lelah lelai lelaki lelancur lelang lelangon lelangse lelap lelar lelas lelat lelatu lelawa lele leleh lelembut lelemuku lelep leler leles lelet lelewa leli lelonobroto leluasa lelucon leluhur leluing lelung lelungit leluri lem lema lemah lemak lemang lemari lemas lemata lemau lembaga lembah lembai lembak lembam lemban lembang lembap lembar lembayung lembega lembek lembeng lembidang lembing lembok lembora lembu lembung lembur lemburu lembut lemena lemender lemes lemidi lemo lemon lempah lempai lempang lempap lempar lempari lempaung lempem lempenai lempeng lemper lemping lempit lempoh lempuh lempuk lempung lempuyang lempuyangan lemur lemuru lemusir lena lenan lencana lencang lenceng lencet lenci lencir lencit lencong lencun lenda lendaian lendeh lender lendir lendot lendung lendut leng lenga lengah lengai lengak lengan lengang lengar lengas lengat lenge lenggak lenggana lengganan lenggang lenggara lenggek lengger lenggok lenggong lenggor lenggundi lenggut lengit lengkai lengkanas lengkang lengkap lengkara lengkeng lengkesa lengket lengkiang lengking lengkitang lengkok lengkong lengkuas lengkung lengkur lengoh lengong lengos lengseng lengser lengset lenguh lengung lening lenis lenitrik lenja lenjan lenjaran lenjing lenjuang lenong lenor lens lensa lenser lenset lenso lentam-lentum lentang lentang-lentok lenteng lentera lentik lenting lentisel lentoid lentok lentong lentuk lentum lentung lentur lentus lenung lenyah lenyai lenyak lenyap lenyau lenyeh lenyet lenyut leo leonid leontin leot lepa lepai lepak lepang lepap lepas lepat lepau lepe lepek leper leperi lepes lepet lepih lepik lepit leplap lepoh lepok lepot lepra leproma lepromin leproseri leptodos leptoskop leptosom lepu lepuh lepuk lepur lerah lerai lerak lerang lerap lereng leret lerok lerot lerum les lesa lesak lesan lesang lesap lesat lesbi lesbian lesbianisme leseh lesek leset lesi lesing lesir lesit lesitin lesitina lesnar lesot lesplang lestari lestek lesterung lesu lesung lesus lesut leta letai letak letal letalitas letang letargi lete-lete letek leter leterseter letih letik leting letis letnan letoi letos letraset letuk letum letung letup letur letus leuca leukemia leukoderma leukofit leukoma leukonisia leukopenia leukoplakia leukore leukorea leukosit leukositometer leukositosis level lever leveransir levertran levirat levitin levulosa lewa lewah lewar lewat lewisid lewu leyeh leyot lezat liabilitas lian liana liang liangliong liar lias liat liau libas libat libei liberal liberalis liberalisasi liberalisme liberalistis liberasi liberator libero libidis libido libitum libra librasi libreto libur licak licau lici licik licin licurai lid lidah lidas lidi lidid lifo lift lifter liga ligamen ligan ligar ligas ligasi ligat ligatur ligih lignin lignit lignoselulosa lignosulfonat lignotuber lihai lihat lik likantropi likas likat likir liku likuid likuida likuidasi likuiditas likur likut lil lila lilah lilan lilau lili lilin liliput lilit lillahi lima liman limar limas limau limbah limbai limbak limban limbang limbat limbing limbubu limbuk limbung limbur limfa limfadema limfadenitis limfadenoma limfadenosis limfaderitis limfangioma limfatik limfoblartoma limfoblas limfoblastoma limfografi limfoma limfonodus limfopenia limfosit limfositopenia limfositosis limit limitasi limitatif limnetik limnologi limnoplankton limpa limpah limpap limpapas limpas limpau limpit limpoh limpung limun limusin limut lin linang linau lincah lincak lincam lincir lincun lindak lindang lindap lindas lindik lindis lindu lindung lindur linear linen ling lingar lingat lingga linggam linggata linggayuran linggi linggis lingkap lingkar lingkawa lingkis lingkung lingkup linglung lingsa lingsang lingsir lingsu lingua linguafon linguis linguistik lini linier linimen lining linjak linoleum linsang lintabung lintadu lintah lintang lintap lintar lintas lintibang linting lintir lintuh lintup linu linuhung linyak linyar lio liofilisasi liong liontin lipai lipan lipas lipase lipat lipektomi lipemia lipid lipiodol lipit liplap lipoksidase lipolisis lipoprotein lipstik lipu lipur liput lir lira lirida lirih lirik liris lis lisah lisan lisani lisensi lisimeter lisis lisol lisong lisplang lister listeria listrik lisu lisus lisut litah litak litani liter literator literer litium litografi litologi litoral litosfer litotes litotomi litsus liturgi liturgis liuk liung-liung liur liut liver liwa liwan liwat liwet loak lob loba lobak loban loberci lobi lobster locok locot lodan lodeh lodoh lodong log logam logaritma logat logawiah logika logis logistik logo logogram logopedia logotip loh loha lohok lohor loji lok loka lokacipta lokakarya lokal lokalis lokalisasi lokan lokananta lokap lokasi lokastiti lokatif lokatikranta lokatraya lokawarta lokawidura lokawigna lokawiruda lokawisata lokcuan lokek lokeswara loket loki lokia lokika lokio loklok lokomobil lokomotif lokos loksek loksun loktong lokus lokusi lolak loleng loloh lolohan lolong lolos lomba lombar lombok lombong lomek lomot lompat lompayang lompok lompong lonan loncat loncek lonceng loncer lonco loncos londang londong loneng long longak-longok longdres longgar longgok longgor longitudinal longo longok longong longser longsor longtorso lonjak lonjong lonjor lonsong lontai lontang-lanting lontang-lantung lontar lontara lonte lontok lontong lonyok lop lopak lopek loper lopis lopor lor lorah loran lorat lorber lorek loreng lori lornyet lorong lorot los lose losin losion losmen loso losong lot lota lotak lotek loteng lotis lotong lotre lotus lowong loya loyak loyal loyalis loyalitas loyang loyar loyo loyong lozenge luah luak luang luap luar luas luat luban lubang luber lubuk lucah lucu lucup lucut ludah ludat ludes luding ludruk lues lugas lugu lugut luhak luhmahful luhung luhur luih luik luing luk luka lukah lukat lukeh lukis luks luku lukut lulai luli luluh luluk lulum lulup lulur lulus lulut lum lumai lumang lumar lumas lumat lumayan lumba-lumba lumbal lumbu lumbung lumen lumer lumi-lumi luminositas lumpang lumpektomi lumpia lumping lumpuh lumpuk lumpur lumrah lumsum lumuh lumur lumus lumut lunak lunas lunau luncai luncas luncung luncur lundang lundi lundu luner lung lungguh lungkah lungkang lungkum lunglai lunglung lungsar lungse lungsin lungsung lungsur lungun lunjur lunta luntang luntang-lantung luntas luntur lunyah lunyai lup lupa lupat lupi lupuh lupuk lupung lupus luput lurah lurik luru lurub luruh lurus lurut lus lusa lusin lustrum lusuh lut lutetium luti lutu lutung lutut luweng luwes luyak luyu luyut maab maaf mabriuk mabrur mabuh mabuk mabul macakal macam macan macapat mace macet macis mad mada madah madali madam madang madani madar madat madewi madi madia madik madinding madmadah mado madona madras madrasah madu madukara madumangsa madya maesan maesenas maestro mafela mafhum mafia mafioso mafsadah mafsadat mag magainin magalah magandi magang magasin magel magenta magersari magfirah magfirat magi magis magister magistrat maglub magma magnesium magnesol magnet magnetik magnetika magnetis magnetisme magnetit magnetometer magnetor magnetostatika magnitudo magrib magribi magrur magun mah maha mahabah mahabintang mahadewa mahadewi mahaduta mahaguru mahah mahajana mahakala mahakarya mahakuasa mahal mahamen mahamenteri mahamulia mahang mahaparana mahapatih mahar maharaja maharajalela maharana maharani mahardika maharesi maharupa mahasiswa mahasiswi mahasuci mahatahu mahatma mahatur mahayana mahbub mahbubah mahbubat mahdi maherat mahesa maheswara mahfuz mahia mahimahi mahir mahkamah mahkota mahligai mahmud mahoni mahraj mahram mahsul mahsyar mahwu mahyong mahzurat mai maido maimun main mair mairat maizena maja majaan majakane majakaya majakeling majal majalah majas majasi majati majedub majekeling majelis majemuk majenun majikan majir majizat majong maju majuh majuj majun majung majusi maka makadam makadasang makadok makalah makalangkang makam makan makantah makantuh makanya makao makaopo makar makara makaroni makas makbud makbul makcik makcomblang makda makdan makelar makerel maket makhdum makhluk makhraj maki makin making makiyah makjuj maklaf maklum maklumat maklun makmal makmum makmur makna maknawi makramat makrame makrifat makrifatullah makro makroekonomi makrofita makrofotografi makroftalmus makrogametosit makrohistori makrokosmos makrokriminologi makrolinguistik makromelia makrometeorologi makromolekul makrosefalik makroskopis makrosmatik makrosmatis makrososiologi makruf makruh maksi maksiat maksila maksim maksimal maksimum maksud maksum maktab maktub makua makul makula makulat makurung makuta makyong makzul mal mala malabau malabsorpsi malafide malafungsi malagandang malagizi malah malai malaik malaikat malaikatulmaut malaise malak malaka malakama malakat malakit malakofili malakologi malakut malam malan malang malangbang malap malapari malapetaka malapraktek malapraktik malar malaria malas malasia malasuai malatindak malau maldistribusi male maleman maleo maleolus mali-mali maligai malih malik maliki malikuljabar malikulmuluk malim maling malis malisol malka malnutrisi maloklusi malt maltase maltosa malu malun malung mam mama mamah mamai mamak mamalia maman mamanah mamanda mamang mamano mamat mambang mambek mambo mambruk mambu mambung mamduhah mami mamik mamlakat mamografi mampai mampat mampir mampu mampung mampus mamut man mana manah manai manajemen manajer manajerial manakala manakan manakib manalagi manasik manasongo manasuka manau mancanegara mancawarna manci mancis mancung mancur manda mandah mandai mandala mandalika mandam mandar mandarin mandat mandataris mandau mandeh mandek mandelevium mandi mandibula mandil mandir mandiri mandolin mandor mandraguna mandril mandrin mandul mandung maneken manerisme manfaat mang mangan mangap mangas mangau mangayubagya mangga manggah manggala manggar manggis manggung mangir mangkak mangkanya mangkar mangkas mangkat mangkel mangkih mangkir mangkok mangkubumi mangkuk mangkus mangsa mangsi mangu mangun mangut mani mania maniak manifes manifestasi manifesto manik manik-depresif manikam manikdepresi manikmaya manikur manila manimba manipol manipulasi manipulatif manipulator manira manis manise manja manjapada manjau manjeri manjing manjung manjur manol manometer manora manostat manset mansiang mansukh manta mantan mantap mantari mantel manten manti mantik mantiki mantisa mantissa mantra mantram mantri mantu mantuk manual manufaktur manufakturing manuk manumisio manumpak manunggal manusia manusiawi manuskrip manut manuver manuwa manyala manyar manzil manzilah maois map mapak mapalus mapan mar mara marah maraja marak marakas marambung maramus maranta marapulai maras maraton marbling marbut marcapada mardatilah mardatillah mardud mare marem maret marfuk marga margalit margarin margasatwa margin marginal marginalisasi marginalisme margrit marhaban marhaen marhaenis marhaenisme marhum marhumah mari maria marikan marikh marikultur marimu marina marinade marine marinir marinyo marinyu marital maritim mariyuana marjik marjinal mark marka markado markah markas markasit marketri markis markisa markoni markonis markusip marlin marmelade marmer marmot maro marpaud mars marsaoleh marsekal marsepen marsose martabak martaban martabat martandang martil martini martir maru marus marut marwas marxisme marzipan mas masa masai masak masakan masakat masala masalah masam masap masase masayu masbuk masdar masehi masektomi maser maserasi mashaf masif masih masin masinal masing-masing masinis masir masjid masjidilaksa masjidilharam maskanat maskapai maskara maskat maskawin masker maskodok maskon maskot maskulin maskulinitas maskumambang maslahat masnawi masohi masoi masokhis masokhisme masokisme mason masori masrum massa massal mastautin mastektomi master masterplan mastik mastitis mastodon mastuli masturbasi masuk masuliah masya masyakah masyarakat masygul masyhadat masyhur masyrik masyuk mat mata matador matahari matakao matalamat matan matang mate matematika matematikus matematis materi material materialistis materiil mati matine matlak matoa maton matra matras matres matriarkal matriarkat matriks matrikulasi matrilineal matrilokal matris matronim matros matu matur maturasi maturitas mau mauizah maujud maujudat maukhid maukif maukuf maula maulai maulana maulhayat maulid maulidurasul maulud maung maupun mausoleum maut mauz mawadah mawar mawas maweda mawin mawon mawut maya mayam mayang mayangda mayapada mayas mayat mayeng mayokratio mayones mayor mayoret mayoritas mayung mayur mazarin mazbah mazhab mazi mazkur mazmumah mazmur mbah mbak mbakyu mbeling mbok mbokmas meander mebel mecis medali medalion medan medang mede media medial median mediasi mediastinum mediator medik medikamentosa medikasi mediko medikolegal medikus medil medio medis medisinal medit meditasi mediterania medium medok medu medula medusa mega megabyte megafon megah megak megakredit megal-megol megalit megalitikum megalomania megalomaniak megalopolis megalosit megamerger megan megaohm megap-megap megapolis megaproyek megar megaspora megasporangium megasporofil megat megaton megatren megatruh megawatt meger megrek-megrek mei meiosis meja mejam mejan mejana mejeng mek mekanik mekanika mekanikgraha mekanis mekanisasi mekanisme mekanolinguistik mekap mekar meko mekonium mel melabuai melambang melamin melanesia melangkup melanin melanisme melankolia melankolis melankonis melanoderma melar melarat melas melase melasma melati melawah melayu melek melela melempem meleng meler melesek meleset melik melilin melinjo melintir melit melitofili meliwis melodi melodika melodius melodrama melodramatik melodramatis melompong melon melor melotot melpari melukut melulu melur mem memang memar membal memble membran memedi memek memelas memo memoar memorabilia memorandum memorat memori memorial mempan mempelai mempelam mempelas mempelasari mempening memper mempitis memplak mempurung memutah mena menaga menak menampun menang menantu menara menat mencak mencelat menceng menceret mencit mencla-mencle menclok mencok mencong mencos mencret mendak mendap mendapa mendeleka mendelevium mendeng mendiang mendikai mending mendira mendoan mendonan mendong mendreng mendu mendung mendur mendura mendut menep menepaat mengah mengangah mengap mengapa mengapmendam mengeh mengerawan mengerna menget mengga menggala menggusta mengi mengiang mengicip mengirat mengkal mengkali mengkara mengkaras mengkelan mengkerang mengkeret mengking mengkirai mengkirik mengkis mengkona mengkuang mengkudu mengok mengor mengot mengsol mengsong mengung menhir meni meningitis menir meniran meniskus menit menjangan menong menopause menor menoragia menostaksis mens menserendahi mensiang menstruasi mensurasi mentah mentak mental mentalitas mentang mentari mentaruh mentas mentaus mentega menteleng menteng mentereng menteri mentibu mentifakta mentigi mentilau mentimun mentis mentok mentol mentolo mentor mentora mentua mentul menu menuet menung menur meong mepet meracang meraga meragi merah merajak merak merakan meralgia merambai merambung merana merang meranggi merangsi merangu meranti merapu merat merawal merawan merbah merbau merbaya merbuk merbulan mercak-mercik mercon mercu mercusuar merdangga merdeka merdesa merdinah merdu merduk mere merek mereka merem merembung mereng meres mergat merger mergul meri meriam meriang merica meridian merih merik merikan merikarp merinding mering meristem merjan merkah merkantilisme merkubang merkuri merkurium merkurius merkuro merkurokrom merlilin merlimau merogoni merosot merot merpati merpaud merpitis merpoyan merserisasi mersik merta mertamu mertapal mertayam mertega mertelu mertua meru meruap merubi merunggai merut merwatin mes mesa mesan mesara mesem mesin mesiu mesjid meskalin meskalina meski mesmerisme mesoderm mesodermik mesofili mesofit mesolitik mesolitikum mesometeorologi mesomorf meson mesopause mesosfer mesotel mesotoraks mesozoa mesozoikum mesra mester mesti mestika mestizo mesui mesum mesut meta metabahasa metabolik metabolis metabolisme metabolit metafil metafisik metafisika metafora metaforis metah metai metal metalik metalinguistik metalografi metaloid metalurgi metalurgis metamorf metamorfis metamorfisme metamorfosis metana metanefros metanol metari metasenter metastasis metatarsus metatesis metazoa mete meteor meteorit meteorograf meteorogram meteoroid meteorologi meteorologis meter meterai metil metode metodik metodis metodologi metonimi metonimia metrik metris metro metrologi metromini metronimik metronom metronomis metropolis metropolisasi metropolitan metroragia metrum meunasah mewah mewari mewek mezanin mezbah mezosopran mi miak miana miang miap miasma midar midi midik midodareni mieloma migrain migran migrasi migren mihrab mihun miiofili mijil miju mik mika mikat mikologi mikoprotein mikosis mikotoksin mikraj mikro mikroanalisis mikroangiopati mikrob mikrobe mikrobiologi mikrobiologis mikrobisida mikrobus mikroekonomi mikroelektronika mikroelemen mikrofag mikrofarad mikrofilm mikrofita mikrofon mikrofotografi mikrogelombang mikrograf mikrografika mikrogram mikrohabitat mikrohistori mikrohm mikroklimat mikrokomputer mikrokosmos mikrolet mikrolinguistik mikrolit mikrom mikromanipulasi mikrometer mikrometri mikron mikroorganisme mikroprosesor mikrosefalia mikrosekon mikroskop mikroskopis mikrospora mikrotom mikrovilus mikrowatt mikser miksoedema mil milad milenium miliampere miliar miliarder miliaria milibar milieu miligram milik mililiter milimeter milimikron milimol milioner milir milisi militan militansi militer militerisme militeristis miliun miliuner milivolt milu mim mimbar mimeograf mimesis mimi mimik mimikri mimis mimisan mimosa mimpi min mina minangsraya minat minder mindi mindoan mindring mineral mineralisasi mineralogi mineralogis minggat minggir minggu minhaj mini miniatur minibasket minibus minikar minikata minikomputer minim minimal minimarket minimum minium minor minoritas minta mintak mintakat mintakulburuj mintal minterat mintuna minum minus minyak mioglobin miokardia mioma miop miopia miosis miotik mipis mirah mirai mirakel mirat miriapod mirih mirik miring mirip miris mirmekofag mirmekofili mirmekologi misa misai misal misan misantrop misbah misdinar misi misil misiologi misionaris misioner miskal miskin miskram misoa misofobia misogami misoginis mispersepsi miss mistar mister misteri misterius mistik mistis mistisisme mistri misuh miswat mitasi mite mitisida mitogen mitologi mitologis mitos mitosis mitra mitraliur mizab mizan mnemonik moa mob mobil mobilet mobilisasi mobilisator mobilitas moblong mobokrasi mochi modal modalitas modar mode model modeling modem moderamen moderat moderato moderator modern modernisasi modernisme modernitas modernomaniak modifikasi modifikatif modin modis modiste modol modul modular modulasi modulator modus mofet moga mogok mohair mohon mohor mojah mojang mok moka mokal moke moko moksa mol mola molar mole molek molekul molekuler moler moles molibden molibdenum molor molos molotov moluska momen momental momentum momok momong momot monarki moncong moncor mondar-mandir mondeling mondial mondok mondolan mondong monel moneter mong monggo monggol mongkok mongmong mongolisme mongoloid monisme monitor mono monoatom monodi monodrama monofag monofobia monofonir monogam monogami monogini monografi monogram monokarpa monokel monokini monoklin monoklinal monokotil monokotiledon monokrasi monokrom monokromatis monokromator monoksida monokultur monolingual monolit monolitik monolog monoloyalitas monomania monomer monoploid monopoli monopolistik monopsoni monorel monosakarida monosem monosemantik monosemi monosilabel monosilabisme monosit monospermi monoteis monoteisme monotipe monoton monsinyur monster monstera monsun montase montering montir montit montok monumen monumental monyet monyong monyos mop mopela mopit morak moral moralis moralisasi moralisme moralistis moralitas morat-marit moratorium morbiditas morbili mordan moreng mores morf morfem morfemik morfemis morfin morfinis morfofonem morfofonemik morfofonemis morfofonologi morfogenesis morfologi mori moril mormon moron morong morse mortalitas mortar mortir mosaik mosi mosok moster mota motel motif motivasi motivator moto motor motorik motoris motorisasi motorsaikel moyang mozah mozaik mu mua muadin muai muak muakadah mual mualaf mualamat mualif mualim muamalah muamalat muanas muara muarikh muasasah muasir muat muazam muazin mubah mubalig mubaligah mubarak mubarat mubazir mubtadi mubut mucikari mud muda mudah mudakar mudarabah mudarat mudasir mudat mudigah mudik mudra mudun mufaham mufakat mufarik mufasal mufasir muflis mufrad mufsidin mufti mugabat muhabah muhadarah muhadat muhajat muhajir muhajirin muhal muhalil muhami muhammad muharam muhasabah muhib muhibah muhit muhlikah muhrim muhsin muhtasyam muih mujadalah mujadid mujahadat mujahid mujahidin mujair mujang mujarab mujarad mujari mujbir mujtahid mujtamak mujur muk muka mukabalah mukadam mukadas mukadim mukadimah mukadin mukadis mukah mukalaf mukalid mukaram mukatabah mukena mukhabarah mukhalaf mukhalafah mukhalif mukhalis mukhlis mukhtasar mukibat mukim mukimin mukjizat mukmin mukminat mukminin mukoprotein mukosa mukositis muktabar muktamad muktamar muktamirin muktazilah mukun mula mulai mulakat mulamasah mulas mulat mulato mulazamah mulhid mulia mullah mulsa multazam multibahasa multidimensi multidisipliner multietnik multifaset multifungsi multigravida multiguna multikompleks multikrisis multikultur multikulturalisme multilateral multilingual multilingualisme multimedia multimeter multimilioner multinasional multinegara multiorgan multipara multipel multipleks multiplikasi multiplikator multipolar multiprosesor multirasial multirasialisme multiseluler multivalen multivalensi multivitamin muluk mulur mulus mulut mumayiz mumbang mumbul mumbung mumet mumi mumifikasi mumpung mumpuni mumuk mumur mumut munafik munafikin munajat munajim munasabah muncang munci muncikari muncrat muncul muncus mundam munding mundu mundur mung munggu munggur mungil mungkar mungkin mungkir mungkum mungkur mungmung mungsi mungut munib munjung muno munsyi muntaber muntah muntaha muntu muntul muntup munyuk muon mupaham muparik mupus mur mura murad muradif murah murai murakab murakabi mural muram murang muras murba murbei murca muri murid muring muris murka murni mursal mursyid murtad muruah murung murup murus mus musaadah musabab musabaqah musafir musafirin musakat musala musang musara museolog museologi museum mushaf musibah musik musikal musikalisasi musikalitas musikolog musikologi musikologis musikus musim musisi muskil muskovit muslih muslihat muslim muslimat muslimin muslin musnah muspra mustahak mustahik mustahil mustaid mustajab mustak mustaka mustakim mustamik mustang musuh musyarakah musyarakat musyarik musyawarah musyawarat musyrik musyrikin musytak musytari mutabar mutagen mutah mutakadim mutakalim mutakhir mutaki mutalaah mutamad mutan mutasawif mutasi mutawif mute mutiara mutih mutilasi mutisme mutlak mutmainah mutu mutualisme mutung muwafakat muwahid muwajahah muwakal muwakil muwari muzah muzakar muzakarah muzaki muzamil muzawir muzhab naam nabatah nabati nabi nabtun nabu nada nadi nadim nadir naf nafar nafas nafi nafiri nafkah nafsi nafsu nafta naftal naftalena naftena naftol naga nagam nagara nagari nagasari nahak nahas nahdiyin nahi nahkoda nahu naib naif naik naim najam najasah najasat najis nak naka nakal nakara nakhoda nal nala nalam nalar nali nalih naluri naluriah nama namaskara namatad namatium nambi namnam nampan namun nan nanah nanang nanap nanaplankton nanar nanas nandu nandung nang nangka nangkoda nangkring nangui naning nanofarad nanofosil nanogram nanometer nanti napal napalm napas napuh naqal naqli nara narablog narapati narapidana narapraja narasi narasumber naratif narator narkolepsi narkomaniak narkose narkosis narkotik narpati narsis narsisisme narsisme narwastu nas nasab nasabah nasakh nasal nasalisasi nasar nasehat nasel nasi nasib nasihat nasion nasional nasionalis nasionalisasi nasionalisme nasionalistis nasionisme naskah nasofaring nasrani nasti nasut natal natalis natalitas natang natar natijah nativis nativisme nativistik natolokal natrium natur natura natural naturalis naturalisasi naturalisme naturalistis naturopatis naung nauplius nausea nautik nautika nautikal nautilus nauzubillah navigasi navigator nawa nawaitu nawala nawalapradata nayaka nayam nayap nazam nazar nazi naziisme nazim nazir ndoro ndoroisme neala nealogi nebeng nebula nebulium neces necis nefoskop nefrektomi nefridium nefrit nefritis nefroblastoma nefrologi nefron nefrosis negara negasi negatif negativisme negativistik neger negeri negosi negosiasi negosiator negrito negro negroid negus neka nekad nekara nekat nekel neko nekrofag nekrofagus nekrofili nekrofilia nekrogeografi nekrolog nekrologi nekromansi nekropolis nekropsi nekrosis neksus nektar nelangsa nelayan nemagon nematoda nematologi nematosida nematosis nenda nendatan nenek nenekanda nenen nenenda nener nenes neng neodarwinisme neodimium neofeodalisme neofeodalistis neoiknologi neoimpresionisme neokarpi neoklasik neoklasisisme neoklasisme neokolonialisme neoliberalisme neolit neolitik neolitikum neologi neologisme neolokal neon neonatal neonatus neontologi neoplasma neoplatonisme neoprena neositosis neotipologi neovirus neozoikum nepotis nepotisme neptunium neptunus neraca nerak neraka neritik neritopelagik neritoplankton neroglia nervasi nervur nesa nestapa nestor net neting neto netra netral netralis netralisasi netralisme netralitas neural neuralgia neurastenia neuritis neuroblastoma neuroglia neurolinguistik neurolog neurologi neurologis neuron neurosis neurotik neurotransmiter neustonologi neutrino neutron newton ngabei ngaben ngablak ngabur ngakngikngok ngalau ngalor-ngidul nganga ngap-ngap ngapain ngarai ngeang ngebet ngebut ngeceng ngeden ngedumel ngelindur ngemil ngenas ngengat ngenyek ngeong ngeres ngeri ngiang ngilu ngoko ngos-ngosan ngot-ngotan ngowos ngoyo ngung nia niaga nian niasin niat nibung nica nidasi nidera nidikola nidulus nifak nifas nih nihil nihilis nihilisme nijas nik nikah nikel nikmat nikotin niktigami nila nilai nilakandi nilam nilau nilon nimbostratus nimbrung nimfomania ninabobo ning ningnong ningrat nini ninik ninitowok niobium nipah nipis nira niraksara nirgagasan nirgesekan nirguna nirkabel nirlaba nirleka nirmala nirselera nirwana nirwarta nisab nisan nisbah nisbi niscaya niskala nista nistagmus nistatin nitrat nitrifikasi nitrobenzena nitrofili nitrofit nitrogen nitrogliserin nitroselulosa niyaga noa nobat nobelium noda nodulus nodus noem noja noken noktah nokturia nokturnal nol nomad nomenklatur nomina nominal nominalisasi nominalisme nominasi nominatif nominator nomine nomogram nomokrasi nomor nomplok non nona nonagresi nonaktif nonblok nondepartemen nondepartemental none nonekonomi noneksakta nonfiksi nonformal nong-nong nongol nongrata nonhistoris noni nonilium nonindustri nonintervensi nonius nonkimia nonkombatan nonkonvensional nonkooperasi nonkooperatif nonmedis nonmigas nonmiliter nonok nonol nonong nonpatogenik nonpemerintah nonpolitik nonpredikatif nonpribumi nonproduktif nonprofit nonprotein nonsens nonsilabis nonstandar nonstop nonteknis nontradisional nonverbal nopek norak norit norma normal normalisasi normatif nosologi nostalgia nostrum not nota notabene notariat notaris notasi notes notifikasi notok notula notulis nova novel novela novelet novelis november novena novokaina nrima nuansa nubuat nudis nudisme nugat nugraha nujum nukil nukleat nukleolus nukleon nukleoprotein nukleus nuklida nuklir nulipara numeralia numerik numeris numismatika nun nunatak nung nunsius nunut nur nuraga nurani nurbisa nuri nuriah nus nusa nusaindah nusakambangan nusantara nusyu nusyus nutan nutasi nutfah nutriea nutrisi nutrisionis nutrisisme nuzul nuzulul nyai nyak nyala nyalang nyalar nyalawadi nyale nyali nyaman nyambing nyamik nyamleng nyampang nyamplung nyamuk nyamur nyana nyang nyanya nyanyah nyanyang nyanyar nyanyi nyanyu nyanyuk nyapang nyapnyap nyarang nyarik nyaring nyaris nyata nyatuh nyawa nyawang nyelekit nyemplong nyentrik nyenyai nyenyak nyenyat nyenyeh nyenyep nyenyet nyepi nyeri nyerocos nyi nyilih nyingnying nyinyir nyiri nyiru nyit nyiur nyolnyolan nyolo nyoman nyong nyonya nyonyeh nyonyong nyonyor nyungsung nyunyut nyureng nyut oase oasis obar obat obduksi obelisk obeng obesitas obi obituarium objek objektif objektivisme objektivitas oblak oblasi obligasi oblong obo obor obral obras obrol obrus observasi observatorium obsesi obsesif obsidian obsolet obstetri obstruen obstruksi obversi obviatif obyek obyektif obyektivisme obyektivitas oceh odalan ode odekolonye odinometer oditur odoh odol odometer odontoblas odontoid odontologi odoran oedipus-kompleks oersted ofensif oferte ofisial ofset oftalmia oftalmoskop oga ogah ogah-agih ogak-agik ogak-ogak ogam ogel ogok ogonium ohm ohmmeter oikumene oja ojeg ojek ojok okarina oke oker oklokrasi oklusi oklusif oknum okok oksalat oksiasetilena oksida oksidan oksidasi oksidator oksigen oksigenase oksimoron oksitetrasiklin oksiton oktaf oktagon oktahedron oktal oktana oktet oktober oktroi okulasi okuler okulis okultis okultisme okupasi okupasional olah olahraga olak olak-alik olanda olang-aling oleander olefin oleh olek oleng oleografi oleometer oleovitamin oles olet oleum oli olia oligarki oligofagus oligofrenia oligopoli oligopolistis oligopsoni oligosen oligositemia oligotrofik oliman olimpiade oliva olivin olok olong-olong om oma ombak ombang-ambing ombyok omega omel omikron omnibus omnivor omnivora omong ompol ompong ompreng omprong ompu omset omslah omzet onagata onak onani onar oncat once oncek oncen oncer oncom oncor onde-onde ondel-ondel onder onderdil onderdistrik onderneming onderok ondo ondoafi ondok ondos oneng-oneng ong ongahangih ongeh onggok ongji ongkang ongkok ongkos ongok ongol-ongol oniks onkogen onkologi onomasiologi onomastika onomatologi onomatope ons onslah ontogeni ontologi ontologis ontran-ontran onyah-anyih onyak-anyik onyang onyok onyot oogenesis oolit opa opak opak-apik opal opalesen opas opasitas opelet open opendim openkap oper oper opera operasi operasional operasionalisasi operatif operator operet operkulum opini opisometer opium oplah oplet oplos opmak opname oponen opor oportunis oportunisme oportunistis oportunitas oposan oposisi oppo opsen opseter opsi opsin opsinder opsiner opsional opsir opstal optatif optik optika optimal optimalisasi optimis optimisme optimistis optimum optis optisien optoelektronika optometri optometris opus orak orak-arik orakel oral oralit orang orang-aring oranye orasi orat-oret orator oratoria oratoris oratorium orbit orbita orbital orde order ordi ordinal ordinasi ordinat ordiner ordner ordo ordonans ordonansi oren oreng oreol oret organ organdi organel organik organis organisasi organisator organisatoris organisir organisme organismus organogram organon orgasme orgasmik orgel orien oriental orientalis orientasi origami orion orisinal orisinalitas orkes orkestra orkestrasi ornamen ornamental ornamentasi ornitologi ornitologis ornitosis orografi orografik orografis orok orong-orong ortodidaktik ortodoks ortodoksi ortodrom ortoepi ortografi ortografis ortoklas ortopedagogik ortopedi ortopedis ose osean oseanarium oseanografi oseanologi osifikasi osikel osilasi osilator osilograf osilogram osiloskop oskilator oskulum osmium osmometer osmoregulasi osmose osmosis osomosis ostentasi osteoblas osteoklas osteologi osteopati osteoporosis ostium otak otak-atik otak-otak otar otek otentik oto otobus otofon otologi otomat otomatis otomatisasi otomobil otomotif otonom otonomi otopet otorisasi otoritas otoriter otoritet otoskop otot ototipi oval ovarium ovasi oven over overaktif overakting overal overdosis overkompensasi overpopulasi overproduksi oversimplifikasi overste oviduk ovipar oviparitas ovipositor ovitesis ovovivipar ovulasi ovulum ovum oyak oyek oyok oyong oyot ozokerit ozon ozonisasi ozonisator ozonometer pabean pabrik pabrikan pabrikasi pacai pacak pacal pacangan pacar pacat pacau pace pacek paceklik pacet pacih pacik pacok pacu pacuk pacul pada padah padahal padak padam padan padang padas padasan padat padepokan padi padma padmasana padmi padri padu padudan paduk paduka paduraksa paedofil paes pagan paganisme pagar pagas pagebluk pagelaran pagi pagina pagoda pagon pagositosis pagu pagun pagupon pagut paguyuban pah paha pahala paham pahang pahar pahat paheman pahit pahlawan pahter pai paidon pail pailit paing paip pair pais paitua paja pajak pajan pajang pajuan pajuh pakai pakal pakan pakanira pakansi pakar pakaryan pakat pakau pakcik pakde pakem paket pakihang pakihi paking pakis paklik pakma pakpui pakpung paksa paksi paksina pakta pakter paku pakuh pakuk pakuncen pakus pal pala paladium palagan palai palak palaka palam palamarta palang palapa palar palari palas palasik palat palatabilitas palatal palatalisasi palatografi palatogram palatum palau palawa palawija paldu pale palem palen paleoantropologi paleobotani paleoekologi paleogeografi paleografi paleografis paleoklimatologi paleolitik paleolitikum paleontologi paleosen paleozoikum pales palet pali paliatif paliatip palindrom paling palinologi palis palit palka pallawa palmarosa palmin palmistri palmit palmitat palolo palpasi palsu paltu palu paluh palun palung palut pamah paman pameget pamer pamflet pamit pamong pamor pampa pampan pampang pampas pampat pamper pampiniform pamrih pamungkas pan pana panah panai panakawan panar panas panasea panau panca pancabicara pancabuta pancacita pancadarma pancaindera pancaindra pancaka pancakara pancakembar pancal pancalima pancalogam pancalomba pancalongok pancamarga pancamuka pancang pancaniti pancapersada pancar pancaragam pancarajadiraja pancaroba pancarona pancasila pancasilais pancasona pancasuara pancasuda pancausaha pancawalikrama pancawara pancawarna pancawarsa panci pancing pancir pancit panco pancong pancung pancur pancut pandai pandak pandam pandan pandang pandau pandawa pandega pandemi pandemik pandialektal pandir pandit pandom pandu panekuk panel panelis panembahan panembrama panen panewu pangabekti pangah pangan pangeran pangestu panggak panggal panggang panggar panggau panggih panggil panggon panggu panggul panggung pangkah pangkai pangkal pangkas pangkat pangkek pangkin pangking pangkon pangku pangkung pangkur panglima pangling panglong pangolat pangonan pangpet pangpung pangreh pangrehpraja pangrukti pangsa pangsek pangsi pangsit panguk pangur pangus panik paniki panil panili paninggil paningset panir paniradia panitera panitia panja panjak panjang panjar panjarwala panjat panjer panji panjing panjul panjunan panjut pankreas pankromatis pankronis panlektal panleukapema panleukopenia panoptikum panorama panser pantai pantak pantalon pantang pantar pantas pantat pantau panteis panteisme panteistis pantek pantekosta panteon panter panti pantik panting pantis panto pantofel pantograf pantomim pantri pantul pantun panus panutan panyembrama pao-pao papa papacang papah papain papak papakerma papan papar paparazi papas papat papatong papi papil papila papirus papras paprika papui par para parab parabasis parabel parabiosis parabola paradam parade paradigma paradigmatis paradiso paradoks paradoksal parados paraf parafasia parafemia parafin parafrasa parafrase parafrenia paragaster paragog paragon paragraf parah parak paralaks paraldehida paralel paralelisasi paralelisme paralelogram paralgesia paralinguistik paralinguistis paralipsis paralisis paralitis param paramaarta paramarta paramasastra paramedis paramen parameter paramiliter parampara paran parang paranoia paranoid paranormal paranpara parap parapalatal parapati paraplasme paraplegia parapodium parapsikolog parapsikologi paras parasetamol parasintesis parasit parasitisme parasitoid parasitologi parasitoma parasitopolis parasut parasutis parataksis parataktis paratesis paratifus paratiroid parau pare parenial parenkim parental parentesis parestesia parewa parfum parga parhelion pari paria parididimis parih parik parikan paring paripurna paris parit paritas pariwara pariwisata parji parka parket parkinson parkinsonisme parkir parkit parlemen parlementaria parlementarisme parlementer parmitu paro parodi paroki parokial parokialisme parolfaktori paron paronim paronisia paronomasia parotitis pars parser parsi parsial partai partenogenesis partial partikel partikelir partikularisme partisan partisi partisipan partisipasi partitif partitur partner partus paru paruh parun parut parvenu parwa pas pasah pasai pasak pasal pasang pasanggiri pasar pasara pasaraya pasase pasasir pasat pascabedah pascadoktoral pascajual pascakawin pascakrisis pascalahir pascalarva pascalikuidasi pascamodern pascamodernisme pascaoperasi pascapanen pascaperang pascaproduksi pascareformasi pascasarjana pascausaha pascayuwana paseban paser paset pasfoto pasi pasien pasif pasifikasi pasifisme pasigrafi pasik pasilan pasim pasimologi pasir pasirah pasit pasiva paskah pasmat pasmen pasok pasowan paspor pasrah pasta pastel pasteur pasteurisasi pasti pastiles pastor pastoral pastoran pastur pastura pasu pasuel pasuk pasumandan pasung patah pataka patam patang patar patek patela paten pater patera pateram paternalis paternalisme paternalistis patet patetis patgulipat pati patih patik patikim patil patin patina pating patio patirasa patiseri patka patogen patogenesis patogenik patois patok patokimia patol patola patolog patologi patologis patolopolis patos patra patrap patri patriark patriarkat patrilineal patrimonium patriot patriotik patriotisme patroli patron patronasi patrun patuh patuk patung patungan patut pauh pauhi pauk paul paun paung paus pause paut paviliun pawai pawak pawaka pawana pawang pawiyatan pawukon paya payah payang payar payau payet payon payu payudara payung peang pecah pecai pecak pecal pecara pecat pece pecel peci pecicilan pecinan pecok pecuk pecun pecut peda pedada pedadah pedagog pedagogi pedagogis pedak pedaka pedal pedanda pedang pedapa pedar pedas pedati pedel pedena pedendang pedengan pedepokan pedestrian pedet pedewakan pediatri pedih pedikur pedis pedisel pedogenesis pedok pedologi pedoman pedometer pedongkang pedot peduli pedunkel pedusi pedut pegagang pegah pegal pegan pegang pegar pegari pegas pegat pegawai pego pegoh pegon peguam pegun pehong pei pejajaran pejaka pejal pejam pejatian pejera pek peka pekaja pekak pekakak pekan pekarang pekasam pekaseh pekat pekatu pekatul pekau pekerti pekik peking pekir pekis pekiwan pekojan peksi pektik pektil pektin peku pekuk pekulun pekung pekur pel pelabi pelabur pelaga pelagas pelagis pelagra pelah pelak pelalah pelamin pelampang pelampung pelan pelana pelancar pelanduk pelang pelangai pelanggi pelangi pelangkin pelangpang pelantar pelanting pelas pelasah pelasik pelaspas pelasuh pelat pelata pelatuk pelawa pelayon pelbagai pelbak pelbet pelebat pelebaya pelebegu pelebon pelecet pelecok peleh pelek pelekat pelekok pelekuk pelembaya pelencit pelengak pelengan pelengset pelepah peles pelesat peleset pelesir pelesit pelet peletek peletik peleting peleton pelias pelihara pelik pelikan pelikel pelinggam pelinteng pelintir pelipir pelipis pelir pelisir pelisit pelit pelita pelitur pelo pelog peloh pelojok pelonco pelong pelopor pelor pelorot pelosok pelosot pelota pelotaris pelotot pelples pelpolisi pels peluang peluh peluit peluk peluluk pelulut pelumpung pelungpung pelupuh pelupuk peluru peluruh pelus pelvis pemair pemali pemarip pematah pematang pemayang pembarap pembayan pemendak pemeo pemetikan pemidang pemindang peminggir pempek pemuda pemudi pemuras pen pena penak penaka penalti penampan penampang penanggah penaram penasaran penat penatu penatua penca pencak pencalang pencar pencet pencil pencok pencong pencu pencut penda pendaga pendahan pendak pendam pendapa pendar pendaringan pendek pendekar pendet pendeta pending pendok pendongkok pendopo pendua penduk pendulum penembahan penes penetrasi penetron penewu pengalasan penganak penganan pengang pengantin pengap pengapuh pengar pengaruh pengat pengatu pengawinan pengeng pengerih pengga penggaga penggah penggal penggar penggawa penghulu pengin pengkal pengkar pengki pengkis pengkol pengkor pengos penguin pengulun peni peniaram pening peningset penis penisilin penisilinat penitensi peniti penjajap penjalin penjara penjaruman penjor penjuna penjura penjuru penmes penologi penomah pensi pensil pensiun pentagin pentagon pentagor pentagram pentahedron pental pentameter pentan pentana pentang pentar pentas pentatonik pentil pentilasi penting pentode pentol pentosa pentotal pentung penuh penyap penyek penyet penyok penyu peok peot pepagan pepah pepak pepaku peparu pepas pepat pepatah pepaya pepe pepek pepeling peper pepermin pepes pepet pepindan peplum pepsin pepsina pepsinogen peptida peptidase peptik pepton pepuju pepunden pepung peputut per pera perabot perabung perada peragat perah perahu perai peraji perak peraka peram perambut peran perancah perang perangah perangai peranggang peranggu peranggul perangin perangkap perangkat peranjat peranti peranye perap peras peras-perus perasat perasukan perat perata perawan perawas perawi perawis perbal perban perbani perbatin perbawa perbegu perbekel perca percaya percik percis percit percul percuma perdah perdana perdata perdeo perdikan perdom perdu pere peredus pereh perei perek perekik perempuan perencah perenggan perengkat perengus perengut perenial perenkum perenyak perenyuk perepat peres peresau peresih perestroika peretel perewa perfek perfeksi perfeksionis perfeksionisme perfektif perforasi perforator performa pergam pergat pergata pergedel pergi pergok pergol pergola perhati peri peria perian periang periantium peribahasa periboga peribudi peridi perifer periferal periferalis periferi perifiton perifrasa perifrase perifrastis perige perigel perigi perih perihal perihelion perikarditis perikardium perikemanusiaan perikondrium periksa perilaku perimbas perimeter perimisium perimpin perimping perinci perincit perineorium perineum perineurium pering peringgan peringgi peringgitan peringis peringkat perintah periodat periode periodik periodisasi periodonsium periodontium periorbita periosteum perirana perisa perisai periskop perispora peristalsis peristaltik peristerit peristiwa peristonium perit peritoneum peritonitis periuk perivaskuler perjaka perji perkakas perkale perkamen perkara perkasa perkedel perkelang perkolar perkolasi perkolator perkoler perkosa perkusi perkutut perlahan perlak perlambang perleng perlente perlenteh perli perlina perling perlintih perlip perlit perlop perlu perlup perlus permadani permai permaisuri permak permalin permana permanen permanganat permasan permata permeabel permeabilitas permen permil permisi permisif permutasi pernah pernak pernekel pernik pernikel pernis perogol perohong peroi peroksida peroksidase peroksisom peroksisoma peroman perompak peron peroneal peronyok perop perosok perosot perot perpatih perpetuasi perponding pers persada persangga persegi persekot persekusi persen persentase persentil persepsi perseptif perseptivitas persero persetan perseus perseverasi persih persik persil persis persisi perslah persneling person persona personal personalia personalisme personalitas personel personifikasi perspektif perspektivisme persuasi persuasif pertal pertama pertepel pertiwi pertua perturbasi pertusis peruak peruan peruang perubalsem perudang peruk perum perumpung perun perunggu perunjung perupuk perus perusi perut perversi perwara perwira pes pesa pesai pesak pesakin pesam pesan pesanggrahan pesantren pesara pesat pesawat pese peseh pesek peser pesero peset pesi pesiar pesimis pesimisme pesimistis pesing pesirah pesisir pesok pesolot pesona pesong pesta pestaka pestisida pestol pesuk pesut pet peta petah petai petak petaka petal petala petaling petam petamari petan petanen petang petaram petarang petarangan petaruan petas petatang-peteteng petatus petegian petek petel petenteng petepete peterana peterseli petes peti petia petik petikrah petikut petilan peting petinggi petiolus petir petis petisi petitih petitum petogram petola petopan petor petrodolar petrografi petrogram petrokimia petrol petrolatum petroleum petrologi petromaks petsai petuah petuding petuduh petuk petunia peturun petus petut pewaka pewat peyek peyorasi peyot phi piadah piagam piah piak pial piala pialang pialing pialu piama piang pianggang pianggu pianika pianis piano pianola piantan piara piarit pias piaster piat piatu piawai pica picah picik picing picis picit pico picu picung pidana pidato pidi piezoelektrik piezoelektrisitas piezometer pigmen pigmentasi pigmi pigura pihak pijah pijak pijar pijat pijin pijinasi pijit pika pikap pikat pikau pike piket pikir piknik piknometer pikofarad pikogram pikolo piktografi piktogram pikul pikun pikup pil pilah pilak pilang pilar pilas pilaster pilau pileh pilek pileren pilih pilin pilis pilon pilong pilorus pilositas pilot pilsener pilu pilus pimpel pimpin pimping pin pina-pina pinak pinang pinar pincang pincuk pincut pinda pindah pindai pindang pines pinga pingai pinggah pinggan pinggang pinggir pinggul pingit pingkal pingkau pingpong pingsan pingul pinis pinisepuh pinisi pinjal pinjam pinjung pinset pinta pintal pintan pintang pintar pintas pintil pintu pintur pinus piogenik pion pioner piong pionir pipa pipet pipi pipih pipil pipis pipit pir pirai piramid piramida piramidal pirang piranograf piranogram piranometer pirasat pirau pireksia pirektik piretrum pirian piriform pirik piring pirit pirofilit pirofobia piroksen pirolisis piromania pirometalurgi pirometer piroteknik pirsa piruet pirus pis pisah pisang pisau pises pisiformis pisik pisin pisit pisitan pisovonus pispot pistol pistom piston pisuh pit pita pitak pitam pitanggang pitar pitarah pitawat piting pitiriasis pitis pitometer piton pitot pitut piuh piung piut piutang pivot piwulang piyik piza plafon plagiarisme plagiat plagiator plagioklas plakat plaket plaksegel plamir plan planaria planel planet planetarium planetoid plang plangkan planimeter planimetri planing planisfer plankton plano planologi planologis planospora plantase planula plasenta plaser plasma plasmodium plasmosis plastid plastik plastin plastis plastisitas plastogami plastometer plastron platelet platform platina platinoid platinum platisma plato platonik platonisme plaza plebisit pleidoi pleiogami pleistosen pleksus plengkung pleno pleonasme pleopod plerem plester pletora pleura plinplan plintat-plintut plinteng plintit pliosaurus pliosen ploi ploidi plombir plonci plonco plong plonga-plongo plontos plosif plot plug plumbago plumbum plumbung plural pluralis pluralisme pluralistis pluriform plus pluto plutokrasi plutonik plutonium pluvial pluviograf pluviometer pneumatika pneumatofos pneumatokista pneumonia poal poces poci pocok pocong podemporem podikal podium poetika pof pogrom pohon poikilohalin poikiloterm poin point poise poiseuille pojok pok pokah pokeng poker poket poko pokok pokrol poksai pokta pol pola polah polan polang polarimeter polarimetri polaris polarisasi polaritas poldan polder polemik polemis polen poleng polenter poler poles polet poliandri poliantus poliester polifagia polifase polifoni poligam poligami poligini poliglot poliglotisme poligon poligraf polihalin polikel poliket poliklinik polikrom polikultur polimer polimerisasi polinia polio polip polipeptida polipetal poliploid polipropilena polis polisakarida polisemi polisentrisme polisepal polisi polisiklis polisilogisme polisindeton polisional polispermi polister politbiro politeis politeisme politeistis politeknik politena politik politika politikus politis politisasi poliuretan polivini polizoa polka polkadot polmah polo polok polones polong polonium polonter polos polusi polutan polutif poma pomade pomologi pompa pompang pompon pompong pon ponakan ponco pondamen pondar ponderabilitas pondik pondoh pondok pondong pongah ponggang ponggok pongkol pongsu poni ponil ponok ponor pons pontang-panting ponten pontoh ponton poo pop popelin popi popok popor popularisasi popularitas populasi populer populis populisme pora porah porak-parik porak-peranda porak-poranda porfiria pori porisitas porno pornografi pornografis porok porong poros porositas porot porselen porsi porta portabel portal portepel portik portir porto portofolio pos pose poser posesif posisi positif positivisme positivistik positron positronium poskar poso posologi postar poster posterior postulat postur pot potas potasium potator potehi potel potensi potensial potensiometer potia potlot potol potong potret poundal poyang praanggapan praba prabu pradana pradesa pradesain pradini praduga pragmatik pragmatika pragmatis pragmatisme prah prahara prahoto prairi praja prajaksa prajurit prakala prakarsa prakarya prakata prakilang prakira prakondisi prakonsepsi praksis praktek praktik praktikan praktikum praktis praktisi pralahir pramenstruasi prameswari pramodern pramubakti pramubarang pramubayi pramudi pramugara pramugari pramujasa pramuka pramukamar pramuniaga pramupintu pramuria pramusaji pramusiwi pramutamu pramuwisata pramuwisma pranala pranata pranatacara pranatal prangas prangko pranikah prapalatal prapatan prapendapat praperadilan prapromosi prapuber prapubertas prapuna prapustaka prarasa prarekam praremaja prasaja prasangka prasaran prasarana prasasti prasawya prasejahtera prasejarah prasekolah praseminar praseodimium prasetia prasi prasmanan prastudi prasyarat pratersier pratinjau prawacana prawira prayang prayitna prayojana prayuwana preadvis preambul preantena preasetabulum predasi predator predestinasi predikat predikatif prediksi predisposisi preferensi prefiks prehistori prei prekositas prekursor preliminer prelude preman prematur premi premis premium premolar prenatal prenjak preparat preposisi prepotensi prerogatif pres presbiopia presbiterium preseden presensi presentabel presentasi presentil preservasi presesi presiden presidensial presidentil presidium presiositas presipitasi presisi preskripsi preskriptif prestasi prestise prestisius presto presumsi pretel pretensi prevalensi preventif preview prewangan pria priagung priayi pribadi pribumi prihatin prima primadona primas primata primbon primer primitif primogenetur primordial primordialisme primpen pringas-pringis pringgitan prinsip prinsipiil prioritas pripih pris prisma prit privasi privat privatisasi prive privilese pro proaktif probabilitas problem problematik procot prodemokrasi prodeo produk produksi produktif produktivitas produsen produser proenzim prof profan profanitas profase profesi profesional profesionalisme profesionalitas profesor profetik profil profilaksis profit profitabel profitabilitas proforma progeni progesteron prognosis program programa progres progresif progresivitas prohibisi proklamasi proklamator proklitik proksimal proksimat prokurasi prokurator prolat prolegomena proleksem proletar proletariat proletarisasi proliferasi prolog promenade prometium prominen prominensia promiskuitas promontorium promosi promotif promotor promovendus pronomina pronominal pronominalisasi prop propaganda propagandis propana propelan propeler properti propfan propilena propinsi proporsi proporsional proposal proposisi propulsi prosa prosais prosede prosedur prosedural prosenium proses prosesi prosesor proskonion proskriptivisme prosodi prosodis prospek prospeksi prospektif prospektus prostaglandin prostat prostitusi protagonis protaktinium protandri protandris protasis proteid protein proteinuria proteksi proteksionisme protektif protektorat proteolisis proteolitik protes protese protesis protestan protestantisme protista proto protofon protogenesis protokol protokoler protolisis protolitik proton protoneolitik protoplasma protoraks prototipe protozoa protrombin protuberansia provinsi provinsialisme provisi provisional provitamin provokasi provokatif provokator provokatur provos proyek proyeksi proyektil proyektor prudensial prurigo psalm psamolitoral pseudo pseudokata pseudomorf pseudonim psi psike psikiater psikiatri psikis psikoanalisis psikodrama psikofarmakologi psikofisiologis psikokinesis psikolepsi psikolinguistik psikolog psikologi psikologis psikometri psikometrika psikomotor psikomotorik psikoneurosis psikopat psikopati psikopatologi psikosastra psikoseksual psikosis psikosomatik psikoteknik psikoteknis psikoterapi psikotes psikotropika psikrofili psikrometer psikrometri psitakosis psoriasis pterodaktil pteropoda ptialin ptomaina puadai puah puak puaka pual pualam puan puas puasa puatang pub puber pubertas pubesens publik publikasi publisis publisistik publisitas pucang pucat pucik pucuk pucung pudar pudat pudel puder pudi puding pudur puerpera puerperium pugak pugar pugas puguh puih puing puisi puitis puitisasi puja pujangga puji pujuk pujur pujut pukah pukal pukang pukas pukat pukau puki pukul pul pula pulai pulan pulang pulas pulasan pulasari pulau pulen pulih pulik pulover pulp pulpa pulpen pulper pulsa pulsar pulsasi puluh pulun pulung pulut puma pumpun pun punah punai punakawan punar punat punca puncak punci pundak punden pundi punding punduh punduk pundung pung pungak-pinguk punggah punggai punggal punggawa pungguk punggung punggur pungkah pungkang pungkas pungkur pungli pungsi pungtuasi punguk pungut punia punjul punjung punjut punk puntal punti puntianak puntir puntuk puntul puntung punuk punya pupa pupil pupu pupuan pupuh pupuk pupur pupus puput pura purba purbakala purbani purbasangka purbawisesa purdah pure purgatif puri purifikasi purik puring puris purisme puristis puritan puritanisme purna purnabakti purnaintegrasi purnajabatan purnajual purnakarya purnama purnapugar purnasarjana purnatugas purnawaktu purpura purser puruk puruk-parak purun purus purusa purut purwa purwakanti purwapada purwarupa pus pusa pusak pusaka pusang pusar pusara pusat puser pusing puskesmas puso puspa puspadana puspadanta puspamala pusparagam puspas puspawarna puspita pusta pustaha pustaka pustakaloka pusu pusung pusut putar putat puter puti putih putik puting putra putranda putrawali putrefaksi putresin putri putriditas putu putus putut puvi-puvi puyan puyeng puyer puyonghai puyu puyuh qaf qari qariah qasar qiamulail qiraah qiraat qudsi quran raba raba-rubu rabak raban rabana rabani rabas rabat rabet rabi rabies rabik rabit rabiulakhir rabiulawal rabotase rabu rabuk rabulizat rabun rabung rabut racak racau racik racuh racun rad rada radaah radah radai radak radang radar radas raden rades radi radiah radial radian radians radiasi radiator radif radikal radikalisasi radikalisme radiks radikula radin radio radioaktif radioaktivitas radiogenetika radiogoniometer radiogoniometri radiograf radiografi radiogram radioisotop radiokarbon radiokimia radiolisis radiolog radiologi radiolokasi radiometer radiosonde radiotelefoni radiotelegrafi radiotelegrafis radioterapi radis radium radius radon radu radurisasi rafak rafaksi rafe rafi rafia rafidi rafik raflesia raga ragam ragang ragas ragawi ragi ragib ragil ragu raguk ragum ragung ragut rahak rahang rahap raharja rahasia rahat rahayu rahib rahim rahimakallah rahimakumullah rahmah rahman rahmat rahmatullah rahu rai raib raigedeg raih raimuna rais raja rajab rajabiah rajah rajalela rajam rajang rajapati rajawali rajim rajin rajok rajuk rajul rajungan rajut rak raka rakaat rakah rakanita rakap rakat rakawira rakbol raket rakila rakis rakit rakitis rakna raksa raksabumi raksasa raksi rakuk rakung rakus rakut rakyat rakyu ralat ralip ram rama rama-rama ramadan ramah ramai ramal ramanda ramania rambah rambai rambak ramban rambang rambat rambeh rambih rambu rambun rambung rambut rambutan rambuti rames rami ramin ramirezi rampa rampai rampak rampang rampas rampat ramping rampok rampuh rampung rampus ramu ramus rana ranah ranai ranap ranca rancah rancak rancam rancang rancap rancau rancu rancung randa randa-rondo randah randai randajawan randak randat randau randek randi randu randuk randung rang rangah rangai rangak rangam rangas rangga ranggah ranggak ranggas ranggeh ranggi ranggit ranggul ranggung rangin rangina rangka rangkai rangkak rangkam rangkang rangkap rangkaya rangket rangkiang rangkik rangking rangkit rangkok rangkul rangkum rangkung rangkup rangkus rangkut rango-rango rangrang rangrangan rangsang rangu rangum rangup rani ranjah ranjang ranjau ranji ranjing rankine ransel ransum rantai rantam rantang rantas rantau rante ranti ranting rantuk rantus ranum ranyah ranyang ranyau ranyun rap rapah rapai rapak rapal rapang rapat rapel rapi rapiah rapik rapor rapsodi rapu rapuh rapun rapung rapus raraha rarai rarak rarangan raras ras rasa rasai rasam rasamala rasan rasau rasberi rase rasem rasi rasia rasial rasialis rasialisme rasian rasio rasional rasionalis rasionalisasi rasionalisme rasionalitas rasisme raster rasuk rasul rasuli rasulullah rasyid rasywah rat rata ratah ratap ratib ratifikasi ratna ratu ratus rau raudah raudatul raudatulatfal raum raun raung raup raut rawa rawah rawai rawak rawan rawang rawat rawatib rawi rawin rawit rawon rawuh raya rayah rayan rayang rayap rayau rayon rayonisasi rayu razia reagen reagensia reak reaksi reaksioner reaktan reaktans reaktansi reaktif reaktivitas reakton reaktor reaktualisasi real realis realisasi realisme realistis realitas realokasi realpolitik reasuransi reaumur reba rebab rebah rebak reban rebana rebas rebat rebeh rebek rebes rebet rebewes reboisasi rebon rebu rebuk rebung rebus rebut reca recak receh recet recik recok recup reda redah redaksi redaksional redaktur redam redang redap redefinisi redih redik redoks reduksi reduksionisme redum redup reduplikasi redusir redut reedukasi reekspor referat referen referendaris referendum referensi referensial reflasi refleks refleksi reflektif reflektor reformasi reformis refraksi refraktometer refraktor refrein refrigerator regah regan regang regas regat regata regel regen regenarasi regenerasi reges regi regio region regional regionalisme register registrasi regisur reglemen reglementer regol regresi regresif regu reguk regularisasi regulasi regulatif regulator reguler regup rehab rehabilitasi rehabilitatif rehal rehat rehidrasi reideologisasi reindoktrinasi reinkarnasi reintegrasi reinterpretasi reinvestasi reja rejab rejah rejan rejang rejasa rejeh rejeng rejuk rek reka rekah rekal rekalkulasi rekalsitran rekam rekan rekanalisasi rekanita rekap rekapangan rekapitalisasi rekapitulasi rekat rekata rekayasa reken rekening rekes rekisitor rekisitur reklamasi reklame reklasering reklasifikasi rekognisi rekoleksi rekombinan rekombinasi rekomendasi rekonsiliasi rekonstruksi rekonstruktif rekonvensi rekor rekreasi rekrut rekrutmen reksa rekstok rektifikasi rekto rektor rekuiem rekuisisi rekuisitor rekurs rel rela relai relaks relaksasi relang relap relas relasi relatif relativisasi relativisme relativitas relau relban relevan relevansi reli reliabel reliabilitas relief religi religiositas religius relik relikui relikwi relokasi reluk relung rem rema remah remai remaja remak remanen remang remas rematik rematisme rematoid rembah rembang rembas rembat rembega rembes rembet rembih rembuk rembulan rembunai remburs rembut remedi remedial remediasi remeh remenia remet remi remiak remiling reminisensi remis remisi remoh rempa rempah rempak rempela rempelas rempenai rempeyek rempong rempuh rempuk rempus remujung remuk remunerasi remunggai rena renah renai renaisans renal renang rencah rencak rencam rencana rencang rencat renceh renceng rencet rencong renda rendabel rendah rendam rendang rendemen rendeng rendet rendong renegosiasi renek renes reng rengadean rengap rengas rengat rengeh rengek rengeng rengga renggam renggang renggat renggek rengges rengginang renggut rengit rengkah rengkam rengkeh rengket rengkit rengkong rengkudah rengkuh rengrengan rengsa rengus rengut renik renin renium renjana renjatan renjeng renjis renjong renjul renkinang renovasi renta rentabilitas rentak rentaka rental rentan rentang rentap rentas rente renteng rentenir rentet renti rentik renumerasi renung renvoi renyah renyai renyam renyang renyap renyau renyeh renyek renyem renyuk renyut reog reol reologi reometri reorganisasi reorientasi reostat reot rep-repan repang reparasi repas repatrian repatriasi repek repertoar repertorium repes repet repeten repetisi repetitif repetitor repih replik replika repolarisasi repormir reportase reporter reposisi repot representasi representatif represi represif reproduksi reprografi reptil reptilia republik republiken repuh repui reput reputasi rerak rerangka reranting reras rerata reresanan rerongkong rerot rerugi reruntuk resa resah resak resam resan resap resbang resek resensi resensor resep resepsi resepsionis reseptif reseptor reserse resersir reservat reserve reservoir reses resesi resi residen residivis residivisme residivistis residu resik resiko resimen resin resinol resipien resiprok resiprokal resistan resistans resistansi resistor resital resitasi resmi resolusi resonan resonansi resonator resor resorpsi resorsinol resosialisasi respek respirasi respirator responden respons responsi responsif restan restiformis restitusi restoran restorasi restriksi restriktif restrukturisasi restu restung resu resultan resume resurjensi ret reta retail retak retardasi retas retek retenidos retensi retet retih retikuler retina retinakulum retinitis retok retorik retorika retoris retorsi retradisionalisasi retreatisme retret retribusi retro retroaktif retrofleks retrofleksi retrogresi retrogresif retrolingual retromamal retromandibuler retrospeksi retur retus reumatismos reuni reunifikasi revaksinasi revaluasi revans reverberasi revisi revisibilitas revisionis revitalisasi revolusi revolusioner revolver rewak rewan rewanda rewang rewel rewet reyal reyot rezeki rezim rho ria riadat riah riak rial riam rian riang riap rias riba ribang ribat ribatat riben riboflavin ribosom ribu ribut rica ricau ricik ricuh rida ridan ridi riding ridip ridu rigai rigi-rigi rihat rihlah riil rijal rijalugaib rijalulgaib rijang rikuh rileks rilis rim rima rimas rimata rimba rimbas rimbat rimbawan rimbun rime rimis rimpang rimpel rimpi rimpuh rimpung rinai rincih rincis rincu rindang rinding rindu ring ringan ringgit ringih ringik ringin ringis ringkai ringkas ringkih ringkik ringking ringkuk ringkus ringsek ringsing rini rinitis rinjing rinoskop rintang rintas rintih rintik rintis rinyai riol ripit ripta ripuh ripuk ririt risa risak risalah risau riset risi risik risiko risit riskan rit ritel ritma ritme ritmis ritual ritul ritus riuh riuk riung rival rivalitas riwan riwayat robak-rabik robat-rabit robek roboh robok robot robotika rocet roda rodan rodat rodensial rodentisida rodi rodium rodok rodolit rodong roga rogoh rogok rogol roh rohani rohaniah rohmat rohulkudus roi rojeng rojol rok rokade roker roket roki rokok rol rolet rolpres roma roman romanistik romansa romantik romantika romantikus romantis romantisisme romawi rombak rombang-rambing rombeng rombik rombohedron romboid rombok rombong rombus romet romok romol-romol romong rompak rompal rompang rompeng rompes rompi rompoh rompok rompong rompyok romsus romusa rona ronce roncet ronda rondah-rondih ronde rondo rondok roneo rong rongak rongga ronggang ronggeng ronggok ronggong rongkoh rongkok rongkol rongkong rongos rongrong rongseng rongsok ronta rontek rontgen rontok ronyeh ronyok ropak-rapik rorehe rorod ros rosario rosbang rosela roseng roseola roset rosin rosok rosot rotan rotasi rotator roti rotograf rotok rowa rowot royak royal royalti royan royemen royer royong rua ruadat ruah ruai ruak ruam ruang ruap ruas ruat ruaya ruba-ruba rubah rubai rubaiat ruban rubanat rubel rubela rubeola rubiah rubidium rubik rubin rubing rubrik rubu rubung rucah rudah rudal rudapaksa rudi rudimen rudin rudu rudus rugbi rugi ruh ruhbahnat ruhban ruhbanat ruhbaniat ruilslag ruing ruit rujah rujak ruji rujuk rukam rukhsah rukiah ruko ruku rukuh rukuk rukun rukyat rukyatulhilal rum rumah rumal rumba rumbah rumbai rumbia rumbing rumbu rumen rumenia rumi rumin ruminansi ruminansia rumit rumor rumpakan rumpang rumpi rumpil rumpon rumpun rumput rumrum rumuk rumung rumus runcing runcit runding rundu-rundu runduk rundung rungau runggas runggu runggu-rangga rungguh runggut rungkau rungkuh rungkun rungkup rungu rungus rungut runjam runjang runjau runjung runtai runtang-runtung runtas runti runtih runtuh runtun runtut runut runyam runyut ruok rupa rupee rupiah rurut rusa rusak rusuh rusuk rutab rute rutenium ruterfordium rutin rutuk rutup ruwah ruwat ruwet ruyak ruyap ruyung ruyup saadah saadin saanen saat sab-sab saba sabah sabak saban sabana sabang sabar sabas sabasani sabat sabatikal sabda sabel saben sabet sabi sabil sabilillah sabit sabitah sableng sablon sabo sabot sabotase sabsab sabtu sabuk sabun sabung sabur sabut sad sadah sadai sadak sadang sadap sadar sadariah sadarulkalam sadarusalam sadau sadel sadik sadin sading sadir sadis sadisme sadistis sado sadrah sadran sadu sadur saf safa safar safari safi safih safinah safinatunajah safir safrah safron safsaf safsah saga sagai sagang sagar sagitarius sagon sagu saguer sagur sah sahabat sahaja saham sahan sahang sahap sahara saharah sahaya sahayanda sahda sahdu sahi sahib sahibulbait sahibulhajat sahibulhikayat sahifah sahih sahir sahkan sahmura sahur sahut sai saif sailan sailo saing sains saintis sair sais saja sajadah sajak sajang sajen saji sak saka sakai sakal sakang sakap sakar sakarida sakarimeter sakarin sakarosa sakat sake sakelar sakelek sakhawat sakhi sakhrat sakhsi saki sakinah saking sakit saklek sakral sakramen sakramental sakramentalia sakratulmaut sakrilegi sakristi sakrokoksigeal sakrolumbal sakrum saksama saksang saksi saksofon sakti saku sakura sal salaf salah salai salak salam salang salar salaris salasal salat salatin saldo sale saleh salem salep sali salib salihah salim salin salina salindia salindra saling salinisasi salinitas salinometer salip salir salira salivasi salju salmon salmonela salon salpeter salping saltasi salto saluir saluk salung salur salut salvarsan salvo sama samad samak saman samanera samaniah samapta samar samara samarium samas samawi samba sambal sambalewa sambang sambangan sambar sambat sambau samben sambet sambi sambil sambiloto sambit sambuk sambung sambur sambut sami samidra samijaga samin samir samo-samo samovar sampa sampah sampai sampak sampakan sampan sampang sampanye sampar samparan sampat sampean sampek sampel samper sampeyan sampil sampilik samping sampir sampling samplok sampo sampu sampuk sampul sampur samsak samsam samseng samsir samsiti samsu samudra samuh samum samun samurai sana sanad sanak sanat sanatogen sanatorium sanatulhijriah sanatulmiladiah sanawiah sanca sanda sandal sandang sandar sandel sandera sandi sanding sandiwara sando sandung sandungan sanering sang sanga sangai sangan sangar sangat sangau sangga sanggah sanggam sanggama sanggan sanggang sanggar sanggarunggi sanggat sanggep sanggerah sangging sanggit sanggrah sanggraloka sanggul sanggup sanggurdi sangha sangih sangir sangit sangka sangkak sangkakala sangkal sangkala sangkan sangkar sangkil sangku sangkul sangkur sangkuriang sangkut sangkut-paut sangli sangling sanglir sangon sangrai sangsai sangsam sangsang sangsi sangu sanguifikasi sangulun sangyang sani sanik sanitas sanitasi saniter sanjai sanjak sanjang sanjung sanksi sano sansai sanseviera sanskerta santa santai santak santam santan santap santase santau santer santet santiaji santing santir santo santonin santri santun santung sanubari sap sapa sapai saparantu sapat sapau sapersi sapi sapih sapir sapit sapogenin saponin saprofit sapta saptadarma saptamarga saptapesona sapu saput saputangan sar sara saradasi saraf sarak saran sarana sarang sarangan sarap sarasehan sarat sarau sarden sardencis sarean sareh sarekat saren sarengat sarhad sari saridele sarik sarikan saring sarira sarirah sarit sarjana sarju sarkasme sarkastis sarkode sarkoderma sarkofagus sarkolema sarkologi sarkoma sarkoplasma saron sarsaparila sartan saru saruk sarung sarut sarwa sasa sasak sasakala sasana sasando sasap sasar sasau sasi sasian sasis sasmita sastra sasus sat satai satang satanologi satar sate satelit satih satin satinet satir satire satiris sato satori satpam satria satron satu saturnus saturometer satwa satyagraha satyalencana satyawacana sau saudagar saudara saudari sauh saujana sauk saum sauna saung saur saus saut sauvinis sauvinisme sauvinistis saw sawab sawah sawai sawala sawan sawang sawangan sawar sawat sawer sawi sawit sawo sawut saya sayak sayang sayap sayat sayembara sayet sayib sayid sayidani sayidi sayidina sayu sayung sayup sayur seba sebab sebahat sebai sebak sebal sebam sebar sebarang sebarau sebasah sebat sebaur sebekah sebel sebelas sebeng sebentar seberang seberhana sebet sebit seblang sebrot sebu sebuk sebum sebun sebura seburas seburu seburus seburut sebut secang seceng secerek secina sedahan sedak sedam sedan sedang sedap sedat sedatif sedativa sedawai sedekah sedekap sedelinggam sedeng sederhana sederum sedia sediakala sedih sedikit sedimen sedimentasi sedimenter sedingin sedong sedot sedu seduayah seduh sefalopoda sefalotoraks seg sega segah segak segala segan seganda segani segar segara segata segeger segeh segel segenap segera segi segianya segitiga segmen segmental segmentasi segregasi seguna seh seharah sehat sehingga seia seilometer sein seismik seismograf seismogram seismolog seismologi seismometer sejahtera sejajar sejak sejarah sejarawan sejari sejat sejati sejingkat sejuk sek seka sekadar sekah sekak sekakar sekakmat sekal sekala sekali sekaligus sekalipun sekalor sekam sekan sekang sekap sekapar sekar sekarang sekarat sekat sekata sekaten sekati sekaut sekeber sekebun sekedeng sekeduduk sekedup sekelat sekelebatan sekelian sekema sekendal sekendi sekengkeng sekepat sekeram sekeri sekerindangan sekering sekesel seketeng sekh sekian sekilwak sekip sekira sekiram sekitar seko sekoci sekoi sekolah sekon sekongkol sekonyong-konyong sekop sekopong sekoteng sekrap sekresi sekret sekreta sekretariat sekretaris sekretin sekring sekrip sekrup seks seksi seksmaniak seksolog seksologi seksologis sekstan sekstet seksual seksualitas sektarian sektarianisme sekte sektor sektoral sekuas sekui sekul sekularis sekularisasi sekularisme sekularitas sekuler sekulir sekunar sekunder sekunyit sekuritas sekuriti sekutu sel sela selabar selaber selaberak selada seladang seladon selagi selai selain selaju selak selaka selakarang selaku selalu selam selamat selamba selampai selampe selampek selampit selan selancak selancang selancar selang selangat selangka selangkang selangkup selanting selap selapan selaput selar selara selarak selaras selarung selasa selasar selasih selat selatan selawah selawat selawe selaya selayun selayur sele selebaran selebran selebrasi selebritas selebriti selebu seleder selederi seledri seleguri selekeh selekoh selekor seleksi selekta selektif selektivitas seleler selembana selembubu selempada selempang selempukau selempuri selendang selender selendro selenggara selengkatan selenium selenografi selenologi selentang-selenting selentik selenting seleo selepa selepang selepat selepe seleper selepetan selepi selera selerak selerang seleret selesa selesai selesma seletuk seleweng selia seliap selibat selibu selibut selidik seligi seligit selimang selimpang selimpat selimut selinap selindung seling selingar selingkit selingkuh selingkup selip selipar selir selira selirak selirat seliri selisih selisik selisip selisir selit seliwer selo selofan selok seloka selokan seloki selom selomot selompret selon selonding selong selongkar selongsong selonjor selonong selop seloroh selot seloyak seloyong selter seluang seluar selubung seludang seludu seluduk seludup selui seluk seluk-beluk selukat selukung seluler seluloid selulosa selulup selulur selumar selumbar selumbari selumbat selumu selumur seluncur selundat selundup selungkang selungkup selup selupan selupat selurah seluru seluruh selusuh selusup selusur selut sema semadi semafor semah semai semaja semak semalu semambu semampai semampang semampat seman semanak semandan semandarasa semandarasah semandera semang semangat semanggi semangka semangkok semangkuk semantan semantik semantis semantung semaput semara semarai semarak semaram semarmendem semat semata semawang semawar semaya semayam semayi sembab sembabat sembada sembagi sembah sembahyang sembai sembak sembam sembap sembar sembarang sembari sembat sembawang sembayan sembelih sembelit sember semberap semberip sembesi sembeta sembiang sembilan sembilang sembilik sembilu sembir sembirat semboyan sembrani sembrono sembuang sembuh sembul sembung sembunyi sembur semburat semburit semecah semedera semejana semeleh sememeh semen semena semenanjung semenda semendarasa semenggah semenjak semenjana sementang sementara sementasi sementelah sementung semerawang semerbak semerdanta semesta semester semi semiang semidiurnal semifinal semifinalis semiidiom semikonduktor semilat seminai seminar seminari seminaris seminau semiologi semiotik semiotika semipermanen semir semitisme semivokal semok sempada sempadan sempak sempal sempalai sempana sempang sempat sempelah sempena sempil sempit semplak sempoyong sempoyongan semprit semprong semprot sempul sempur sempuras sempurna semrawut semringah semsem semu semua semunding semunian semur semut sen sena senak senam senamaki senandika senandung senang senangin senantan senantiasa senapan senapati senar senarai senario senat senator senawan senawar senawat senawi senda sendal sendalu sendam sendang sendar sendarat sendaren sendat sendawa sendayan sendayang sendel sendeng sender senderik senderung senderut sendi sending sendiri sendocong sendok sendon sendorong sendratari sendu senduduk senduk senen senewen seng sengaja sengal sengam sengangar sengangkar sengap sengar sengar-sengir sengarat sengaring sengat sengau sengelat senget senggak senggang senggara senggat senggau senggayut senggerahan senggeruk sengget senggiling senggol senggora senggugu senggugut sengguk senggulung senggut sengih sengingih sengir sengit sengkak sengkal sengkalan sengkang sengkar sengkarut sengkawang sengkayan sengked sengkedan sengkek sengkela sengkelang sengkelat sengkeling sengkelit sengkenit sengker sengketa sengkil sengkilit sengkuang sengkuap sengon sengsai sengsam sengsara sengsem sengsurit senguk sengungut sengut seni senigai senil senilitas seniman senin senior senioritas senja senjak senjang senjata senjolong senjong senohong senonoh senoyong sensasi sensasional sensibel sensibilitas sensitif sensitivitas sensor sensoris sensual sensualisme sensualitas sensur sensus senta sentada sentadu sentagi sentak sentaka sental sentali sentana sentap sentara senteng senter senterpor senti sentiare sentiasa sentigram sentil sentiliter sentimen sentimental sentimentalitas sentimentil sentimeter senting sentiong sentiung sentol sentong sentosa sentra sentral sentralisasi sentralistis sentrifugal sentripetal sentrum sentuh sentuk sentul sentung senu senuh senuk senunggang senur senyampang senyap senyar senyawa senyum senyur seok seolah-olah sep sepada sepah sepai sepak sepakat sepal sepala-pala sepam sepan sepanar sepandri sepang sepangkalan separasi separatis separatisme separbang sepasin sepat sepatbor sepatu sepeda sepedas sepegoh sepekuk sepel sepele sepeling sepen sepenuh seperah seperantu sepersi seperti sepesan sepet sepetir sepi sepih sepiker sepir sepit seples sepoi seprai seprei sepsis september septima septum sepuh sepuit sepuk sepukal sepul sepulih sepupu sepur seput sera serabi serabut serabutan seraga seragam serah serahi serai serak serakah seram serama serambi serampang serampin serampu serampuk seran serana seranah serandang serandau serandib serandung serang serangga serangguh seranggung serangkak serangsang serani seranograf seranometer seranta serap serapah serapat serasa serasah serasi serat seratah serati seratung serau seraumeter seraut serawak serawal seraya serba serbaada serbaakal serbabaru serbabisa serbadua serbaemas serbaguna serbah-serbih serbaindah serbak serbakeemasan serbakurang serbamacam serban serbaneka serbaputih serbarumah serbasalah serbasama serbasusah serbat serbausaha serbet serbi serbu serbuk serdadu serdak serdam serdang serdawa serdi serdih serealia serealin sereat serebral serebrospinal serebrum seregang sereh serembah-serembih seremban seremoni seremonial serempak serempet serempu serendah serendeng sereng serengam serengeh serengit serenjak serenjang serenta serentak serep seresin seret sergah sergam sergap sergut seri serial seriap seriat seriawan seribulan seriding serigading serigala serigunting serik serikat serikaya serimala serimpet serimpi serimpung serindai serindit sering seringai seringing seriosa serit serium serius serkah serkai serkap serkup serlah serling sermangin sermet sernak sero serobeh serobok serobot serografi seroja serok serologi serombong serompok serondeng serondok serondol serondong serong seronok seroplastik seropot serositas serosoh serot seroyong serpentina serpih sersan serse sersi serta sertifikasi sertifikat sertu seru seruak seruas seruda serudi seruduk serugah serugat seruh serui seruit seruk serul seruling serum serumat serumen serumpu serun serunai serunda serundang serundeng seruni serunjang seruntun serupih seruput seruru serut serutu seruyuk servis sesah sesai sesaing sesajen sesak sesal sesam sesamoid sesanti sesap sesar sesat sesawi sesenap seser sesi sesil sesira sesium sesoca sespan sestina sesuai sesuatu sesumbar set seta setabelan setagen setai setaka setakona setal setambun setan setana setang setangan setanggi setapak setaria setat setawar setebal seteger seteheng setek seteker setel setela seteleng setem setempel seten setenggar seter seteranah seteru setewel seti setia setiabu setiar setiga setik setin setinggi setip setir setirman setiwel setoka setokin setolop setom seton setop setoples setor setori setoter setra setrap setrat setren setreng setrik setrika setrimin setrip setruk setrum setrup setti setu setuil setum setung setup seturi seturu seudati sewa sewah sewaka sewal sewar sewat sewot sewu sfenoidal sferoid sferometer sfigmograf sfigmomanometer sfikmograf sfingofili sfingter sfinks sia siaga siah siak siakap siakon sial sialang sialit siam siamang sian sianamida sianang siang sianggit sianida sianometer sianometri sianosis siantan siap siap-sedia siap-siaga siapa siapuh siar siarah siarat siasat siat siau sibak sibar sibernetika sibilan sibir sibuk sibur sibusuk sice sicerek sida sidai sidamukti sidang sidat siderit sidi sidik siding sidomukti sidratulmuntaha siduga siduk sif sifat sifatullah sifer sifilis sifilobia sifiloid sifir sifon sigai sigak sigando sigap sigar sigaret sigasir sigenting siger sigi sigilografi sigma signifikan signifikansi signifikasi sigot sigung sih sihir sijik sijil sika sikah sikai sikak sikap sikari sikas sikat sikedempung sikeras sikik sikikih sikin sikit siklik siklis sikloid siklon sikloparafin siklotron siklus siksa siku sikudidi sikudomba sikut sil sila silabel silabis silabus silah silalatu silam silampukau silang silap silara silase silat silaturahmi silau silengah silet silih silik silika silikat silikon silikona silikosis silinder silindris silindroid silir silium silo silogisme silok silologi silometer siloptik silsilah silt silu siluet siluk siluman silungkang silvika silvikultur silvisida simak simalakama simalu simaung simbah simbai simbang simbar simbat simbion simbiosis simbiotis simbiou simbok simbol simbolis simbolisme simbukan simbur simetri simetris simfisis simfoni simifisis simile simpai simpak simpan simpang simpang-siur simpanse simpat simpati simpatik simpatisan simpel simpetal simping simpir simpleks simplifikasi simplistis simposium simpuh simpuk simpul simpur simtom simtomatis simtomatologi simulasi simulator simulfiks simultan simuntu sin sinaga sinagoga sinagoge sinambung sinanaga sinansari sinar sinatan sinau sinawar sindap sinden sinder sindeton sindikalisme sindikasi sindikat sindir sindrom sindur sineas sinekdoke sinektika sinema sinemapleks sinemaskop sinematik sinematograf sinematografi sinematografis sinemikrografik sineol sinepleks sinergi sinergis sinergisme sineskop sinestesia sinetron sing singa singahak singelar singga singgah singgan singgang singgasana singgel singgir singgit singgul singgung singit singkak singkang singkap singkat singkeh singkek singkil singkir singkong singkup singkur singlet singsat singse singset singsing singularis singulum singulun singunen sini sinis sinisme sinjang sinklin sinkonina sinkope sinkretis sinkretisasi sinkretisme sinkron sinkronis sinkronisasi sinkronisme sinode sinolog sinologi sinom sinoman sinonim sinonimi sinopsis sinoptis sinovia sinovial sinovitas sinovitis sinrili sinse sintagma sintagmatis sintaksis sintaktis sintal sintar sintas sinter sinterklas sintese sintesis sintetik sintetis sinting sintir sintonik sintren sintua sintuk sintulang sintung sinu sinuhun sinus sinusal sinusitis sinusoid sinyal sinyalemen sinyalir sinyo sinyokolas sio sioca siong siongka sip sipahi sipai sipangkalan sipat sipatung sipedas sipesan sipi sipil sipir sipit sipolan sipongang sipu sipulut siput sir sira sirah siram sirangkak sirap sirat siratalmustakim siraut sirene sirep siri siriasis sirib sirih sirik siring siringitis sirip sirkam sirke sirkol sirkuit sirkulasi sirkuler sirkumfiks sirkumfleks sirkus sirlak sirna sirokumulus sirop sirostratus sirsak siru sirup sirus sis sisa sisal sisalak sisi sisih sisik sisip sisir sista sistaltik sistem sistematik sistematika sistematis sistematisasi sistematisir sistemis sistemisasi sisterna sistitis sistole sistolik sisurut siswa siswi sit sita sitak sitat siter siti sitinggil sitir sitokrom sitolilis sitolisis sitologi sitoplasma sitrat sitrin sitrun situ situasi situasional situn situs siuh siuk siul siuman siung siur siut sivilisasi siwalan siwaratri siwer sizigi skafa skala skalanisasi skalar skalop skandal skandium skarifikasi skatola skatologi skedul skelet skema skematis skenario skene skeptis skeptisisme sketsa ski skiameter skiatika skilot skip skiping skisma skizofrenia skizoid sklerenkima sklerosis skleroterapi skolastik skolastikus skolastisi skolastisisme skombroid skop skopometer skor skorbut skorpio skors skorsing skrin skrining skrip skripsi skrobikulus skrotum skuadron skuas skuat skuos skuter slagorde slah slang slebor slendro sling slintat-slintut slip slof slogan smes smokel snob snobisme soak soal soang soarma soba soban sobat sobek sobok soda sodet sodium sodok sodomasosisme sodomi sodomia sodor soe sofa sofis sofisme sofistri sofitel soga sogan sogang sogo sogok sohar sohib sohor sohun soja sok soka sokah soker soket sokom sokong sol solah solak solang solanina solar solarimeter solder solek solempis solenoide solfatar solfatara solid solidaritas solider soliditas solilokui solinometer solipsisme solis soliter solo solois solok solokan solot solum solusi solvabilitas solven som soma somah somasi somatis somatomegali sombok sombol sombong sombrero someng somnambulis somnambulisme sompek sompeng somplak somplok sompoh sompok sompong sompret sonar sonata sonatina sondai sondanco sondang sondase sondek sonder sondok sondong soneta songar songel songgeng songket songkok songkro songong songsong sonik sono sonogram sonokeling sonor sonoran sontak sontek sontok sontoloyo sop sopak sopan sopek sopi sopir soporifik sopran sorak sorang sorban sorbet sore sorek soren sorgum sori sorog sorok sorong sorot sortir sosi sosial sosialis sosialisasi sosialisme sosialistis sosio sosio-kultural sosiobiolog sosiodemokrasi sosiodrama sosiokultural sosiolek sosiolinguistik sosiolog sosiologi sosiologis sosiometri sosionasional sosiopat sosis sositet sosoh sosok sosor soto sotoh sotong sotor soun sowan sowang soyak spageti spalasi span spanduk spaning sparing spasi spasial spasmodis spasmus spastik spatbor spatula spedometer spektakel spektakuler spektator spektograf spektogram spektrograf spektrogram spektrokimia spektrometer spektroskop spektrum spekuk spekulan spekulasi spekulatif spekulator speleologi spelter sperma spermaseti spermatid spermatofora spermatogenesis spermatosit spermatozoa spermatozoid spesial spesialis spesialisasi spesialistis spesies spesifik spesifikasi spesimen spidol spidometer spikul spil spina spion spionase spiral spiralisasi spirilum spirit spiritis spiritisme spiritual spiritualisasi spiritualisme spiritus spirometer spons sponsor spontan spontanitas spora sporadis sporangium sporofil sport sportif sportivitas spring sprint sprinter sputnik sputum sreg sregep srempet sri srigading srigunggu srigunting srikandi srikaya srimanganti sripah sripanggung sriti stabil stabilisasi stabilisator stabilitas stabilizer stadion stadium staf stafilitis stagnan stagnasi staking stalagmit stalagmometri stalaktit stalinisme stalon stamba stambon stambuk stambul stamen stamina stan standar standardisasi stanplat stanum stanza stapler staples start starter stasi stasioner stasis stasiun statis statistik statistika statistis stator status statuta statuter stearat stearin steatit steatosis stegodon steik stek steker stela steling stema stemma stempel sten stengun steno stenografer stenografi stenogram stensil step stepa stepler steradian stereo stereofoni stereofonik stereognosis stereograf stereografi stereoisomerisme stereokimia stereometri stereoskop stereotip stereotipikal steril sterilisasi sterilitas steroid steroidal sterol stetoskop stevador stibium stigma stigmata stik stiker stilbestrol stilir stilistika stilograf stimulan stimulans stimulasi stimulatif stimulator stimulus stipendium stipulasi stirena stoikiometri stok stokastik stoker stol stoliditas stolon stomata stomatitis stomatogastrik stomatoskop stop stoper stopkeran stopkontak stoples stori stormking strabotomi strata strategem strategi strategis stratifikasi stratigrafi strato stratokumulus stratopause stratopouse stratosfer stratum stratus streng streptokokus streptomisin stres striker strimin strip striptis stroberi strobila stroboskop stroke stromking strontium struktur struktural strukturalisasi strukturalisme struma studen studi studio stuko stupa sua suah suai suak suaka suam suami suaminda suang suangi suap suar suara suarang suargaloka suari suasa suasana suat suatu sub subak subal subam suban subang subbab subbagian subdirektorat subentri suberat suberin subetnik subfilum subgeneralisasi subgenus subhana subhanallah subhat subirigasi subjek subjektif subjektivisme subkategorisasi subkelas subklas subkontraktor subkultur sublema subletal sublim sublimasi sublimat submarine submukosa subordinasi subordinat suborganisasi subsider subsidi subskrip subsonik substandar substansi substansial substantif substitusi substitutif substrat subtil subtonik subtropik subuco subuh subunit subur subversi subversif subyek subyektif subyektivisme suceng suci suda sudah sudet sudi sudip sudoriferus sudra sudu suduayah suduk sudung sudut suf sufah sufal sufi sufiks sufisme sufrah sugar sugesti sugi sugih suguh sugul sugun suh suhad suhian suhu suhuf suhun suiseki suit sujana sujen suji sujud suka sukacita sukade sukamandi sukan sukar sukarela sukaria sukat sukduf suke suket suki suklapaksa sukma sukrosa sukses suksesi suksesif suku sukuisme sukun sula sulah sulalah sulalat sulam sulang sulap sulat-sulit sulbi sulfanasi sulfanilamida sulfat sulfhidril sulfolipid sulfonamida sulfur sulfurasi suli sulih suling sulit sultan sultanat sultani suluh suluk sulung sulur sulut sum sumah sumarah sumare sumasi sumba sumbang sumbangsih sumbar sumbat sumbel sumber sumbi sumbing sumbu sumbuk sumbul sumbung sumbur sumbut sumeh sumengit sumilir sumir sumirat sumo sumpah sumpal sumpek sumpel sumping sumpit sumsum sumur sumurung sun sunah sunam sunan sunat sunatullah sunbulat sundai sundak sundal sundang sundari sundep sunduk sundul sundus sundusin sundut sungai sungga sunggi sungging sunggit sungguh sungguhpun sungil sungkah sungkai sungkal sungkan sungkap sungkawa sungkem sungkit sungkuk sungkum sungkup sungkur sungkuran sungsang sungu sungut suni sunjam sunti suntiabu suntih suntik sunting suntuk sunu sunukung sunyata sunyi sup supa supai supaya supel super superblok supercepat superfisial superfosfat superheterodin superinfeksi superintenden superior superioritas superjet superkomputer superkonduktivitas superkonduktor superlatif superlativisme superlunar supermarket supermen supernatural supernova superskrip superskripsi supersonik superstar superstruktur supervisi supervisor suplai suplemen suplementasi suplesi supletoar suporter suportif supra supraalami suprafiks supramolekuler supranasional suprarene suprarenoma suprasasti suprasegmental supremasi supresif supresor surah surahi surai suralaya suraloka suram surat surati surau suraya surealis surealisme suren surfaktan surga surgaloka surgawi suri surian surih surili surjan surogat surplus suruh suruk surup surut survei surya suryakanta suryani sus susah susastra suseptibilitas susila susilat suspender suspensi suster susu susuh susuk susul susun susung susup susur susut sut sutan suten sutil sutra sutradara sutura suul suun suuzan suvenir suwarnabumi suwarnadwipa suwir suwita svedberg swa swabakar swabela swadana swadarma swadaya swadesi swadidik swadisiplin swagriya swahara swaharga swaimbas swak swakaji swakarsa swakarya swakelola swakendali swakontradiksi swalayan swanama swanggi swapraja swarabakti swasembada swasensor swasraya swasta swastanisasi swastiastu swastika swatabur swatantra swausaha sweter swike swimpak swipoa syabah syabas syafaat syafakat syafii syah syahadat syahadatain syahbandar syahda syahdan syahdu syahid syahriah syahsiah syahwat syair syairi syajar syajarah syak syaka syakban syakduf syakhsi syakir syal syala syam syamali syaman syamanisme syamsi syamsiah syamsir syamsu syantung syar syarab syarah syarak syarat syarbat syarekat syariat syarif syarifah syarik syarikat syatar syaulam syawal syeir syekh syeti syiar syikak syin syirik syiwa syiwaratri syogun syok syubhat syuhada syukur syumuliah syur syura syurah syuriah syuruk syuting taajul taala taaruf taasub taat taawud taazur tabah tabak tabal taban tabar-tabar tabarak tabaruk tabayun tabe tabel tabela tabelaris tabernakel tabia tabiat tabib tabii tabiin tabik tabir tablet tablig tablo tabloid tabo tabok tabrak tabu tabuh tabuhan tabula tabulasi tabulator tabulatur tabun tabung tabur tabut tabzir taci tadabur tadah tadaruk tadarus tadbir tadi tadir tadung tadwin taekwondo taeniasis taf tafadal tafahus tafakur tafeta tafsir tagak tagal tagan tagar tageh tagih tago tagut tahajud tahak tahal tahalul tahan tahana tahang tahap tahar taharah tahbis tahi tahiat tahil tahir tahkik tahkim tahlil tahmid tahniah tahnik tahsil tahu tahun taib taifun taiga taiko taipan tais taiso taja tajak tajali tajam tajarud tajau tajdid tajen taji tajin tajnis tajribah taju tajuk tajung tajur tajusalatin tajwid tak takabur takaful takah takak takal takang-takik takar takarir takarub takat takbir takbiratulihram takdim takdir takdis takeh takel takeyari takhayul takhlik takhsis takhta taki takigrafi takik takimeter takir takisme takjil takjub taklid taklif taklik taklikat taklim taklimat takluk takma takmurni takoah takol takometer takraw takrif takrim takrir taksa taksasi taksem taksi taksidermi taksimeter taksin taksir taksologi takson taksonomi taktik taktil taktis takuh takuk takung takur takut takwa takwil takwim takwin takyin takziah takzim takzir tal tala talabiah talah talak talam talang talar talas talasemia talasofit talbiah talek talempong talen talenan talenta tali talib talibun talium talk talkin talon talu talun talupuh talut tam tamadun tamah tamak tamam taman tamar tamarinda tamasya tamat tamatulkalam tambah tambak tambakan tambal tamban tambang tambar tambat tamber tambera tambi tambo tamborin tambuh tambul tambun tambung tambur tambus tameng tamimah tampah tampak tampal tampan tampang tampar tampas tampel tampi tampik tampil tampin tamping tampon tamponade tampuk tampung tampus tamsil tamtam tamtama tamu tamuk tamyiz tan tanah tanai tanak tanam tanang tanau tanazul tanbiat tanbihat tancang tancap tanda tandak tandan tandang tandas tandem tandik tandikat tandil tanding tandon tandu tanduk tandun tandur tandus tanfiziah tang tangan tangap tangar tangas tangeh tangen tangga tanggah tanggal tanggam tanggang tanggap tanggar tanggetong tangguh tangguk tanggul tanggulang tanggung tangis tangkah tangkai tangkaian tangkal tangkap tangkar tangkas tangki tangkil tangkis tangkue tangkuk tangkul tangkup tangkur tangkut tanglung tango tangsa tangsel tangsi tani tania tanin tanjak tanji tanjidor tanju tanjul tanjung tanjur tank tanker tanpa tansi tantang tante tanti tantiem tantrisme tanur tanwin tanwir tanwujud tanya tanzih tanzil taocang taoci taoco taoge taoisme taoke taosi tap tapa tapai tapak tapal tapang tapestri tapi tapih tapin tapioka tapir tapis taplak taprofit taptibau taptu tapui tapuk tapung tapus tar tara taraf tarah tarak taraksasin taram tarang tarantisme tarantula tarap taraqi tarasul tarawangsa tarawih tarbiah tarbil tarbus tarcis tarekat target tarhim tari tarif tarik tarikat tarikh taring taris tarjih tarkas tarling tarmak tarpaulin tarsus tartar tartil tartir tartrat taruh taruk taruko tarum tarung tarup tarzan tas tasa tasai tasak tasalsul tasamuh tasaruf tasawuf tasbeh tasbih tasdik tasel tashih tasik taslim tasmik tasrif tasrih taswir tasyahud tasyakur tasyaum tasyayuh tasybih tasydid tasyhid tasyrih tasyrik tata tataganing tatah tatai tatak tatal tatami tatanan tatang tatap tatar tatih tating tatkala tato tau taubat taucang taufah taufik tauhid tauhidiah tauke taul tauliah taun taung taur taurat tauret taurus taut tautofoni tautologi tautomerisme tautonimi tautonomi tawa tawadu tawaduk tawaf tawajuh tawak tawakal tawan tawang tawar tawarik tawaruk tawas tawasul tawes tawon tawur tayamum tayang tayib tayibah tayub tayum tazkirah tean teater teatris tebah tebak tebal teban tebang tebar tebas tebat tebeng teberau tebing tebok tebon tebu tebuhar tebuk tebung tebus tedak tedarus tedas tedeng tedong teduh tedung tefrit tega tegah tegak tegal tegang tegap tegar tegari tegarun tegas tegel tegil tegmen teguh teguk tegun tegur teh teisme teja teji teka tekaan tekad tekah tekak tekalak tekam tekan tekang tekap tekar tekat tekek tekel teken teker teki tekidanto tekik tekis teklek teklok teknifon teknik teknikus teknis teknisi teknokrasi teknokrat teknokratik teknokratisme teknologi teknonim teknonimi teko tekoan tekoh tekokak tekong tekor tekoran tekpi teks tekstil tekstur tekstural tekte tektek tektit tektogenesa tektonik tektonis tektum tekua tekuk tekukur tekun tekung tekur tel tela telaah telabang telabat telacak teladan teladas telaga telah telajak telak telakup telampung telan telancang telang telangkai telangkup telanjang telanjur telantar telap telapak telas telat telatah telatap telaten telau tele telearsika teledek teledor teledrama telefon telefoni telefoto telegenik telegraf telegrafi telegrafis telegram telegrap telekan telekap telekinesis telekomedi telekomunikasi teleks teleku telekung telelensa telemeter telemetri telempap telempong teleng telenovela telentang teleologi teleost telepati telepok telepon teleprinter telepromter telepuk teler telerang teles teleskop telestesia televisi telgram telik telikung telimpuh telinak telinga telingkah telingkung teliti telmotofit telop telor telotak telpon teluh teluk teluki telungkup telunjuk telur telurat telurit telus telusuk telusur telut telutuh telutur telutut tem tema temaah temabur temaha temahak temak temalang temali teman temangau temangga temanten temara temaram temas tematik tematis tematisasi temayun tembadau tembaga tembak tembakang tembakau tembakul tembam tembang tembarau tembatar tembatu tembek tembekar tembel tembelang tembelian tembeliung tembelok tembem tembera temberam temberang temberas temberek tembereng temberih temberos tembesu tembiang tembikai tembikar tembilang tembilar tembiring tembis tembok tembolok tembong tembosa tembra tembu tembuk tembuku tembung tembuni tembus tembusu temegun temeh temengalan temenggung temenung temesar temetu temiang temilang temin temokus temoleh tempa tempah tempala tempan tempang tempap tempat tempaus tempawak tempawan tempayak tempayan tempayung tempe tempek tempel tempelak tempeleng temperamen temperamental temperas temperatur temperau tempiar tempias tempik tempilai tempinah tempinis templek templok tempo tempoh tempolong temponek tempong temporal temporer tempoyak tempoyan tempua tempuh tempui tempuling tempunai tempunik tempur tempurung tempus tempuyung temu temucut temukut temungkul temuras temurat temut-temut tenaga tenahak tenak tenam tenang tenar tenat tenda tendang tendas tendensi tendensius tender tendinitis tendo tendon tener teng tengadah tengah tengak tengalan tengar tengara tengas tenggadai tenggak tenggala tenggalung tenggan tenggang tenggara tenggarang tenggat tenggayun tenggayung tenggehem tenggek tenggelam tengger tenggiling tenggiri tenggiring tenggok tenggorok tengguli tengik tengil tengkalak tengkalang tengkaluk tengkam tengkang tengkar tengkarah tengkarap tengkaras tengkawang tengkek tengkel tengkelek tengker tengkerong tengkes tengking tengkoh tengkolok tengkorak tengku tengkujuh tengkuk tengkulak tengkuluk tengkurap tengkuyung tengok tengteng tengu tenis tenjet tenok tenong tenor tensi tentakel tentamen tentang tentara tentatif tentawan tenteng tenteram tentir tentu tenuk tenun tenung teodolit teokrasi teokratis teolog teologi teologis teoretikus teoretis teori teorisasi teosofi teosofis tepa tepak tepam tepas tepat tepeh tepek tepekong teperam tepes tepet tepi tepik tepis teplok tepo tepok tepos teptibau tepu tepuk tepung tepurang tepus ter tera teracak terada terajam teraju terak terakota terakup teral terala terali teraling teramisin terampil teran teranas terang terap terapang terapeutik terapi terarium teras terasi teraso terasul teratai teratak teratap teratologi teratu terau terawang teraweh terban terbang terbis terbit terbium terbul terbut terein terem terenang terendak terenen terentang terenyuh teres teret teretet teri teriak teriba terigu terik terika terikit teriko terima terin terindil tering teripang terista teritih teritik teritip teritis teritorial teritorium teriujung terjal terjang terjemah terjun terka terkadang terkam terkap terkul terkup terlak terlalu terlut term termaestesia termal termin terminal terminasi terminografi terminologi termion termionika termistor termodinamika termodinamis termoelektris termoelektrisitas termofili termofilik termofosforesens termograf termogram termohigrograf termokimia termoklin termolabil termolisis termolistrik termoluminesens termometer termonuklir termoplastik termos termosfer termostat terna ternak terobos terok teroka terombol teromol terompah terompet terondol terong terongko teropong teror teroris terorisme terowongan terpa terpal terpana terpedo terpentin tersier tertawa tertib terubuk terubus terucuk terucukan teruk terum terumba terumbu terumbuk teruna terung terungku teruntum terup terus terusi terwelu terzina tes tesaurus tesis tesmak testa testamen tester testes testikel testimonium testing testis testosteron teta tetak tetal tetampan tetamu tetangga tetanus tetap tetapi tetar tetas teteguk teteh tetek tetelan tetelo teter teterapan tetes tetibar tetibau tetikus tetirah tetiron tetoron tetra tetrahidrokanabinol tetrahidron tetraklorida tetraploid tetris tetua tetuang tewas teyan tezi theta tiada tiaga tiam tian tiang tiangui tiap tiara tiarap tib tiba tiban tidak tidur tifa tifus tiga tigari tigas tihul tijaniah tik tika tikai tikam tikar tikas tike tiket tikim tikpi tikung tikus tilam tilan tilang tilap tilas tilawah tilde tilik tim timah timang timarah timba timbal timbang timbau timbel timbil timbo timbre timbrung timbuk timbul timbun timburu timbus timi timol timpa timpal timpang timpani timpanitis timpanum timpas timpuh timpuk timpus timu-timu timun timur timus tin tindak tindan tindas tindawan tindih tindik tindis tiner ting tinggal tinggam tinggi tinggung tingi tingkah tingkal tingkalak tingkap tingkar tingkarang tingkarung tingkas tingkat tingkeb tingkis tingkrang tingkuh tingting tingtong tingtur tinja tinjak tinjau tinju tinta tinting tintir tinulat tip tipar tipe tipi tipikal tipis tipograf tipografi tipologi tipologis tipu tir tirah tirai tirakat tiram tiran tirani tiras tiraton tirau tiri tiris tirkah tiroid tiroiditis tiroksin tirta tiru tirus tis tisik tisotropi tisu titah titanium titar titel titer titi titik titilasi titimangsa titinada titip titir titis titisara titit titrasi titrimetri tituler tiung tiup tiwah tiwikrama tiwul tmesis toapekong toas tobak tobang tobat toblos toboh tobong tobralko todak todong toga togan toge togel togok toh tohok tohor toilet tok tokak tokcer toke tokek toko tokoh tokok tokong toksemia toksikogenik toksikolog toksikologi toksin toktok tol tolak tolan tolap toleh toleran toleransi tolerir tolok tolol tolong toluena tom toman tomang tomat tombak tomboi tombok tombol tombola tombong tombru tomong tompel ton tona tonase tonem tonetika tong tonggak tonggara tonggek tonggeret tonggok tonggong tonggos tongkah tongkang tongkat tongkeng tongkol tongkor tongkrong tongol tongong tongpes tongsan tongseng tongsit tongtong tonik tonikum tonil tonis tonisitas tonit tonjok tonjol tonometer tonsil tonton tonus top topan topang topas topdal topek topeng topes tophit topi topiari topik topikalisasi topikalitas topo topografi topografis topong toponimi torak toraks torani toreh torek tores torida torium tornado torne toro torpedo torpedor torsi torso tortor torus tos tosan toserba total totalisator totalitas totaliter totaliterisme totau totem totemisme totemproparte totok totol towel toya toyor tra trabekula tradisi tradisional tradisionalisme trafo tragedi tragikomedi tragis tragus trailer trakeid trakom traksi traktasi traktat traktir traktor traktus trama trampolin trans transaksi transduksi transek transeksual transenden transendental transfer transfigurasi transformasi transformasionalis transformatif transformator transfusi transgenik transisi transistor transit transitif transkrip transkripsi translasi transliterasi translokasi translusens transmigran transmigrasi transmisi transmiter transmogrifikasi transmutasi transnasional transonik transparan transparansi transpirasi transplantasi transpor transportasi transposisi transversal transvetisme trap trapesium trapezoid tras trauler trauma traumatis travesti trawler trayek trek trekbal trem trema trematoda trembesi tremer tremor tren trendi trengginas trenyuh tres tresna tri trias triatlon tribokelistrikan tribologi tribrata tribunal tribune tributa trica tridarma tridentat trienial triennale trifoliat triftong trigatra trigemius trigliserida trigonometri trigraf trihidrik trik triko trikotomi trikuspid tril trilateral trilingga trilipat triliun trilogi trilomba trim trimatra trimurti trinil trinitas trinitrotoluena trio triode trip tripartit tripleks triplet triplik tripod triprasetia trips tripsin tripsinogen triptofan triptotos trisep trisula tritunggal triturasi triumvirat trivalen trivialitas triwangsa triwindu triwulan trofi trofoblas troi troika trokanter trokea troli trombin trombon trombosis trombosit trombus tromol trompet trompong tropik tropika tropis tropisme tropopause troposfer tropus tros trotoar trubadur truf truk truntum trusa tsar tsunami tsuru tua tuah tuai tuak tual tuala tualang tuam tuan tuang tuangku tuanku tuap tuar tuarang tuas tuba tubagus tuban tube tubektomi tuberkulosis tubi tubin tubir tubruk tubuh tuding tuduh tudung tufa tufah tugal tugar tugas tugi tugu tugur tuhan tuhfah tuhfahlulajnas tuhfahtulajnas tuhfat tuhmah tuhu tuidi tuil tuit tujah tuji tuju tujuh tujul tuk tukai tukak tukal tukam tukang tukar tukas tukik tukil tukmis tuksedo tuku tukuk tukul tukun tukung tukup tula tulah tulak tulang tular tulat tule tulen tuli tulis tulium tulu tulup tulus tum tuma tuman tumang tumbakan tumbal tumbang tumbas tumben tumbu tumbuh tumbuk tumbung tumenggung tumika tumis tumit tumor tumpah tumpak tumpal tumpang tumpang-tindih tumpas tumpat tumpeng tumper tumpil tumplak tumplek tumpu tumpuk tumpul tumpur tumtam tumu tumungkul tumus tun tuna tunaaksara tunabusana tunadaksa tunaganda tunagizi tunagrahita tunai tunak tunakarya tunalaras tunam tunan tunanetra tunang tunapolitik tunarungu tunas tunasosial tunasusila tunatenaga tunawicara tunawisma tunda tundan tundang tundra tunduk tundun tundung tung tungau tunggak tunggal tungganai tunggang tunggik tungging tunggu tunggul tungkahan tungkai tungkak tungkap tungku tungkul tungkup tungkus tungro tungsten tungu tunik tunjal tunjam tunjang tunjuk tunjung tuntas tuntun tuntung tuntut tunu tupai tur tura turang turangga turap turas turba turbiditas turbin turbogenerator turbojet turbulen turbulensi turfat turgor turi turiang turinisasi turis turisme turistik turkuois turmalin turnamen turne turnoi tursi turun turus turut tus tusam tusir tuslah tustel tusuk tuter tutor tutorial tuts tutu tutuh tutuk tutul tutung tutup tutur tutut tuwuhan tuwung tuyuk tuyul uai uak uan uanda uang uap uar uba ubah uban ubang ubar ubat ubek ubel uber ubet ubi ubikuitas ubin ubit ubrak-abrik ubub ubudiah ubun-ubun ubung ubur-ubur ubyang-ubyung ucap ucek uci-uci ucis ucok ucu ucus uda udak udam udang udani udap udar udara ude udek udel udeng udet udi udik udim udo udu uduh uduk udut uek ufti ufuk ugahari ugal-ugalan ugem uger uget-uget ugut uhu uih uik uir-uir uis uit ujana ujang ujar uji uju ujub ujud ujuk ujul ujung ukas ukhrawi ukhuwah ukik ukir uktab ukulele ukup ukur ula-ula ulah ulak ulam ulama ulan ulang ulang-alik ulang-aling ulap-ulap ular ulas ulat ulayah ulayat ulek ulem ulen ules ulet uli ulik ulin uling ulir ulit ulna ulos ultima ultimatum ultimo ultimogenitur ultra ultrafilter ultramarin ultramikroskopik ultramikroskopiks ultramodern ultrasonik ultrasonika ultrasonografi ultraungu ultraviolet ulu uluk ulun ulung ulup ulur uma umak uman umang-umang umara umat umbai umbalan umban umbang umbang-ambing umbar umbara umbi umbilikus umbin umbisi umbo umbra umbu umbuk umbul umbur-umbur umbut umi umlaut umpak umpama umpan umpat umpet umpil umpuk umpun umput umrah umu umuk umum umun umur unam uncang uncang-uncit uncit uncu uncue uncui unda undagi undak undan undang undi unduh unduk-unduk undung-undung undur unek-unek ungah-angih ungam ungar unggah unggal unggang-anggit unggas unggat-unggit unggis unggit unggul unggun unggut ungka ungkah ungkai ungkak ungkal ungkap ungkat ungkau ungkil ungkir ungkit ungkul ungkur ungsi ungti ungu unguis uni uniat unifikasi uniform uniformitas unik unilateral unilineal unilinear union uniseks uniseluler unit unitaris unitarisme univalen universal universalia universalisme universalitas universiade universitas universiter universitet universum unjuk unjun unjung unjur unjut unsur unsuri unta untai untal untang-anting unti until unting untir untuk untun untung untut unun unyai upa upaboga upacara upaduta upah upajiwa upak upakara upakarti upam upan upanishad upar upau upawasa upaya upet upeti upih upik upil upsilon ura-ura uraemia urah urai urak urakus uran-uran uranisme uranium uranologi uranus urap uras urat urban urbanisasi urbanisme urdu urea uremia ureter uretra uretritis urgen urgensi uri urian uribang urik urinalisis urine uring urinoar urinometer urip uris urit urita uritan urna urolog urologi uroskopi uruk urun urung urup urus urut usada usah usaha usai usak usali usam usang usap usar usat user-user usia usik usil usir uskup usrek ustad ustaz ustazah usuk usul usuluddin usung usur usus usut uswah utak-atik utama utang utar-utar utara utarid utas uterus utih utik utilitas utopia utopis utopisme utrikel utrolokal utuh utus uvula uvular uwar uwungan uwur uyuh uzlah uzur vagina vak vakansi vakasi vakatur vakbon vaksin vaksinasi vakum vakuol vakuola valas valensi valentin valentine valeria valid validitas valis valium valorisasi valuta vampir vanadium vandal vandalisme vandalistis vandel vanili varia variabel variabilitas varian variansi variasi variatif varietas variola varises vas vasal vasektomi vaselin vaskular vaskularisasi vaskuler vaskulum vasodilasi vasodilator vasomotor vatikan vaucer vedda veddoid veem vegetarian vegetarir vegetaris vegetarisme vegetasi vektor velamentum velar velarisasi velodrom velositas velum vena venal venalitas vendeta vendor ventilasi ventilator ventrikel ventrikulus venus verba verbal verbalisan verbalisasi verbalisme verbalistis verbatim verbena verdigris verifikasi verifikatur veritisme verkoper vermilium vermiliun vermiseli vermisida vernis veronal verset versi verso verstek versus vertebra vertebrata vertikal verzet vespa veste vestibul vestibula vestibulum vet veter veteran veterinarian veteriner vetiver veto vetsin via viabel viabilitas viaduk vibran vibrasi vibrator vibrio vide video videofon videoklip videoteks vigia vigili vignet vikariat vikaris vila vinil vinyet viol viola violces violet violin violinis violis virga virginia virgo virilis virilisme virilitas virilokal virologi virtual virtuoso virulen virulensi virus visa visera visi visibel visibilitas visioner visitasi visitator visiun viskometer viskose viskositas vista visual visualisasi visum visus vitakultur vital vitalitas vitamin vitelin vitiligo vitreositas vitrifikasi vitriol vivarium vivifikasi vivipar vla vlek voal vodka vokabularium vokabuler vokal vokalia vokalis vokasional vokatif vokoid volatil volatilitas voli volt voltameter voltase volume volumeter volumetri volunter vonis vopo vorteks voting votum vrah vrahoto vulgar vulgata vulger vulkan vulkanis vulkanisasi vulkanolog vulkanologi vulkavit vulpen vulva vulvektomi vuring waad waadat wabah wabakdu wabarakatuh wacana wadah wadak wadal wadam wadat wadi wadon waduh waduk wadung wafa wafak wafat wage wagon wagu wah wahah wahai waham wahana wahdah wahdaniah wahdiah wahib wahid wahyu wai waid waima waisak waisya waitankung wajah wajan wajar wajib wajik wak wakaf wakil waktu wakun wakwak walabi walad walafiat walah walak walakhir walakin walang walangkopo walango walat walau walaupun waledan waleh walet walhal walhasil wali walikukun walimah walimana waliullah wallahi wallahualam wals waluh waluku wambrau wan wana wanara wanawisata wanda wandu wang wangi wangkang wangsa wangsit wani wanita wanodya wantah wantek wanti-wanti wantilan wara warak warakawuri waralaba warangan waranggana warangka waras warasah warawiri wardi warga wari waria warid waringin waris warita warkat warna warna-warni warok warsa warta waru waruga waruna warung warwar wasahlan wasak wasal wasalam wasangka wasi wasiat wasilah wasir wasit wasitah waskita waskom waslah waslap waspada wastafel waswas watak watan watang watas watase watermantel watermark waterpas waterpruf watt watu wau wawa wawancara wawanmuka wawanrembuk wawas wawu wayang wayuh wazari wazir weda wedam wedana wedang wedani wedar wede wedel weduk wegah weh weharima wejang wekel weker welahar welas weling welirang welit welter welut wenang wenter werak werangka werda werdatama were werek wereng werit werst wese wesel weselbor wesi wesket westernis westernisasi wet wetan weton wewarah wewaton wewe wibawa wicaksana wicara widi widiaiswara widiwasa widodari widoro widuri widyaiswara widyawisata wig wigata wihara wijaya wijayakusuma wijayamala wijayamulia wijdaniah wijen wiji wijik wikalat wiku wiladah wilah wilangon wilayah wilis wilwatikta wimana winaya windu wing wingit winglet winter wira wirabank wiracarita wiraga wirakarya wirang wiraniaga wirasuara wiraswasta wirid wiru wisa wisal wisata wisaya wisesa wisik wiski wisma wisnu wisuda witir wiwaha wiweka wiyaga wiyata wizurai wodka wol wolanda wolfram wombat won wong wora-wari wortel wosi wotogal-agil wrang wreda wredatama wregu wrisaba wudani wudu wuduk wujud wuker wukerar wuku wukuf wulan wulang wulu wulung wungon wungu wuwungan xantat xantena xantofil xenia xenofili xenofobia xenoglosia xenograf xenokrasi xenolit xenomania xenon xerofil xerofit xeroftalmia xerografi xerosis xifoid xilem xilena xilofon xilograf xilografi xiloid xiloidina xilol xilologi xilonit xilosa yad yahud yahudi yahudiah yahwe yais yaitu yakin yakis yakitori yakjuj yakni yaksa yakun yakut yamtuan yang yantra yard yargon yasan yasmin yasti yatim yaum yaumudin yaumulakhir yaumulaza yaumuljamak yaumuljaza yaumulkiamah yaumulmahsyar yayasan yayi yayu yehova yel yen yeyunum yodium yoga yoghurt yogi yogia yohimbina yojana yokal yolk yos yosong yoyo yubileum yuda yudikatif yudisial yudisium yudo yudoka yuk yunani yunda yunior yunta yupa yupiter yura yuran yuridis yuris yurisdiksi yurisprudensi yustisi yute yuvenil yuwana yuwaraja yuyitsu yuyu zabah zabaniah zabarjad zabib zabur zadah zahid zai zaim zair zaitun zakar zakat zakelek zakiah zakum zal zalim zalir zaman zamin zamindar zamrud zamzam zan zanggi zantara zarafah zarah zaratit zariah zariat zat zatua zawal zawiat zebra zebu zelot zen zend-avesta zendeling zending zeni zenit zeolit zeoponik zero zet zeta ziadah ziarah zib zig-zag zigomorf zigot zikir zilullah zimase zimi zimogen zimolisis zimosis zimotik zimurgi zina zindik zink zinkografi zion zionis zionisme zirafah zirah zirbad zirkonia zirkonium zirnikh ziter zodiak zoetrop zohal zohrah zohrat zona zonasi zonder zone zoning zoofit zoofobia zoogani zoogeografi zoologi zoonosis zoosemiotika zuama zuhud zuhur zulfikar zulhijah zulkaidah zulmat zulu zurafah zuriah zus `
\ No newline at end of file
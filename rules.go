@@ -0,0 +1,121 @@
+package stemmer
+
+import (
+	"bytes"
+	"regexp"
+)
+
+// prefixRule is one rule of the derivation prefix cascade.
+// When match matches a word, its removals are tried in order.
+type prefixRule struct {
+	match    *regexp.Regexp
+	removals []prefixRemoval
+}
+
+// prefixRemoval replaces prefix at the start of a word with recode.
+// When guard is set, it is only tried on words starting with guard.
+type prefixRemoval struct {
+	prefix string
+	recode string
+	guard  string
+}
+
+// apply reports whether the removal applies to word and,
+// if so, returns the word with the prefix replaced.
+func (r prefixRemoval) apply(word []byte) ([]byte, bool) {
+	if !bytes.HasPrefix(word, []byte(r.prefix)) {
+		return nil, false
+	}
+	if r.guard != "" && !bytes.HasPrefix(word, []byte(r.guard)) {
+		return nil, false
+	}
+
+	stem := make([]byte, 0, len(r.recode)+len(word)-len(r.prefix))
+	stem = append(stem, r.recode...)
+	return append(stem, word[len(r.prefix):]...), true
+}
+
+// findPrefixRule returns the first rule in rules matching word, or nil.
+func findPrefixRule(rules []prefixRule, word []byte) *prefixRule {
+	for i := range rules {
+		if rules[i].match.Match(word) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func rule(pattern string, removals ...prefixRemoval) prefixRule {
+	return prefixRule{match: regexp.MustCompile(pattern), removals: removals}
+}
+
+func strip(prefix string) prefixRemoval {
+	return prefixRemoval{prefix: prefix}
+}
+
+func recode(prefix, recode string) prefixRemoval {
+	return prefixRemoval{prefix: prefix, recode: recode}
+}
+
+func guarded(guard, prefix string) prefixRemoval {
+	return prefixRemoval{prefix: prefix, guard: guard}
+}
+
+// plainPrefixRules are the "di-" "ke-" and "se-" prefixes.
+// A word matching one of them is not tried against any other rule.
+var plainPrefixRules = []prefixRule{
+	rule(`^di\S{1,}`, strip("di")),
+	rule(`^ke\S{1,}`, strip("ke"), strip("keber")),
+	rule(`^se\S{1,}`, strip("se")),
+}
+
+// complexPrefixRules are the "be-" "te-" and "me-" prefixes.
+// Within each group only the first matching rule is applied.
+var complexPrefixRules = [][]prefixRule{
+	{
+		rule(`^(ber)[aiueo]\S{1,}`, strip("ber"), recode("ber", "r")),
+		rule(`^(ber)[^aiueor]([a-z\-]+)\S{1,}`, strip("ber")),
+		rule(`^(ber)[^aiueor]([a-z\-]+)er[aiueo]\S{1,}`, strip("ber")),
+		rule(`^belajar\S{0,}`, strip("bel")),
+		rule(`^(be)[^aiueolr]er[^aiueo]\S{1,}`, strip("be")),
+	},
+	{
+		rule(`^(terr)\S{1,}`),
+		rule(`^(ter)[aiueo]\S{1,}`, strip("ter"), recode("ter", "r")),
+		rule(`^(ter)[^aiueor]er[aiueo]\S{1,}`, strip("ter")),
+		rule(`^(ter)[^aiueor]\S{1,}`, strip("ter")),
+		rule(`^(te)[^aiueor]er\S{1,}`, strip("te")),
+		rule(`^(ter)[^aiueor]er[^aiueo]\S{1,}`, strip("ter")),
+	},
+	{
+		rule(`^(me)[lrwyv][aiueo]`, strip("me")),
+		rule(`^(mem)[bfvp]\S{1,}`, strip("mem"), strip("member")),
+		rule(`^(mem)((r[aiueo])|[aiueo])\S{1,}`, recode("mem", "m"), recode("mem", "p")),
+		rule(`^(men)[cdjszt]\S{1,}`, strip("men")),
+		rule(`^(men)[aiueo]\S{1,}`, recode("men", "n"), recode("men", "t")),
+		rule(`^(meng)[ghqk]\S{1,}`, strip("meng")),
+		rule(`^(meng)[aiueo]\S{1,}`, strip("meng"), recode("meng", "k"), recode("meng", "ng"), strip("menge")),
+		rule(`^(meny)[aiueo]\S{1,}`, recode("meny", "s"), strip("me")),
+	},
+}
+
+// pePrefixRules are the "pe-" prefixes. They are tried after
+// complexPrefixRules, so words like "memperbarui" lose both prefixes.
+var pePrefixRules = []prefixRule{
+	rule(`^(pe)[wy]\S{1,}`, strip("pe")),
+	rule(`^(per)[aiueo]\S{1,}`, strip("per"), recode("per", "r")),
+	rule(`^(per)[^aiueor]\S{1,}`, strip("per"), strip("perse")),
+	rule(`^(per)[^aiueor]([a-z\-]+)(er)[aiueo]\S{1,}`, strip("per")),
+	rule(`^(pem)[bfv]\S{1,}`, strip("pem")),
+	rule(`^(pem)(r[aiueo]|[aiueo])\S{1,}`, recode("pem", "m"), recode("pem", "p")),
+	rule(`^(pen)[cdjzts]\S{1,}`, strip("pen")),
+	rule(`^(pen)[aiueo]\S{1,}`, recode("pen", "n"), recode("pen", "t")),
+	rule(`^(peng)[ghq]\S{1,}`, strip("peng")),
+	rule(`^(peng)[aiueo]\S{1,}`, strip("peng"), recode("peng", "k"), strip("penge")),
+	rule(`^(peng)[^ghq]\S{1,}`, strip("peng")),
+	rule(`^(peny)[aiueo]\S{1,}`, recode("peny", "s"), strip("pe")),
+	rule(`^(pel)[aiueo]\S{1,}`, recode("pel", "l"), guarded("pelajar", "pel")),
+	rule(`^(pe)[^rwylmn]er[aiueo]\S{1,}`, strip("pe")),
+	rule(`^(pe)[^rwylmn]\S{1,}`, strip("pe")),
+	rule(`^(pe)[^aiueor]er[^aiueo]\S{1,}`, strip("pe")),
+}
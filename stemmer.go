@@ -3,874 +3,517 @@
 package stemmer
 
 import (
+	"bytes"
 	"regexp"
 	"strings"
+	"sync/atomic"
 )
 
-type Stemmer struct{}
+type Stemmer struct {
+	dict   atomic.Pointer[Dictionary]
+	mode   Mode
+	casing Casing
+	strict bool
+
+	stopwords *Dictionary
+	filter    StopwordFilter
+	protected atomic.Pointer[Dictionary]
+	folded    atomic.Pointer[foldedWords]
+	overrides atomic.Pointer[Overrides]
+	cache     *cache
+}
 
-var rootWords map[string]int
+// rootWords is the dictionary shared by every Stemmer created by New.
+var rootWords atomic.Pointer[Dictionary]
 
 func init() {
 	InitRootWords()
 }
 
+// InitRootWords (re)builds the shared dictionary from the embedded root words.
+// The new dictionary is swapped in atomically, so it is safe to call
+// while other goroutines are stemming.
 func InitRootWords() {
-	rootWords = make(map[string]int)
+	rootWords.Store(DefaultDictionary())
+}
 
-	words := strings.Split(string(data), " ")
-	for k := range words {
-		rootWords[words[k]] = k
+// New returns a Stemmer configured by opts. Without options it uses
+// the Nazief-Adriani mode and the shared embedded dictionary.
+func New(opts ...Option) *Stemmer {
+	s := &Stemmer{}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
-func New() *Stemmer {
-	return &Stemmer{}
+// NewWithDictionary returns a Stemmer that owns the given dictionary
+// instead of the shared one. A nil dict falls back to the shared dictionary.
+func NewWithDictionary(dict *Dictionary, opts ...Option) *Stemmer {
+	return New(append([]Option{WithDictionary(dict)}, opts...)...)
 }
 
+// Mode returns the algorithm used by s.
+func (s *Stemmer) Mode() Mode {
+	return s.mode
+}
+
+// Casing returns the capitalisation of the stems returned by s.
+func (s *Stemmer) Casing() Casing {
+	return s.casing
+}
+
+// Strict reports whether s returns words without a confirmed root unchanged.
+func (s *Stemmer) Strict() bool {
+	return s.strict
+}
+
+// Dictionary returns the dictionary owned by s. A Stemmer on the
+// shared dictionary first takes a private copy of it, like
+// AddRootWords, so changes to the result never reach other stemmers.
+func (s *Stemmer) Dictionary() *Dictionary {
+	return s.ownDictionary()
+}
+
+// dictionary returns the dictionary consulted by s without copying
+// the shared one.
+func (s *Stemmer) dictionary() *Dictionary {
+	if d := s.dict.Load(); d != nil {
+		return d
+	}
+	return rootWords.Load()
+}
+
+// SetDictionary atomically replaces the dictionary consulted by s.
+// A nil dict makes s fall back to the shared dictionary.
+func (s *Stemmer) SetDictionary(dict *Dictionary) {
+	s.dict.Store(dict)
+}
+
+// Stemm returns the stem of each word, capitalised as set by the
+// Casing of s. Stopwords are stemmed like any other word, so the
+// stems line up with ws; use Filter to drop them first.
 func (s *Stemmer) Stemm(ws ...string) []string {
+	st := s.stemming()
 	result := []string{}
 	for _, w := range ws {
-		word := []byte(strings.ToLower(w))
-		if s.IsRootWord(word) {
-			result = append(result, w)
-		} else {
-			result = append(result, s.removingProcess(word))
-		}
+		stem, _ := st.stemm(w, KeepStopwords)
+		result = append(result, stem)
 	}
 	return result
 }
 
-func (s *Stemmer) IsRootWord(word []byte) bool {
-	if _, ok := rootWords[string(word)]; ok {
-		return true
-	}
-	return false
+// WordStemmer is implemented by stemmers of single words, such as
+// the Snowball ports for other languages, and by *Stemmer.
+type WordStemmer interface {
+	Stem(word string) string
 }
 
-func (s *Stemmer) removingProcess(word []byte) string {
-	// try to only remove derivation prefixes
-	p0 := s.removeDerivationPrefixes(word)
-	if s.IsRootWord(p0) {
-		return string(p0)
-	}
+var _ WordStemmer = (*Stemmer)(nil)
 
-	p1 := s.removeInflectionSuffixes(word)
-	p2 := s.removeDerivationSuffixes(p1)
-	if s.IsRootWord(p2) {
-		return string(p2)
-	}
-	p3 := s.removeDerivationPrefixes(p2)
-	p4 := s.removeDerivationPeople(p3)
-	return string(p4)
+// Stem returns the stem of word like Stemm, without allocating a slice.
+func (s *Stemmer) Stem(word string) string {
+	st := s.stemming()
+	stem, _ := st.stemm(word, KeepStopwords)
+	return stem
 }
 
-// isRulePrecedence checks the Rule Precedence
-// combination of Prefix and Suffix
-// "be-lah" "be-an" "me-i" "di-i" "pe-i" or "te-i"
-func (s *Stemmer) isRulePrecedence(word []byte) bool {
-	if match, _ := regexp.Match(`^(be)([a-z\-]+)(lah|an)$`, word); match {
-		return match
-	} else if match, _ := regexp.Match(`^(di|[mpt]e)([a-z\-]+)(i)$`, word); match {
-		return match
+// stemm stems one word given in any case. Protected words are
+// returned verbatim and overridden words get their stem from the
+// overrides. It reports false when filter drops the word as a stopword.
+func (st *stemming) stemm(w string, filter StopwordFilter) (string, bool) {
+	lower := strings.ToLower(w)
+	if st.isProtected(lower) {
+		return w, true
+	}
+	if filter == FilterBeforeStemming && st.IsStopword(lower) {
+		return "", false
 	}
 
-	return false
-}
+	root := st.root(lower)
+	if filter == FilterAfterStemming && st.IsStopword(root) {
+		return "", false
+	}
+	if st.strict && root == lower {
+		if _, ok := st.words[lower]; !ok {
+			// a strict stemmer keeps a word without a root word as given
+			return w, true
+		}
+	}
 
-// isdisallowedprefixsuffixes checks Disallowed Prefix-Suffix Combinations
-// "be-i" . "di-an" . "ke-i|kan" . "me-an" . "se-i|kan" or "te-an"
-func (s *Stemmer) isDisallowedPrefixSuffixes(word []byte) bool {
-	if match, _ := regexp.Match(`^(be)([a-z\-]+)(i)$`, word); match {
-		return true
-	} else if match, _ := regexp.Match(`^(di)([a-z\-]+)(an)$`, word); match {
-		return true
-	} else if match, _ := regexp.Match(`^(ke)([a-z\-]+)(i|kan)$`, word); match {
-		return true
-	} else if match, _ := regexp.Match(`^(me)([a-z\-]+)(an)$`, word); match {
-		return true
-	} else if match, _ := regexp.Match(`^(se)([a-z\-]+)(i|kan)$`, word); match {
-		return true
-	} else if match, _ := regexp.Match(`^(te)([a-z\-]+)(an)$`, word); match {
-		return true
+	if st.casing == RestoreCase {
+		return restoreCase(w, root), true
 	}
-	return false
+	return root, true
 }
 
-// removeInflectionSuffixes
-// 1. Particle "-lah" "-kah" "-tah" and "-pun"
-// 2. Possesive Pronoun "-ku" "-mu" "-nya"
-func (s *Stemmer) removeInflectionSuffixes(word []byte) []byte {
-	if match, _ := regexp.Match(`([klt]ah|pun|[km]u|nya)$`, word); match {
-		re, _ := regexp.Compile(`([klt]ah|pun|[km]u|nya)$`)
-		infSuf := re.ReplaceAll(word, []byte([]byte("")))
-
-		if match, _ := regexp.Match(`([km]u|nya)$`, infSuf); match {
-			re, _ := regexp.Compile(`([km]u|nya)$`)
-			posPron := re.ReplaceAll(infSuf, []byte(""))
-			return posPron
+// root returns the stem of a lowercase word, from the cache if s has one.
+func (st *stemming) root(lower string) string {
+	if st.cache != nil {
+		if root, ok := st.cache.get(st.source, lower); ok {
+			return root
 		}
+	}
 
-		return infSuf
+	root, ok := st.overrides[lower]
+	if !ok {
+		root = lower
+		if word := []byte(lower); !st.isRoot(word) {
+			stem, _, _ := st.stem(word)
+			root = string(stem)
+		}
 	}
 
-	return word
+	if st.cache != nil {
+		st.cache.add(st.source, lower, root)
+	}
+	return root
 }
 
-// removeDerivationSuffixes
-// "-i" . "-kan" . "-an"
-func (s *Stemmer) removeDerivationSuffixes(word []byte) []byte {
-	var base []byte
-
-	if match, _ := regexp.Match(`(kan)$`, word); match {
-		re, _ := regexp.Compile(`(kan)$`)
-		base = re.ReplaceAll(word, []byte([]byte("")))
-		if s.IsRootWord(base) {
-			return base
+func (s *Stemmer) IsRootWord(word []byte) bool {
+	_, ok := s.dictionary().load()[string(word)]
+	return ok
+}
+
+// AddRootWords adds lowercase root words to the dictionary of s.
+// A Stemmer on the shared dictionary first takes a private copy of
+// it, so the words never leak into other stemmers and s no longer
+// follows InitRootWords.
+func (s *Stemmer) AddRootWords(words ...string) {
+	s.ownDictionary().Add(words...)
+}
+
+// RemoveRootWords removes root words from the dictionary of s,
+// copying the shared dictionary first like AddRootWords.
+func (s *Stemmer) RemoveRootWords(words ...string) {
+	s.ownDictionary().Remove(words...)
+}
+
+// ownDictionary returns the dictionary owned by s, swapping in
+// a copy of the shared dictionary if s has none yet.
+func (s *Stemmer) ownDictionary() *Dictionary {
+	for {
+		if d := s.dict.Load(); d != nil {
+			return d
 		}
+		s.dict.CompareAndSwap(nil, rootWords.Load().clone())
 	}
+}
+
+// stemming stems words against one snapshot of the dictionary,
+// so a concurrent reload never changes the root words mid-word.
+type stemming struct {
+	*Stemmer
+	words     wordSet
+	protected wordSet
+	overrides stemTable
+	source    cacheSource
+	trace     *Trace
+}
 
-	if match, _ := regexp.Match(`(an|i)$`, word); match {
-		re, _ := regexp.Compile(`(an|i)$`)
-		base = re.ReplaceAll(word, []byte([]byte("")))
-		if s.IsRootWord(base) {
-			return base
+func (s *Stemmer) stemming() stemming {
+	st := stemming{Stemmer: s}
+	if ws := s.dictionary().words.Load(); ws != nil {
+		st.words, st.source.words = *ws, ws
+	}
+	st.protected = s.protectedWords()
+	if o := s.overrides.Load(); o != nil {
+		if t := o.stems.Load(); t != nil {
+			st.overrides, st.source.overrides = *t, t
 		}
 	}
+	return st
+}
+
+func (st *stemming) isProtected(lower string) bool {
+	_, ok := st.protected[lower]
+	return ok
+}
 
-	if s.isDisallowedPrefixSuffixes(base) {
-		return word
+func (st *stemming) isRoot(word []byte) bool {
+	_, ok := st.words[string(word)]
+	if st.trace != nil {
+		st.trace.Steps = append(st.trace.Steps, TraceStep{Action: "lookup", Input: string(word), Found: ok})
 	}
+	return ok
+}
 
-	return word
+// candidate is a word together with the affixes removed to get it.
+type candidate struct {
+	word    []byte
+	affixes affixes
 }
 
-// removeDerivationPeople
-// "-man" . "-wan" . "-wati"
-func (s *Stemmer) removeDerivationPeople(word []byte) []byte {
-	var base []byte
-
-	if match, _ := regexp.Match(`([mw]an)$`, word); match {
-		re, _ := regexp.Compile(`([mw]an)$`)
-		base = re.ReplaceAll(word, []byte([]byte("")))
-		if s.IsRootWord(base) {
-			return base
+// stem stems a lowercase word that is not a root word. It returns the
+// stem, the affixes removed and whether word is a reduplication.
+// Hyphenated words that are not reduplications are returned unchanged.
+// The stem may be a partly stripped word that is not a root word,
+// unless s is strict.
+func (st *stemming) stem(word []byte) ([]byte, affixes, bool) {
+	c, reduplicated := st.removeReduplication(word)
+	switch {
+	case reduplicated:
+	case bytes.IndexByte(word, '-') >= 0:
+		// a compound such as "kerja-sama" keeps both of its words
+		c = candidate{word: word}
+	default:
+		c = st.stemWord(word)
+	}
+	if st.strict && !st.isRoot(c.word) {
+		st.step("skip", "", c.word, word, "strict stemmer keeps words without a root word")
+		return word, affixes{}, false
+	}
+	return c.word, c.affixes, reduplicated
+}
+
+// stemWord stems a single word with the algorithm selected by the mode.
+func (st *stemming) stemWord(word []byte) candidate {
+	if st.mode == EnhancedConfixStripping {
+		return st.confixStrippingProcess(word)
+	}
+	return st.removingProcess(word)
+}
+
+func (st *stemming) removingProcess(word []byte) candidate {
+	// confixes with rule precedence lose their prefix before their suffix,
+	// any other word loses its suffixes first
+	precedence := st.isRulePrecedence(word)
+	if precedence {
+		p0 := st.removeDerivationPrefixes(word)
+		if st.isRoot(p0.word) {
+			return p0
 		}
+		st.step("skip", "", word, p0.word, "rule precedence: removing the prefix first gave no root word")
 	}
 
-	if match, _ := regexp.Match(`(wati)$`, word); match {
-		re, _ := regexp.Compile(`(wati)$`)
-		base = re.ReplaceAll(word, []byte([]byte("")))
-		if s.IsRootWord(base) {
-			return base
+	p1 := st.removeInflectionSuffixes(word)
+	if st.trace != nil {
+		input := word
+		for _, kind := range []AffixKind{Particle, PossessivePronoun} {
+			if a := p1.affixes[kind]; a.Value != "" {
+				st.removed(a, input, input[:len(input)-len(a.Value)])
+				input = input[:len(input)-len(a.Value)]
+			}
 		}
 	}
+	p2 := st.removeDerivationSuffixes(p1.word)
+	p2.affixes = p1.affixes.with(p2.affixes)
+	if st.isRoot(p2.word) {
+		return p2
+	}
 
-	if s.isDisallowedPrefixSuffixes(base) {
-		return word
+	p3 := st.removeDerivationPrefixes(p2.word)
+	p3.affixes = p2.affixes.with(p3.affixes)
+	if st.isRoot(p3.word) {
+		return p3
 	}
 
-	return word
+	if !precedence {
+		// fall back to removing only the prefixes
+		st.step("skip", "", word, p3.word, "removing the suffixes first gave no root word")
+		p0 := st.removeDerivationPrefixes(word)
+		if st.isRoot(p0.word) {
+			return p0
+		}
+	}
+
+	p4 := st.removeDerivationPeople(p3.word)
+	p4.affixes = p3.affixes.with(p4.affixes)
+	return p4
 }
 
-// removeDerivationPrefixes
-// "di-" . "ke-" . "se-" . "me-" . "be-" . "pe-" or "te-"
-func (s *Stemmer) removeDerivationPrefixes(word []byte) []byte {
-	base := word
-	var strRemovedDerivSuff []byte
-	var strRemovedStdPref []byte
-	var strRemovedCmplxPref []byte
-	var prefixes []byte
+var (
+	rulePrecedenceBe = regexp.MustCompile(`^(be)([a-z\-]+)(lah|an)$`)
+	rulePrecedenceI  = regexp.MustCompile(`^(di|[mpt]e)([a-z\-]+)(i)$`)
+)
 
-	var re *regexp.Regexp
+var (
+	particles          = []string{"lah", "kah", "tah", "pun"}
+	possessivePronouns = []string{"ku", "mu", "nya"}
+	derivationSuffixes = []string{"kan", "an", "i"}
+	derivationPeople   = []string{"man", "wan", "wati"}
+)
 
-	if match, _ := regexp.Match(`^(di|[ks]e)\S{1,}`, word); match {
-		re, _ = regexp.Compile("^(di|[ks]e)")
+// isRulePrecedence checks the Rule Precedence
+// combination of Prefix and Suffix
+// "be-lah" "be-an" "me-i" "di-i" "pe-i" or "te-i"
+func (s *Stemmer) isRulePrecedence(word []byte) bool {
+	return rulePrecedenceBe.Match(word) || rulePrecedenceI.Match(word)
+}
 
-		strRemovedStdPref = re.ReplaceAll(word, []byte(""))
-		if s.IsRootWord(strRemovedStdPref) {
-			return strRemovedStdPref
-		}
+// disallowedConfixes are the Disallowed Prefix-Suffix Combinations
+// "be-i" . "di-an" . "ke-i|kan" . "me-an" . "se-i|kan" or "te-an"
+var disallowedConfixes = []struct {
+	prefix   string
+	suffixes []string
+}{
+	{"be", []string{"i"}},
+	{"di", []string{"an"}},
+	{"ke", []string{"i", "kan"}},
+	{"me", []string{"an"}},
+	{"se", []string{"i", "kan"}},
+	{"te", []string{"an"}},
+}
 
-		strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedStdPref)
-		if s.IsRootWord(strRemovedDerivSuff) {
-			return strRemovedDerivSuff
+// isdisallowedprefixsuffixes checks Disallowed Prefix-Suffix Combinations
+// on the surface of word, so "merasakan" counts as "me-an". It tries
+// every split of word into a prefix, at least one letter and a suffix
+// against isDisallowedConfix, so the two checks cannot drift apart.
+func (s *Stemmer) isDisallowedPrefixSuffixes(word []byte) bool {
+	for i := 2; i < len(word); i++ {
+		if isDisallowedConfix(string(word[:i-1]), string(word[i:])) {
+			return true
 		}
+	}
+	return false
+}
 
-		if match, _ := regexp.Match(`^(keber)\S{0,}`, word); match {
-			re, _ = regexp.Compile("^([ks]eber)")
-			strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-			if s.IsRootWord(strRemovedCmplxPref) {
-				return strRemovedCmplxPref
+// isDisallowedConfix reports whether removing both prefix and suffix
+// from a word would decompose it into a disallowed confix. The prefix
+// may be any variant of a disallowed one, such as "meng-" for "me-".
+func isDisallowedConfix(prefix, suffix string) bool {
+	for _, c := range disallowedConfixes {
+		if !strings.HasPrefix(prefix, c.prefix) {
+			continue
+		}
+		for _, s := range c.suffixes {
+			if s == suffix {
+				return true
 			}
+		}
+	}
+	return false
+}
 
-			strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-			if s.IsRootWord(strRemovedDerivSuff) {
-				return strRemovedDerivSuff
-			}
+// trimSuffix returns word without the first of suffixes it ends with,
+// and that suffix.
+func trimSuffix(word []byte, suffixes []string) ([]byte, string, bool) {
+	for _, suffix := range suffixes {
+		if bytes.HasSuffix(word, []byte(suffix)) {
+			return word[:len(word)-len(suffix)], suffix, true
 		}
+	}
+	return word, "", false
+}
 
-	} else if match, _ := regexp.Match(`^([^aiueo])e\1[aiueo]\S{1,}`, word); match {
-		re, _ = regexp.Compile("^([^aiueo])e")
+// removeInflectionSuffixes
+// 1. Particle "-lah" "-kah" "-tah" and "-pun"
+// 2. Possesive Pronoun "-ku" "-mu" "-nya"
+func (s *Stemmer) removeInflectionSuffixes(word []byte) candidate {
+	c := candidate{word: word}
+	if infSuf, particle, ok := trimSuffix(c.word, particles); ok {
+		c.word = infSuf
+		c.affixes.add(Particle, particle, "")
+	}
+	if posPron, pronoun, ok := trimSuffix(c.word, possessivePronouns); ok {
+		c.word = posPron
+		c.affixes.add(PossessivePronoun, pronoun, "")
+	}
+	return c
+}
+
+// removeDerivationSuffixes
+// "-i" . "-kan" . "-an"
+func (st *stemming) removeDerivationSuffixes(word []byte) candidate {
+	return st.removeRootSuffix("", word, derivationSuffixes)
+}
 
-		prefixes = re.ReplaceAll(word, []byte(""))
-		if s.IsRootWord(prefixes) {
-			return prefixes
+// removeDerivationPeople
+// "-man" . "-wan" . "-wati"
+func (st *stemming) removeDerivationPeople(word []byte) candidate {
+	return st.removeRootSuffix("", word, derivationPeople)
+}
+
+// removeRootSuffix tries each of suffixes in order and returns
+// the first stripped word that is a root word, or word itself.
+// The word is taken to have already lost prefix, so suffixes
+// that form a disallowed confix with it are skipped.
+func (st *stemming) removeRootSuffix(prefix string, word []byte, suffixes []string) candidate {
+	for _, suffix := range suffixes {
+		if !bytes.HasSuffix(word, []byte(suffix)) {
+			continue
 		}
+		if isDisallowedConfix(prefix, suffix) {
+			st.step("skip", "-"+suffix, word, nil, "disallowed confix "+prefix+"-"+suffix)
+			continue
+		}
+		if base := word[:len(word)-len(suffix)]; st.isRoot(base) {
+			c := candidate{word: base}
+			c.affixes.add(DerivationSuffix, suffix, "")
+			st.removed(c.affixes[DerivationSuffix], word, base)
+			return c
+		}
+	}
+	return candidate{word: word}
+}
 
-		strRemovedDerivSuff = s.removeDerivationSuffixes(prefixes)
-		if s.IsRootWord(strRemovedDerivSuff) {
-			return strRemovedDerivSuff
+// removeDerivationPrefixes
+// "di-" . "ke-" . "se-" . "me-" . "be-" . "pe-" or "te-"
+func (st *stemming) removeDerivationPrefixes(word []byte) candidate {
+	if rule := st.findPrefixRule(plainPrefixRules, word); rule != nil {
+		if root, _ := st.applyPrefixRule(rule, word, FirstPrefix); root.word != nil {
+			return root
 		}
+		return candidate{word: word}
+	}
 
-	} else if match, _ := regexp.Match(`^([tmbp]e)\S{1,}`, word); match {
-		if match, _ := regexp.Match(`^(be)\S{1,}`, word); match {
-			if match, _ := regexp.Match(`^(ber)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ber)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("r"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(ber)[^aiueor]([a-z\-]+)\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ber)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(ber)[^aiueor]([a-z\-]+)er[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ber)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^belajar\S{0,}`, word); match {
-				re, _ = regexp.Compile("^(bel)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(be)[^aiueolr]er[^aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(be)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
+	// the "pe-" rules get the last word tried by the other
+	// complex prefixes, which strips the second prefix of "memper-"
+	next, kind := candidate{word: word}, FirstPrefix
+	for _, rules := range complexPrefixRules {
+		if rule := st.findPrefixRule(rules, word); rule != nil {
+			root, last := st.applyPrefixRule(rule, word, FirstPrefix)
+			if root.word != nil {
+				return root
+			}
+			if last.word != nil {
+				next, kind = last, SecondPrefix
 			}
-
 		}
+	}
 
-		if match, _ := regexp.Match(`^(te)\S{1,}`, word); match {
-			if match, _ := regexp.Match(`^(terr)\S{1,}`, word); match {
-				return word
-
-			} else if match, _ := regexp.Match(`^(ter)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ter)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("r"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(ter)[^aiueor]er[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ter)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(ter)[^aiueor]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ter)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(te)[^aiueor]er\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(te)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(ter)[^aiueor]er[^aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(ter)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-			}
+	if rule := st.findPrefixRule(pePrefixRules, next.word); rule != nil {
+		if root, _ := st.applyPrefixRule(rule, next.word, kind); root.word != nil {
+			root.affixes = next.affixes.with(root.affixes)
+			return root
 		}
+	}
 
-		if match, _ := regexp.Match(`^(me)\S{1,}`, word); match {
-			if match, _ := regexp.Match(`^(me)[lrwyv][aiueo]`, word); match {
-				re, _ = regexp.Compile("^(me)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(mem)[bfvp]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(mem)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				if match, _ := regexp.Match(`^(member)\S{0,}`, word); match {
-					re, _ = regexp.Compile("^(member)")
-
-					strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-					if s.IsRootWord(strRemovedCmplxPref) {
-						return strRemovedCmplxPref
-					}
-
-					strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-					if s.IsRootWord(strRemovedDerivSuff) {
-						return strRemovedDerivSuff
-					}
-				}
-
-			} else if match, _ := regexp.Match(`^(mem)((r[aiueo])|[aiueo])\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(mem)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("m"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("p"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(men)[cdjszt]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(men)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(men)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(men)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("n"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("t"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(meng)[ghqk]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(meng)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(meng)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(meng)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("k"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("ng"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				re, _ = regexp.Compile("^(menge)")
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(meny)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(meny)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("s"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				re, _ = regexp.Compile("^(me)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-			}
+	return candidate{word: word}
+}
+
+// findPrefixRule returns the first rule in rules matching word, or nil.
+func (st *stemming) findPrefixRule(rules []prefixRule, word []byte) *prefixRule {
+	rule := findPrefixRule(rules, word)
+	if rule != nil {
+		st.step("rule", rule.match.String(), word, nil, "")
+	}
+	return rule
+}
+
+// applyPrefixRule tries every removal of rule on word, with and without
+// the derivation suffixes allowed after its prefix, and records the
+// prefix as kind. It returns the first root word found, or else the
+// last word tried.
+func (st *stemming) applyPrefixRule(rule *prefixRule, word []byte, kind AffixKind) (root, last candidate) {
+	for _, removal := range rule.removals {
+		stem, ok := removal.apply(word)
+		if !ok {
+			continue
 		}
 
-		if len(strRemovedDerivSuff) != 0 {
-			word = strRemovedDerivSuff
+		last = candidate{word: stem}
+		last.affixes.add(kind, removal.prefix, removal.recode)
+		st.removed(last.affixes[kind], word, stem)
+		if st.isRoot(stem) {
+			return last, candidate{}
 		}
 
-		if match, _ := regexp.Match(`^(pe)\S{1,}`, word); match {
-			if match, _ := regexp.Match(`^(pe)[wy]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pe)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(per)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(per)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("r"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(per)[^aiueor]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(per)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				if match, _ := regexp.Match(`^(perse)\S{0,}`, word); match {
-					re, _ = regexp.Compile("^(perse)")
-
-					strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-					if s.IsRootWord(strRemovedCmplxPref) {
-						return strRemovedCmplxPref
-					}
-
-					strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-					if s.IsRootWord(strRemovedDerivSuff) {
-						return strRemovedDerivSuff
-					}
-				}
-
-			} else if match, _ := regexp.Match(`^(per)[^aiueor]([a-z\-]+)(er)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(per)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pem)[bfv]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pem)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pem)(r[aiueo]|[aiueo])\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pem)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("m"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("p"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pen)[cdjzts]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pen)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pen)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pen)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("n"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("t"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-			} else if match, _ := regexp.Match(`^(peng)[ghq]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(peng)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(peng)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(peng)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("k"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				re, _ = regexp.Compile("^(penge)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(peng)[^ghq]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(peng)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(peny)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(peny)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("s"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				re, _ = regexp.Compile("^(pe)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pel)[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pel)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte("l"))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-				if match, _ := regexp.Match(`^(pelajar)i*\S{0,}`, word); match {
-					re, _ = regexp.Compile("^(pel)")
-
-					strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-					if s.IsRootWord(strRemovedCmplxPref) {
-						return strRemovedCmplxPref
-					}
-
-					strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-					if s.IsRootWord(strRemovedDerivSuff) {
-						return strRemovedDerivSuff
-					}
-				}
-
-			} else if match, _ := regexp.Match(`^(pe)[^rwylmn]er[aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pe)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pe)[^rwylmn]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pe)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-
-			} else if match, _ := regexp.Match(`^(pe)[^aiueor]er[^aiueo]\S{1,}`, word); match {
-				re, _ = regexp.Compile("^(pe)")
-
-				strRemovedCmplxPref = re.ReplaceAll(word, []byte(""))
-				if s.IsRootWord(strRemovedCmplxPref) {
-					return strRemovedCmplxPref
-				}
-
-				strRemovedDerivSuff = s.removeDerivationSuffixes(strRemovedCmplxPref)
-				if s.IsRootWord(strRemovedDerivSuff) {
-					return strRemovedDerivSuff
-				}
-			}
+		root = st.removeRootSuffix(removal.prefix, stem, derivationSuffixes)
+		if root.affixes[DerivationSuffix].Value != "" {
+			root.affixes = last.affixes.with(root.affixes)
+			return root, candidate{}
 		}
 	}
-
-	return base
+	return candidate{}, last
 }
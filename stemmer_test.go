@@ -174,6 +174,19 @@ func TestStemm(t *testing.T) {
 	}
 }
 
+func TestStem(t *testing.T) {
+	var s WordStemmer = New(WithCasing(RestoreCase))
+	for _, w := range append(benchmarkWords, "Perekonomian", "buku-buku") {
+		if out, want := s.Stem(w), New(WithCasing(RestoreCase)).Stemm(w)[0]; out != want {
+			t.Error(w, out, want)
+		}
+	}
+
+	if out := New(WithStopwords(nil, FilterBeforeStemming)).Stem("yang"); out != "yang" {
+		t.Error(out)
+	}
+}
+
 func TestInitRootWordsSuccess(t *testing.T) {
 	InitRootWords()
 }
@@ -230,6 +243,8 @@ func TestIsDisallowedPrefixSuffixes(t *testing.T) {
 		{"bercinta", false},
 		{"dicinta", false},
 		{"mencinta", false},
+		{"bei", false},
+		{"mean", false},
 	}
 
 	s := New()
@@ -253,12 +268,15 @@ func TestRemoveInflectionSuffixes(t *testing.T) {
 		{"cintaku", "cinta"},
 		{"cintamu", "cinta"},
 		{"cintanya", "cinta"},
+
+		{"bukunya", "buku"},
+		{"bukumulah", "buku"},
 	}
 
 	s := New()
 	for _, tc := range testCases {
 		out := s.removeInflectionSuffixes([]byte(tc.in))
-		if string(out) != tc.out {
+		if string(out.word) != tc.out {
 			t.Error(tc.in)
 		}
 	}
@@ -273,10 +291,10 @@ func TestRemoveDerivationSuffixes(t *testing.T) {
 		{"tekaan", "teka"},
 	}
 
-	s := New()
+	s := New().stemming()
 	for _, tc := range testCases {
 		out := s.removeDerivationSuffixes([]byte(tc.in))
-		if string(out) != tc.out {
+		if string(out.word) != tc.out {
 			t.Error(tc.in)
 		}
 	}
@@ -294,11 +312,153 @@ func TestRemoveDerivationPeople(t *testing.T) {
 		{"seniman", "seni"},
 	}
 
-	s := New()
+	s := New().stemming()
 	for _, tc := range testCases {
 		out := s.removeDerivationPeople([]byte(tc.in))
-		if string(out) != tc.out {
+		if string(out.word) != tc.out {
 			t.Error(tc.in)
 		}
 	}
 }
+
+var benchmarkWords = []string{
+	"mencintai", "pembangunan", "keberuntunganmu", "memperbarui", "perekonomian",
+	"menyanyikan", "bersembunyilah", "pelangganmukah", "terasingkan", "dimulai",
+	"kebaikannya", "berpelanggan", "mempengaruhi", "penstabilan", "budayawan",
+	"cinta", "makan", "rumah", "sekolah", "yang",
+}
+
+func BenchmarkStemm(b *testing.B) {
+	s := New()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Stemm(benchmarkWords[i%len(benchmarkWords)])
+	}
+}
+
+func BenchmarkStem(b *testing.B) {
+	s := New()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Stem(benchmarkWords[i%len(benchmarkWords)])
+	}
+}
+
+func BenchmarkStemmParallel(b *testing.B) {
+	s := New()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Stemm(benchmarkWords[i%len(benchmarkWords)])
+		}
+	})
+}
+
+func BenchmarkRemoveDerivationPrefixes(b *testing.B) {
+	s := New().stemming()
+	word := []byte("memperbarui")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.removeDerivationPrefixes(word)
+	}
+}
+
+func TestRemovingProcessRulePrecedence(t *testing.T) {
+	testCases := []struct {
+		word     string
+		baseWord string
+	}{
+		// be-lah, "masa" would be found first without precedence
+		{"bermasalah", "masalah"},
+		{"bersekolah", "sekolah"},
+		// be-an, "bertam" would be found first without precedence
+		{"bertaman", "taman"},
+		{"beraman", "aman"},
+		// me-i, "melambang" would be found first without precedence
+		{"melambangi", "lambang"},
+		{"mendatangi", "datang"},
+		// di-i, "diayah" would be found first without precedence
+		{"diayahi", "ayah"},
+		{"didatangi", "datang"},
+		// pe-i, "pencar" would be found first without precedence
+		{"pencari", "cari"},
+		{"pendaki", "daki"},
+		// te-i, "tertawa" would be found first without precedence
+		{"tertawai", "tawa"},
+		{"terlalui", "lalu"},
+
+		// no rule precedence, suffixes are removed first
+		{"kejilah", "keji"},
+		{"dinaskah", "dinas"},
+		{"kenafkan", "kenaf"},
+	}
+
+	s := New()
+	for _, tc := range testCases {
+		out := s.Stemm(tc.word)
+		if out[0] != tc.baseWord {
+			t.Error(tc.word, tc.baseWord, out[0])
+		}
+	}
+}
+
+func TestRemovingProcessDisallowedConfixes(t *testing.T) {
+	testCases := []struct {
+		word     string
+		baseWord string
+	}{
+		// disallowed confixes are not decomposed any more
+		{"berjalani", "berjalani"},
+		{"dibacaan", "dibacaan"},
+		{"kecintai", "kecintai"},
+		{"kebaikkan", "kebaikkan"},
+		{"menulisan", "menulisan"},
+		{"sebaiki", "sebaiki"},
+		{"sepantaskan", "sepantaskan"},
+		{"terlupaan", "terlupaan"},
+
+		// allowed confixes with the same prefixes
+		{"dimainkan", "main"},
+		{"kebaikan", "baik"},
+		{"kemasukan", "masuk"},
+		{"menuliskan", "tulis"},
+		{"sesuaikan", "sesuai"},
+	}
+
+	s := New()
+	for _, tc := range testCases {
+		out := s.Stemm(tc.word)
+		if out[0] != tc.baseWord {
+			t.Error(tc.word, tc.baseWord, out[0])
+		}
+	}
+}
+
+func TestIsDisallowedConfix(t *testing.T) {
+	testCases := []struct {
+		prefix string
+		suffix string
+		out    bool
+	}{
+		{"ber", "i", true},
+		{"di", "an", true},
+		{"ke", "i", true},
+		{"keber", "kan", true},
+		{"meng", "an", true},
+		{"se", "kan", true},
+		{"ter", "an", true},
+
+		{"ber", "kan", false},
+		{"di", "kan", false},
+		{"ke", "an", false},
+		{"meng", "kan", false},
+		{"pe", "an", false},
+		{"", "i", false},
+	}
+
+	for _, tc := range testCases {
+		if isDisallowedConfix(tc.prefix, tc.suffix) != tc.out {
+			t.Error(tc.prefix, tc.suffix)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Package stemmergrpc serves a stemmer.Stemmer as the gRPC
+// StemmerService defined in package stemmerpb.
+package stemmergrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ariefrahmansyah/stemmer"
+	"github.com/ariefrahmansyah/stemmer/stemmerpb"
+)
+
+// Server implements stemmerpb.StemmerServiceServer with a Stemmer.
+type Server struct {
+	stemmerpb.UnimplementedStemmerServiceServer
+
+	s *stemmer.Stemmer
+}
+
+// NewServer returns a Server stemming with s.
+func NewServer(s *stemmer.Stemmer) *Server {
+	return &Server{s: s}
+}
+
+// Register registers a Server stemming with s on g.
+func Register(g grpc.ServiceRegistrar, s *stemmer.Stemmer) {
+	stemmerpb.RegisterStemmerServiceServer(g, NewServer(s))
+}
+
+// Stem returns the stems of the words of req.
+func (srv *Server) Stem(ctx context.Context, req *stemmerpb.StemRequest) (*stemmerpb.StemResponse, error) {
+	return &stemmerpb.StemResponse{Stems: srv.s.Stemm(req.GetWords()...)}, nil
+}
+
+// IsRootWord reports whether the word of req is a root word.
+func (srv *Server) IsRootWord(ctx context.Context, req *stemmerpb.IsRootWordRequest) (*stemmerpb.IsRootWordResponse, error) {
+	if req.GetWord() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing word")
+	}
+	return &stemmerpb.IsRootWordResponse{IsRoot: srv.s.IsRootWord([]byte(strings.ToLower(req.GetWord())))}, nil
+}
+
+// Analyze returns the analysis of the word of req.
+func (srv *Server) Analyze(ctx context.Context, req *stemmerpb.AnalyzeRequest) (*stemmerpb.AnalyzeResponse, error) {
+	if req.GetWord() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing word")
+	}
+
+	a := srv.s.Analyze(req.GetWord())
+	resp := &stemmerpb.AnalyzeResponse{
+		Word:         a.Word,
+		Root:         a.Root,
+		Reduplicated: a.Reduplicated,
+		Found:        a.Found,
+		Protected:    a.Protected,
+		Overridden:   a.Overridden,
+	}
+	for _, affix := range a.Affixes {
+		resp.Affixes = append(resp.Affixes, &stemmerpb.Affix{
+			Kind:     affixKinds[affix.Kind],
+			Value:    affix.Value,
+			Recoding: affix.Recoding,
+		})
+	}
+	return resp, nil
+}
+
+var affixKinds = map[stemmer.AffixKind]stemmerpb.AffixKind{
+	stemmer.FirstPrefix:       stemmerpb.AffixKind_AFFIX_KIND_FIRST_PREFIX,
+	stemmer.SecondPrefix:      stemmerpb.AffixKind_AFFIX_KIND_SECOND_PREFIX,
+	stemmer.ThirdPrefix:       stemmerpb.AffixKind_AFFIX_KIND_THIRD_PREFIX,
+	stemmer.DerivationSuffix:  stemmerpb.AffixKind_AFFIX_KIND_DERIVATION_SUFFIX,
+	stemmer.PossessivePronoun: stemmerpb.AffixKind_AFFIX_KIND_POSSESSIVE_PRONOUN,
+	stemmer.Particle:          stemmerpb.AffixKind_AFFIX_KIND_PARTICLE,
+}
+
+// StemStream answers every batch of words received with their stems
+// until the client closes its side of the stream.
+func (srv *Server) StemStream(stream grpc.BidiStreamingServer[stemmerpb.StemRequest, stemmerpb.StemResponse]) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(&stemmerpb.StemResponse{Stems: srv.s.Stemm(req.GetWords()...)}); err != nil {
+			return err
+		}
+	}
+}
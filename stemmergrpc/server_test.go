@@ -0,0 +1,129 @@
+package stemmergrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ariefrahmansyah/stemmer"
+	"github.com/ariefrahmansyah/stemmer/stemmerpb"
+)
+
+// newClient serves s on an in-process listener and returns a client for it.
+func newClient(t *testing.T, s *stemmer.Stemmer) stemmerpb.StemmerServiceClient {
+	lis := bufconn.Listen(1 << 20)
+	g := grpc.NewServer()
+	Register(g, s)
+	go g.Serve(lis)
+	t.Cleanup(g.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return stemmerpb.NewStemmerServiceClient(conn)
+}
+
+func TestStem(t *testing.T) {
+	c := newClient(t, stemmer.New())
+	resp, err := c.Stem(context.Background(), &stemmerpb.StemRequest{Words: []string{"mencintai", "Perekonomian"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.GetStems(), []string{"cinta", "ekonomi"}) {
+		t.Error(resp.GetStems())
+	}
+}
+
+func TestIsRootWord(t *testing.T) {
+	testCases := []struct {
+		in   string
+		out  bool
+		code codes.Code
+	}{
+		{"cinta", true, codes.OK},
+		{"Cinta", true, codes.OK},
+		{"mencintai", false, codes.OK},
+		{"", false, codes.InvalidArgument},
+	}
+
+	c := newClient(t, stemmer.New())
+	for _, tc := range testCases {
+		resp, err := c.IsRootWord(context.Background(), &stemmerpb.IsRootWordRequest{Word: tc.in})
+		if status.Code(err) != tc.code || resp.GetIsRoot() != tc.out {
+			t.Error(tc.in, resp, err)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	c := newClient(t, stemmer.New())
+	resp, err := c.Analyze(context.Background(), &stemmerpb.AnalyzeRequest{Word: "mencintainya"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &stemmerpb.AnalyzeResponse{
+		Word:  "mencintainya",
+		Root:  "cinta",
+		Found: true,
+		Affixes: []*stemmerpb.Affix{
+			{Kind: stemmerpb.AffixKind_AFFIX_KIND_FIRST_PREFIX, Value: "men"},
+			{Kind: stemmerpb.AffixKind_AFFIX_KIND_DERIVATION_SUFFIX, Value: "i"},
+			{Kind: stemmerpb.AffixKind_AFFIX_KIND_POSSESSIVE_PRONOUN, Value: "nya"},
+		},
+	}
+	if !proto.Equal(resp, want) {
+		t.Error(resp)
+	}
+
+	if _, err := c.Analyze(context.Background(), &stemmerpb.AnalyzeRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Error(err)
+	}
+}
+
+func TestStemStream(t *testing.T) {
+	c := newClient(t, stemmer.New())
+	stream, err := c.StemStream(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batches := [][]string{{"mencintai"}, {"perekonomian", "buku-buku"}, {}}
+	want := [][]string{{"cinta"}, {"ekonomi", "buku"}, nil}
+	for i, words := range batches {
+		if err := stream.Send(&stemmerpb.StemRequest{Words: words}); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(resp.GetStems(), want[i]) {
+			t.Error(words, resp.GetStems())
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,5 @@
+// Package stemmerpb holds the protobuf messages and gRPC service
+// definitions of the stemmer, generated from stemmer.proto.
+package stemmerpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative stemmer.proto
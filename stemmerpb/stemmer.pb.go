@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: stemmer.proto
+
+package stemmerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AffixKind is the position of an affix in a word.
+type AffixKind int32
+
+const (
+	AffixKind_AFFIX_KIND_UNSPECIFIED        AffixKind = 0
+	AffixKind_AFFIX_KIND_FIRST_PREFIX       AffixKind = 1
+	AffixKind_AFFIX_KIND_SECOND_PREFIX      AffixKind = 2
+	AffixKind_AFFIX_KIND_THIRD_PREFIX       AffixKind = 3
+	AffixKind_AFFIX_KIND_DERIVATION_SUFFIX  AffixKind = 4
+	AffixKind_AFFIX_KIND_POSSESSIVE_PRONOUN AffixKind = 5
+	AffixKind_AFFIX_KIND_PARTICLE           AffixKind = 6
+)
+
+// Enum value maps for AffixKind.
+var (
+	AffixKind_name = map[int32]string{
+		0: "AFFIX_KIND_UNSPECIFIED",
+		1: "AFFIX_KIND_FIRST_PREFIX",
+		2: "AFFIX_KIND_SECOND_PREFIX",
+		3: "AFFIX_KIND_THIRD_PREFIX",
+		4: "AFFIX_KIND_DERIVATION_SUFFIX",
+		5: "AFFIX_KIND_POSSESSIVE_PRONOUN",
+		6: "AFFIX_KIND_PARTICLE",
+	}
+	AffixKind_value = map[string]int32{
+		"AFFIX_KIND_UNSPECIFIED":        0,
+		"AFFIX_KIND_FIRST_PREFIX":       1,
+		"AFFIX_KIND_SECOND_PREFIX":      2,
+		"AFFIX_KIND_THIRD_PREFIX":       3,
+		"AFFIX_KIND_DERIVATION_SUFFIX":  4,
+		"AFFIX_KIND_POSSESSIVE_PRONOUN": 5,
+		"AFFIX_KIND_PARTICLE":           6,
+	}
+)
+
+func (x AffixKind) Enum() *AffixKind {
+	p := new(AffixKind)
+	*p = x
+	return p
+}
+
+func (x AffixKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AffixKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_stemmer_proto_enumTypes[0].Descriptor()
+}
+
+func (AffixKind) Type() protoreflect.EnumType {
+	return &file_stemmer_proto_enumTypes[0]
+}
+
+func (x AffixKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AffixKind.Descriptor instead.
+func (AffixKind) EnumDescriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{0}
+}
+
+type StemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StemRequest) Reset() {
+	*x = StemRequest{}
+	mi := &file_stemmer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StemRequest) ProtoMessage() {}
+
+func (x *StemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StemRequest.ProtoReflect.Descriptor instead.
+func (*StemRequest) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StemRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type StemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stems holds the stem of each word, in the order of the words.
+	Stems         []string `protobuf:"bytes,1,rep,name=stems,proto3" json:"stems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StemResponse) Reset() {
+	*x = StemResponse{}
+	mi := &file_stemmer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StemResponse) ProtoMessage() {}
+
+func (x *StemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StemResponse.ProtoReflect.Descriptor instead.
+func (*StemResponse) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StemResponse) GetStems() []string {
+	if x != nil {
+		return x.Stems
+	}
+	return nil
+}
+
+type IsRootWordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsRootWordRequest) Reset() {
+	*x = IsRootWordRequest{}
+	mi := &file_stemmer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsRootWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsRootWordRequest) ProtoMessage() {}
+
+func (x *IsRootWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsRootWordRequest.ProtoReflect.Descriptor instead.
+func (*IsRootWordRequest) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IsRootWordRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type IsRootWordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsRoot        bool                   `protobuf:"varint,1,opt,name=is_root,json=isRoot,proto3" json:"is_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsRootWordResponse) Reset() {
+	*x = IsRootWordResponse{}
+	mi := &file_stemmer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsRootWordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsRootWordResponse) ProtoMessage() {}
+
+func (x *IsRootWordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsRootWordResponse.ProtoReflect.Descriptor instead.
+func (*IsRootWordResponse) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IsRootWordResponse) GetIsRoot() bool {
+	if x != nil {
+		return x.IsRoot
+	}
+	return false
+}
+
+type AnalyzeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeRequest) Reset() {
+	*x = AnalyzeRequest{}
+	mi := &file_stemmer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRequest) ProtoMessage() {}
+
+func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AnalyzeRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type Affix struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  AffixKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=stemmer.v1.AffixKind" json:"kind,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// recoding holds the letters put back in place of the affix.
+	Recoding      string `protobuf:"bytes,3,opt,name=recoding,proto3" json:"recoding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Affix) Reset() {
+	*x = Affix{}
+	mi := &file_stemmer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Affix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Affix) ProtoMessage() {}
+
+func (x *Affix) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Affix.ProtoReflect.Descriptor instead.
+func (*Affix) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Affix) GetKind() AffixKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AffixKind_AFFIX_KIND_UNSPECIFIED
+}
+
+func (x *Affix) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Affix) GetRecoding() string {
+	if x != nil {
+		return x.Recoding
+	}
+	return ""
+}
+
+type AnalyzeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Word  string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Root  string                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// affixes are the affixes removed, in the order they appear in word.
+	Affixes       []*Affix `protobuf:"bytes,3,rep,name=affixes,proto3" json:"affixes,omitempty"`
+	Reduplicated  bool     `protobuf:"varint,4,opt,name=reduplicated,proto3" json:"reduplicated,omitempty"`
+	Found         bool     `protobuf:"varint,5,opt,name=found,proto3" json:"found,omitempty"`
+	Protected     bool     `protobuf:"varint,6,opt,name=protected,proto3" json:"protected,omitempty"`
+	Overridden    bool     `protobuf:"varint,7,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeResponse) Reset() {
+	*x = AnalyzeResponse{}
+	mi := &file_stemmer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeResponse) ProtoMessage() {}
+
+func (x *AnalyzeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stemmer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
+	return file_stemmer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AnalyzeResponse) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *AnalyzeResponse) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *AnalyzeResponse) GetAffixes() []*Affix {
+	if x != nil {
+		return x.Affixes
+	}
+	return nil
+}
+
+func (x *AnalyzeResponse) GetReduplicated() bool {
+	if x != nil {
+		return x.Reduplicated
+	}
+	return false
+}
+
+func (x *AnalyzeResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *AnalyzeResponse) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *AnalyzeResponse) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+var File_stemmer_proto protoreflect.FileDescriptor
+
+const file_stemmer_proto_rawDesc = "" +
+	"\n" +
+	"\rstemmer.proto\x12\n" +
+	"stemmer.v1\"#\n" +
+	"\vStemRequest\x12\x14\n" +
+	"\x05words\x18\x01 \x03(\tR\x05words\"$\n" +
+	"\fStemResponse\x12\x14\n" +
+	"\x05stems\x18\x01 \x03(\tR\x05stems\"'\n" +
+	"\x11IsRootWordRequest\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\"-\n" +
+	"\x12IsRootWordResponse\x12\x17\n" +
+	"\ais_root\x18\x01 \x01(\bR\x06isRoot\"$\n" +
+	"\x0eAnalyzeRequest\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\"d\n" +
+	"\x05Affix\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.stemmer.v1.AffixKindR\x04kind\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1a\n" +
+	"\brecoding\x18\x03 \x01(\tR\brecoding\"\xde\x01\n" +
+	"\x0fAnalyzeResponse\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12+\n" +
+	"\aaffixes\x18\x03 \x03(\v2\x11.stemmer.v1.AffixR\aaffixes\x12\"\n" +
+	"\freduplicated\x18\x04 \x01(\bR\freduplicated\x12\x14\n" +
+	"\x05found\x18\x05 \x01(\bR\x05found\x12\x1c\n" +
+	"\tprotected\x18\x06 \x01(\bR\tprotected\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\a \x01(\bR\n" +
+	"overridden*\xdd\x01\n" +
+	"\tAffixKind\x12\x1a\n" +
+	"\x16AFFIX_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17AFFIX_KIND_FIRST_PREFIX\x10\x01\x12\x1c\n" +
+	"\x18AFFIX_KIND_SECOND_PREFIX\x10\x02\x12\x1b\n" +
+	"\x17AFFIX_KIND_THIRD_PREFIX\x10\x03\x12 \n" +
+	"\x1cAFFIX_KIND_DERIVATION_SUFFIX\x10\x04\x12!\n" +
+	"\x1dAFFIX_KIND_POSSESSIVE_PRONOUN\x10\x05\x12\x17\n" +
+	"\x13AFFIX_KIND_PARTICLE\x10\x062\xa1\x02\n" +
+	"\x0eStemmerService\x129\n" +
+	"\x04Stem\x12\x17.stemmer.v1.StemRequest\x1a\x18.stemmer.v1.StemResponse\x12K\n" +
+	"\n" +
+	"IsRootWord\x12\x1d.stemmer.v1.IsRootWordRequest\x1a\x1e.stemmer.v1.IsRootWordResponse\x12B\n" +
+	"\aAnalyze\x12\x1a.stemmer.v1.AnalyzeRequest\x1a\x1b.stemmer.v1.AnalyzeResponse\x12C\n" +
+	"\n" +
+	"StemStream\x12\x17.stemmer.v1.StemRequest\x1a\x18.stemmer.v1.StemResponse(\x010\x01B.Z,github.com/ariefrahmansyah/stemmer/stemmerpbb\x06proto3"
+
+var (
+	file_stemmer_proto_rawDescOnce sync.Once
+	file_stemmer_proto_rawDescData []byte
+)
+
+func file_stemmer_proto_rawDescGZIP() []byte {
+	file_stemmer_proto_rawDescOnce.Do(func() {
+		file_stemmer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stemmer_proto_rawDesc), len(file_stemmer_proto_rawDesc)))
+	})
+	return file_stemmer_proto_rawDescData
+}
+
+var file_stemmer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stemmer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_stemmer_proto_goTypes = []any{
+	(AffixKind)(0),             // 0: stemmer.v1.AffixKind
+	(*StemRequest)(nil),        // 1: stemmer.v1.StemRequest
+	(*StemResponse)(nil),       // 2: stemmer.v1.StemResponse
+	(*IsRootWordRequest)(nil),  // 3: stemmer.v1.IsRootWordRequest
+	(*IsRootWordResponse)(nil), // 4: stemmer.v1.IsRootWordResponse
+	(*AnalyzeRequest)(nil),     // 5: stemmer.v1.AnalyzeRequest
+	(*Affix)(nil),              // 6: stemmer.v1.Affix
+	(*AnalyzeResponse)(nil),    // 7: stemmer.v1.AnalyzeResponse
+}
+var file_stemmer_proto_depIdxs = []int32{
+	0, // 0: stemmer.v1.Affix.kind:type_name -> stemmer.v1.AffixKind
+	6, // 1: stemmer.v1.AnalyzeResponse.affixes:type_name -> stemmer.v1.Affix
+	1, // 2: stemmer.v1.StemmerService.Stem:input_type -> stemmer.v1.StemRequest
+	3, // 3: stemmer.v1.StemmerService.IsRootWord:input_type -> stemmer.v1.IsRootWordRequest
+	5, // 4: stemmer.v1.StemmerService.Analyze:input_type -> stemmer.v1.AnalyzeRequest
+	1, // 5: stemmer.v1.StemmerService.StemStream:input_type -> stemmer.v1.StemRequest
+	2, // 6: stemmer.v1.StemmerService.Stem:output_type -> stemmer.v1.StemResponse
+	4, // 7: stemmer.v1.StemmerService.IsRootWord:output_type -> stemmer.v1.IsRootWordResponse
+	7, // 8: stemmer.v1.StemmerService.Analyze:output_type -> stemmer.v1.AnalyzeResponse
+	2, // 9: stemmer.v1.StemmerService.StemStream:output_type -> stemmer.v1.StemResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_stemmer_proto_init() }
+func file_stemmer_proto_init() {
+	if File_stemmer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stemmer_proto_rawDesc), len(file_stemmer_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stemmer_proto_goTypes,
+		DependencyIndexes: file_stemmer_proto_depIdxs,
+		EnumInfos:         file_stemmer_proto_enumTypes,
+		MessageInfos:      file_stemmer_proto_msgTypes,
+	}.Build()
+	File_stemmer_proto = out.File
+	file_stemmer_proto_goTypes = nil
+	file_stemmer_proto_depIdxs = nil
+}
@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: stemmer.proto
+
+package stemmerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StemmerService_Stem_FullMethodName       = "/stemmer.v1.StemmerService/Stem"
+	StemmerService_IsRootWord_FullMethodName = "/stemmer.v1.StemmerService/IsRootWord"
+	StemmerService_Analyze_FullMethodName    = "/stemmer.v1.StemmerService/Analyze"
+	StemmerService_StemStream_FullMethodName = "/stemmer.v1.StemmerService/StemStream"
+)
+
+// StemmerServiceClient is the client API for StemmerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StemmerService stems Indonesian words.
+type StemmerServiceClient interface {
+	// Stem returns the stem of each word, like Stemmer.Stemm.
+	Stem(ctx context.Context, in *StemRequest, opts ...grpc.CallOption) (*StemResponse, error)
+	// IsRootWord reports whether a word is in the dictionary.
+	IsRootWord(ctx context.Context, in *IsRootWordRequest, opts ...grpc.CallOption) (*IsRootWordResponse, error)
+	// Analyze returns the root of a word and the affixes removed.
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	// StemStream stems batches of words as they arrive and answers
+	// each request with one response, in order.
+	StemStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StemRequest, StemResponse], error)
+}
+
+type stemmerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStemmerServiceClient(cc grpc.ClientConnInterface) StemmerServiceClient {
+	return &stemmerServiceClient{cc}
+}
+
+func (c *stemmerServiceClient) Stem(ctx context.Context, in *StemRequest, opts ...grpc.CallOption) (*StemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StemResponse)
+	err := c.cc.Invoke(ctx, StemmerService_Stem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stemmerServiceClient) IsRootWord(ctx context.Context, in *IsRootWordRequest, opts ...grpc.CallOption) (*IsRootWordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsRootWordResponse)
+	err := c.cc.Invoke(ctx, StemmerService_IsRootWord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stemmerServiceClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, StemmerService_Analyze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stemmerServiceClient) StemStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StemRequest, StemResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StemmerService_ServiceDesc.Streams[0], StemmerService_StemStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StemRequest, StemResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StemmerService_StemStreamClient = grpc.BidiStreamingClient[StemRequest, StemResponse]
+
+// StemmerServiceServer is the server API for StemmerService service.
+// All implementations must embed UnimplementedStemmerServiceServer
+// for forward compatibility.
+//
+// StemmerService stems Indonesian words.
+type StemmerServiceServer interface {
+	// Stem returns the stem of each word, like Stemmer.Stemm.
+	Stem(context.Context, *StemRequest) (*StemResponse, error)
+	// IsRootWord reports whether a word is in the dictionary.
+	IsRootWord(context.Context, *IsRootWordRequest) (*IsRootWordResponse, error)
+	// Analyze returns the root of a word and the affixes removed.
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	// StemStream stems batches of words as they arrive and answers
+	// each request with one response, in order.
+	StemStream(grpc.BidiStreamingServer[StemRequest, StemResponse]) error
+	mustEmbedUnimplementedStemmerServiceServer()
+}
+
+// UnimplementedStemmerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStemmerServiceServer struct{}
+
+func (UnimplementedStemmerServiceServer) Stem(context.Context, *StemRequest) (*StemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stem not implemented")
+}
+func (UnimplementedStemmerServiceServer) IsRootWord(context.Context, *IsRootWordRequest) (*IsRootWordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsRootWord not implemented")
+}
+func (UnimplementedStemmerServiceServer) Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedStemmerServiceServer) StemStream(grpc.BidiStreamingServer[StemRequest, StemResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StemStream not implemented")
+}
+func (UnimplementedStemmerServiceServer) mustEmbedUnimplementedStemmerServiceServer() {}
+func (UnimplementedStemmerServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeStemmerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StemmerServiceServer will
+// result in compilation errors.
+type UnsafeStemmerServiceServer interface {
+	mustEmbedUnimplementedStemmerServiceServer()
+}
+
+func RegisterStemmerServiceServer(s grpc.ServiceRegistrar, srv StemmerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStemmerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StemmerService_ServiceDesc, srv)
+}
+
+func _StemmerService_Stem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StemmerServiceServer).Stem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StemmerService_Stem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StemmerServiceServer).Stem(ctx, req.(*StemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StemmerService_IsRootWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsRootWordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StemmerServiceServer).IsRootWord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StemmerService_IsRootWord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StemmerServiceServer).IsRootWord(ctx, req.(*IsRootWordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StemmerService_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StemmerServiceServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StemmerService_Analyze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StemmerServiceServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StemmerService_StemStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StemmerServiceServer).StemStream(&grpc.GenericServerStream[StemRequest, StemResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StemmerService_StemStreamServer = grpc.BidiStreamingServer[StemRequest, StemResponse]
+
+// StemmerService_ServiceDesc is the grpc.ServiceDesc for StemmerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StemmerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stemmer.v1.StemmerService",
+	HandlerType: (*StemmerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Stem",
+			Handler:    _StemmerService_Stem_Handler,
+		},
+		{
+			MethodName: "IsRootWord",
+			Handler:    _StemmerService_IsRootWord_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _StemmerService_Analyze_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StemStream",
+			Handler:       _StemmerService_StemStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "stemmer.proto",
+}
@@ -0,0 +1,78 @@
+package stemmer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"unicode"
+)
+
+// streamChunkSize is the most text StemStream holds in memory at once.
+const streamChunkSize = 64 << 10
+
+// StemStream reads text from r and writes it to w with every word
+// replaced by its stem, keeping the whitespace, punctuation, numbers,
+// emails, URLs, mentions and hashtags as they are. The stopwords
+// dropped by s are removed, leaving the whitespace around them.
+//
+// The text is stemmed in chunks ending at whitespace, so memory use
+// is bounded; a run of more than 64KiB without whitespace is split.
+// StemStream stops with ctx.Err() when ctx is done, checked between
+// reads. A read error is returned after the text read before it has
+// been stemmed and written.
+func (s *Stemmer) StemStream(ctx context.Context, r io.Reader, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	buf := make([]byte, 0, streamChunkSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, readErr := r.Read(buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		done := readErr != nil
+
+		end := len(buf)
+		if !done {
+			if i := bytes.LastIndexFunc(buf, unicode.IsSpace); i >= 0 {
+				end = i + 1
+			} else if len(buf) < cap(buf) {
+				continue
+			}
+		}
+
+		if err := s.stemChunk(bw, string(buf[:end])); err != nil {
+			return err
+		}
+		buf = buf[:copy(buf, buf[end:])]
+
+		if done {
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+			if readErr == io.EOF {
+				return nil
+			}
+			return readErr
+		}
+	}
+}
+
+// stemChunk writes text to w with its words replaced by their stems.
+func (s *Stemmer) stemChunk(w *bufio.Writer, text string) error {
+	st := s.stemming()
+	last := 0
+	for _, token := range Tokenize(text) {
+		if token.Kind != Word {
+			continue
+		}
+		w.WriteString(text[last:token.Start])
+		if stem, ok := st.stemm(token.Text, st.filter); ok {
+			w.WriteString(stem)
+		}
+		last = token.End
+	}
+	_, err := w.WriteString(text[last:])
+	return err
+}
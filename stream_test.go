@@ -0,0 +1,89 @@
+package stemmer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestStemStream(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out string
+	}{
+		{"", ""},
+		{"Perekonomian  mencintai,\n\tbuku-buku!\n", "ekonomi  cinta,\n\tbuku!\n"},
+		{"surel budi@contoh.id #Perekonomian 2.500 kali", "surel budi@contoh.id #Perekonomian 2.500 kali"},
+	}
+
+	s := New()
+	for _, tc := range testCases {
+		var out strings.Builder
+		// one byte at a time, so words are split between reads
+		if err := s.StemStream(context.Background(), iotest.OneByteReader(strings.NewReader(tc.in)), &out); err != nil {
+			t.Error(tc.in, err)
+		}
+		if out.String() != tc.out {
+			t.Error(tc.in, out.String())
+		}
+	}
+}
+
+func TestStemStreamLarge(t *testing.T) {
+	in := strings.Repeat("mencintai perekonomian\n", 10000)
+	var out strings.Builder
+	if err := New().StemStream(context.Background(), strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != strings.Repeat("cinta ekonomi\n", 10000) {
+		t.Error(out.Len())
+	}
+}
+
+func TestStemStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := New().StemStream(ctx, strings.NewReader("mencintai"), io.Discard)
+	if !errors.Is(err, context.Canceled) {
+		t.Error(err)
+	}
+}
+
+func TestStemStreamReadError(t *testing.T) {
+	errRead := errors.New("read failed")
+	testCases := []struct {
+		r   io.Reader
+		out string
+	}{
+		{io.MultiReader(strings.NewReader("mencintai "), iotest.ErrReader(errRead)), "cinta "},
+		// the last read returns the error with its data
+		{&dataErrReader{"mencintai perekonomian", errRead}, "cinta ekonomi"},
+	}
+
+	for _, tc := range testCases {
+		var out strings.Builder
+		err := New().StemStream(context.Background(), tc.r, &out)
+		if !errors.Is(err, errRead) {
+			t.Error(err)
+		}
+		if out.String() != tc.out {
+			t.Error(out.String())
+		}
+	}
+}
+
+// dataErrReader returns its data and err from the same Read.
+type dataErrReader struct {
+	data string
+	err  error
+}
+
+func (r *dataErrReader) Read(p []byte) (int, error) {
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, r.err
+}
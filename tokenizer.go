@@ -0,0 +1,107 @@
+package stemmer
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// TokenKind is the kind of a token found in text.
+type TokenKind int
+
+const (
+	// Word is a run of letters, possibly joined by hyphens or
+	// apostrophes, such as "buku-buku" or "Jum'at".
+	Word TokenKind = iota
+	// Number is a word with digits, such as "Rp2.500", "10:30" or "ke-2".
+	Number
+	Email
+	URL
+	// Mention is a user name such as "@budi".
+	Mention
+	// Hashtag is a tag such as "#indonesia".
+	Hashtag
+)
+
+var tokenKindNames = [...]string{
+	Word:    "word",
+	Number:  "number",
+	Email:   "email",
+	URL:     "url",
+	Mention: "mention",
+	Hashtag: "hashtag",
+}
+
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return "unknown"
+	}
+	return tokenKindNames[k]
+}
+
+// MarshalText encodes the kind as its name.
+func (k TokenKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Token is a token of text. Start and End are the byte offsets
+// of Text in the text. Stem is only set on stemmed words.
+type Token struct {
+	Kind  TokenKind `json:"kind"`
+	Text  string    `json:"text"`
+	Start int       `json:"start"`
+	End   int       `json:"end"`
+	Stem  string    `json:"stem,omitempty"`
+}
+
+// tokenPattern matches a token of each kind, in the order of tokenKinds.
+// Numbers without separators are matched as words and told apart by
+// their digits.
+var tokenPattern = regexp.MustCompile(`(?i)` +
+	`((?:https?://|www\.)[^\s<>"]*[^\s<>".,;:!?)\]}'])` +
+	`|([\pL\pN._%+-]+@[\pL\pN-]+(?:\.[\pL\pN-]+)+)` +
+	`|(@[\pL\pN_]+)` +
+	`|(#[\pL\pN_]+)` +
+	`|(\pL*\pN+(?:[.,:/]\pN+)+)` +
+	`|([\pL\pN]+(?:['’-][\pL\pN]+)*)`)
+
+var tokenKinds = [...]TokenKind{URL, Email, Mention, Hashtag, Number, Word}
+
+// Tokenize splits text into tokens, skipping whitespace and punctuation.
+func Tokenize(text string) []Token {
+	tokens := []Token{}
+	for _, m := range tokenPattern.FindAllStringSubmatchIndex(text, -1) {
+		for i, kind := range tokenKinds {
+			start, end := m[2*i+2], m[2*i+3]
+			if start < 0 {
+				continue
+			}
+			if kind == Word && strings.IndexFunc(text[start:end], unicode.IsDigit) >= 0 {
+				kind = Number
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: text[start:end], Start: start, End: end})
+			break
+		}
+	}
+	return tokens
+}
+
+// StemText tokenizes text and stems its words. Numbers, emails,
+// URLs, mentions and hashtags are left as they are, and the
+// stopwords dropped by s are left out.
+func (s *Stemmer) StemText(text string) []Token {
+	st := s.stemming()
+	tokens := Tokenize(text)
+	kept := tokens[:0]
+	for _, token := range tokens {
+		if token.Kind == Word {
+			stem, ok := st.stemm(token.Text, st.filter)
+			if !ok {
+				continue
+			}
+			token.Stem = stem
+		}
+		kept = append(kept, token)
+	}
+	return kept
+}
@@ -0,0 +1,89 @@
+package stemmer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out []Token
+	}{
+		{"", []Token{}},
+		{" ,.! ", []Token{}},
+		{"Buku-buku, Jum'at.", []Token{
+			{Kind: Word, Text: "Buku-buku", Start: 0, End: 9},
+			{Kind: Word, Text: "Jum'at", Start: 11, End: 17},
+		}},
+		{"Rp2.500 untuk ke-2 (10:30)", []Token{
+			{Kind: Number, Text: "Rp2.500", Start: 0, End: 7},
+			{Kind: Word, Text: "untuk", Start: 8, End: 13},
+			{Kind: Number, Text: "ke-2", Start: 14, End: 18},
+			{Kind: Number, Text: "10:30", Start: 20, End: 25},
+		}},
+		{"1990an, 2500.", []Token{
+			{Kind: Number, Text: "1990an", Start: 0, End: 6},
+			{Kind: Number, Text: "2500", Start: 8, End: 12},
+		}},
+		{"Lihat https://contoh.id/a?b=1. atau www.contoh.id", []Token{
+			{Kind: Word, Text: "Lihat", Start: 0, End: 5},
+			{Kind: URL, Text: "https://contoh.id/a?b=1", Start: 6, End: 29},
+			{Kind: Word, Text: "atau", Start: 31, End: 35},
+			{Kind: URL, Text: "www.contoh.id", Start: 36, End: 49},
+		}},
+		{"surel budi.s@contoh.co.id, @budi_s #Indonesia", []Token{
+			{Kind: Word, Text: "surel", Start: 0, End: 5},
+			{Kind: Email, Text: "budi.s@contoh.co.id", Start: 6, End: 25},
+			{Kind: Mention, Text: "@budi_s", Start: 27, End: 34},
+			{Kind: Hashtag, Text: "#Indonesia", Start: 35, End: 45},
+		}},
+		{"“kédai”", []Token{
+			{Kind: Word, Text: "kédai", Start: 3, End: 9},
+		}},
+	}
+
+	for _, tc := range testCases {
+		out := Tokenize(tc.in)
+		if !reflect.DeepEqual(out, tc.out) {
+			t.Error(tc.in, out)
+		}
+		for _, token := range out {
+			if tc.in[token.Start:token.End] != token.Text {
+				t.Error(tc.in, token)
+			}
+		}
+	}
+}
+
+func TestStemText(t *testing.T) {
+	out := New().StemText("Perekonomian #Indonesia mencintai @budi, 2.500 kali!")
+	want := []Token{
+		{Kind: Word, Text: "Perekonomian", Start: 0, End: 12, Stem: "ekonomi"},
+		{Kind: Hashtag, Text: "#Indonesia", Start: 13, End: 23},
+		{Kind: Word, Text: "mencintai", Start: 24, End: 33, Stem: "cinta"},
+		{Kind: Mention, Text: "@budi", Start: 34, End: 39},
+		{Kind: Number, Text: "2.500", Start: 41, End: 46},
+		{Kind: Word, Text: "kali", Start: 47, End: 51, Stem: "kali"},
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Error(out)
+	}
+}
+
+func TestTokenKindString(t *testing.T) {
+	testCases := []struct {
+		in  TokenKind
+		out string
+	}{
+		{Word, "word"},
+		{Hashtag, "hashtag"},
+		{TokenKind(-1), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if tc.in.String() != tc.out {
+			t.Error(tc.in.String())
+		}
+	}
+}
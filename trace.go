@@ -0,0 +1,104 @@
+package stemmer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TraceStep is one step taken while stemming a word.
+type TraceStep struct {
+	// Action is "lookup", "rule", "remove", "restore" or "skip".
+	Action string `json:"action"`
+	// Rule is the prefix rule that matched or the affix involved.
+	Rule string `json:"rule,omitempty"`
+	// Input is the word the step was applied to.
+	Input string `json:"input"`
+	// Output is the word the step produced.
+	Output string `json:"output,omitempty"`
+	// Found is the outcome of a dictionary lookup of Input.
+	Found bool `json:"found"`
+	// Reason tells why a branch was abandoned.
+	Reason string `json:"reason,omitempty"`
+}
+
+// String formats the step on a single line.
+func (t TraceStep) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-7s", t.Action)
+	if t.Rule != "" {
+		fmt.Fprintf(&b, " %s", t.Rule)
+	}
+	fmt.Fprintf(&b, " %s", t.Input)
+	if t.Output != "" {
+		fmt.Fprintf(&b, " -> %s", t.Output)
+	}
+	if t.Action == "lookup" {
+		if t.Found {
+			b.WriteString(": found")
+		} else {
+			b.WriteString(": not found")
+		}
+	}
+	if t.Reason != "" {
+		fmt.Fprintf(&b, " (%s)", t.Reason)
+	}
+	return b.String()
+}
+
+// Trace records how a word was stemmed, step by step.
+type Trace struct {
+	Analysis
+	Mode  Mode        `json:"mode"`
+	Steps []TraceStep `json:"steps"`
+}
+
+// String formats the trace with one step per line.
+func (t Trace) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s)\n", t.Word, t.Mode)
+	for _, step := range t.Steps {
+		fmt.Fprintf(&b, "  %s\n", step)
+	}
+	fmt.Fprintf(&b, "root %s", t.Root)
+	if !t.Found {
+		b.WriteString(" (not found)")
+	}
+	return b.String()
+}
+
+// Explain stems word like Analyze and records every rule tried,
+// every intermediate word and dictionary lookup, and why the
+// abandoned branches were given up.
+func (s *Stemmer) Explain(word string) Trace {
+	t := Trace{Mode: s.mode, Steps: []TraceStep{}}
+	st := s.stemming()
+	st.trace = &t
+	t.Analysis = st.analyze(word)
+	return t
+}
+
+// step records a step when st is tracing.
+func (st *stemming) step(action, rule string, input, output []byte, reason string) {
+	if st.trace == nil {
+		return
+	}
+	st.trace.Steps = append(st.trace.Steps, TraceStep{
+		Action: action,
+		Rule:   rule,
+		Input:  string(input),
+		Output: string(output),
+		Reason: reason,
+	})
+}
+
+// removed records the removal of affix from input when st is tracing.
+func (st *stemming) removed(affix Affix, input, output []byte) {
+	if st.trace == nil {
+		return
+	}
+	rule := affix.String()
+	if affix.Recoding != "" {
+		rule += " +" + affix.Recoding
+	}
+	st.step("remove", rule, input, output, "")
+}
@@ -0,0 +1,105 @@
+package stemmer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	testCases := []struct {
+		mode  Mode
+		in    string
+		root  string
+		steps []TraceStep
+	}{
+		{NaziefAdriani, "cinta", "cinta", []TraceStep{
+			{Action: "lookup", Input: "cinta", Found: true},
+		}},
+		{NaziefAdriani, "menyapu", "sapu", []TraceStep{
+			{Action: "rule", Rule: `^(meny)[aiueo]\S{1,}`, Input: "menyapu"},
+			{Action: "remove", Rule: "meny- +s", Input: "menyapu", Output: "sapu"},
+			{Action: "lookup", Input: "sapu", Found: true},
+		}},
+		{NaziefAdriani, "menulisan", "menulisan", []TraceStep{
+			{Action: "skip", Rule: "-an", Input: "tulisan", Reason: "disallowed confix men-an"},
+		}},
+		{NaziefAdriani, "qwx-pwx", "qwx-pwx", []TraceStep{
+			{Action: "rule", Rule: "reduplication", Input: "qwx-pwx"},
+			{Action: "skip", Rule: "reduplication", Input: "qwx-pwx", Reason: "neither half has a root word"},
+		}},
+		{NaziefAdriani, "kerja-sama", "kerja-sama", []TraceStep{
+			{Action: "rule", Rule: "reduplication", Input: "kerja-sama"},
+			{Action: "skip", Rule: "reduplication", Input: "kerja-sama", Reason: "the halves are unrelated words"},
+		}},
+		{EnhancedConfixStripping, "menyapukan", "sapu", []TraceStep{
+			{Action: "remove", Rule: "-kan", Input: "menyapukan", Output: "menyapu"},
+			{Action: "lookup", Input: "menyapu"},
+			{Action: "rule", Rule: `^meny[aiueo]`, Input: "menyapu"},
+			{Action: "remove", Rule: "meny- +s", Input: "menyapu", Output: "sapu"},
+		}},
+	}
+
+	for _, tc := range testCases {
+		trace := New(WithMode(tc.mode)).Explain(tc.in)
+		if trace.Root != tc.root || trace.Mode != tc.mode {
+			t.Error(tc.in, trace.Root)
+		}
+		if !containsSteps(trace.Steps, tc.steps) {
+			t.Error(tc.in, trace.String())
+		}
+	}
+}
+
+// containsSteps reports whether want appears in steps in order.
+func containsSteps(steps, want []TraceStep) bool {
+	for _, step := range steps {
+		if len(want) > 0 && step == want[0] {
+			want = want[1:]
+		}
+	}
+	return len(want) == 0
+}
+
+func TestTraceString(t *testing.T) {
+	out := New().Explain("menyapu").String()
+	if fmt.Sprint(New().Explain("menyapu")) != out {
+		t.Error("Trace should print as its String")
+	}
+	for _, line := range []string{
+		"menyapu (nazief-adriani)\n",
+		"  remove  meny- +s menyapu -> sapu\n",
+		"  lookup  sapu: found\n",
+		"root sapu",
+	} {
+		if !strings.Contains(out, line) {
+			t.Error(line, out)
+		}
+	}
+}
+
+func TestTraceJSON(t *testing.T) {
+	trace := New(WithMode(EnhancedConfixStripping)).Explain("menyapu")
+	b, err := json.Marshal(&trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out struct {
+		Word    string
+		Root    string
+		Mode    string
+		Found   bool
+		Affixes []struct{ Kind, Value, Recoding string }
+		Steps   []TraceStep
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Word != "menyapu" || out.Root != "sapu" || out.Mode != "ecs" || !out.Found ||
+		len(out.Affixes) != 1 || out.Affixes[0].Kind != "first-prefix" || out.Affixes[0].Recoding != "s" ||
+		len(out.Steps) != len(trace.Steps) {
+		t.Error(string(b))
+	}
+}